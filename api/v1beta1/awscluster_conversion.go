@@ -43,10 +43,45 @@ func (src *AWSCluster) ConvertTo(dstRaw conversion.Hub) error {
 	}
 	restoreControlPlaneLoadBalancerStatus(&restored.Status.Network.APIServerELB, &dst.Status.Network.APIServerELB)
 
+	dst.Spec.SecondaryControlPlaneLoadBalancer = restored.Spec.SecondaryControlPlaneLoadBalancer
+	dst.Spec.ControlPlaneDNS = restored.Spec.ControlPlaneDNS
+	restored.Status.Network.SecondaryAPIServerELB.DeepCopyInto(&dst.Status.Network.SecondaryAPIServerELB)
+
+	dst.Spec.NetworkSpec.VPC.Endpoints = restored.Spec.NetworkSpec.VPC.Endpoints
+	dst.Spec.NetworkSpec.VPC.IPAMPool = restored.Spec.NetworkSpec.VPC.IPAMPool
+	dst.Spec.NetworkSpec.VPC.CarrierGatewayID = restored.Spec.NetworkSpec.VPC.CarrierGatewayID
+	dst.Spec.NetworkSpec.VPC.FlowLogs = restored.Spec.NetworkSpec.VPC.FlowLogs
+	dst.Status.Network.FlowLogIDs = restored.Status.Network.FlowLogIDs
+	dst.Status.Network.AssociatedHostedZoneIDs = restored.Status.Network.AssociatedHostedZoneIDs
+	dst.Status.Network.AdditionalRoutes = restored.Status.Network.AdditionalRoutes
+	dst.Status.Network.VPCEndpoints = restored.Status.Network.VPCEndpoints
+	dst.Spec.NetworkSpec.TransitGateway = restored.Spec.NetworkSpec.TransitGateway
+	dst.Status.Network.TransitGatewayAttachmentID = restored.Status.Network.TransitGatewayAttachmentID
+	dst.Spec.NetworkSpec.ElasticIPPool = restored.Spec.NetworkSpec.ElasticIPPool
+	dst.Spec.NetworkSpec.AdditionalRoutes = restored.Spec.NetworkSpec.AdditionalRoutes
+	dst.Spec.NetworkSpec.NetworkACLs = restored.Spec.NetworkSpec.NetworkACLs
+	dst.Spec.NetworkSpec.SecurityGroupEgressRules = restored.Spec.NetworkSpec.SecurityGroupEgressRules
+	dst.Spec.NetworkSpec.AdditionalIngressRules = restored.Spec.NetworkSpec.AdditionalIngressRules
+	for i := range dst.Spec.NetworkSpec.Subnets {
+		if subnet := restored.Spec.NetworkSpec.Subnets.FindByID(dst.Spec.NetworkSpec.Subnets[i].ID); subnet != nil {
+			dst.Spec.NetworkSpec.Subnets[i].AdditionalRoutes = subnet.AdditionalRoutes
+			dst.Spec.NetworkSpec.Subnets[i].ZoneType = subnet.ZoneType
+			dst.Spec.NetworkSpec.Subnets[i].ParentZoneName = subnet.ParentZoneName
+		}
+	}
+
 	dst.Spec.S3Bucket = restored.Spec.S3Bucket
+	dst.Spec.PlacementGroups = restored.Spec.PlacementGroups
+	dst.Status.PlacementGroups = restored.Status.PlacementGroups
 	if restored.Status.Bastion != nil {
 		dst.Status.Bastion.InstanceMetadataOptions = restored.Status.Bastion.InstanceMetadataOptions
 		dst.Status.Bastion.PlacementGroupName = restored.Status.Bastion.PlacementGroupName
+		dst.Status.Bastion.PlacementGroupPartition = restored.Status.Bastion.PlacementGroupPartition
+		dst.Status.Bastion.AdditionalNetworkInterfaces = restored.Status.Bastion.AdditionalNetworkInterfaces
+		dst.Status.Bastion.NetworkInterfaceAttachments = restored.Status.Bastion.NetworkInterfaceAttachments
+		dst.Status.Bastion.CapacityReservation = restored.Status.Bastion.CapacityReservation
+		dst.Status.Bastion.PrivateDNSName = restored.Status.Bastion.PrivateDNSName
+		dst.Status.Bastion.MaintenanceOptions = restored.Status.Bastion.MaintenanceOptions
 	}
 	dst.Spec.Partition = restored.Spec.Partition
 
@@ -38,6 +38,13 @@ func (src *AWSMachine) ConvertTo(dstRaw conversion.Hub) error {
 	dst.Spec.Ignition = restored.Spec.Ignition
 	dst.Spec.InstanceMetadataOptions = restored.Spec.InstanceMetadataOptions
 	dst.Spec.PlacementGroupName = restored.Spec.PlacementGroupName
+	dst.Spec.PlacementGroupPartition = restored.Spec.PlacementGroupPartition
+	dst.Spec.AdditionalNetworkInterfaces = restored.Spec.AdditionalNetworkInterfaces
+	dst.Spec.CapacityReservation = restored.Spec.CapacityReservation
+	dst.Spec.PrivateDNSName = restored.Spec.PrivateDNSName
+	dst.Spec.ElasticIP = restored.Spec.ElasticIP
+	dst.Spec.MaintenanceOptions = restored.Spec.MaintenanceOptions
+	dst.Status.MaintenanceOptions = restored.Status.MaintenanceOptions
 
 	return nil
 }
@@ -85,6 +92,12 @@ func (r *AWSMachineTemplate) ConvertTo(dstRaw conversion.Hub) error {
 	dst.Spec.Template.Spec.Ignition = restored.Spec.Template.Spec.Ignition
 	dst.Spec.Template.Spec.InstanceMetadataOptions = restored.Spec.Template.Spec.InstanceMetadataOptions
 	dst.Spec.Template.Spec.PlacementGroupName = restored.Spec.Template.Spec.PlacementGroupName
+	dst.Spec.Template.Spec.PlacementGroupPartition = restored.Spec.Template.Spec.PlacementGroupPartition
+	dst.Spec.Template.Spec.AdditionalNetworkInterfaces = restored.Spec.Template.Spec.AdditionalNetworkInterfaces
+	dst.Spec.Template.Spec.CapacityReservation = restored.Spec.Template.Spec.CapacityReservation
+	dst.Spec.Template.Spec.PrivateDNSName = restored.Spec.Template.Spec.PrivateDNSName
+	dst.Spec.Template.Spec.ElasticIP = restored.Spec.Template.Spec.ElasticIP
+	dst.Spec.Template.Spec.MaintenanceOptions = restored.Spec.Template.Spec.MaintenanceOptions
 
 	return nil
 }
@@ -60,6 +60,19 @@ type AWSClusterSpec struct {
 	// +optional
 	ControlPlaneLoadBalancer *AWSLoadBalancerSpec `json:"controlPlaneLoadBalancer,omitempty"`
 
+	// SecondaryControlPlaneLoadBalancer is an additional load balancer that can be used for the control plane.
+	// It must be a network load balancer with a scheme different from the primary control plane load balancer,
+	// for example an internal load balancer for in-VPC traffic alongside an internet-facing one.
+	// Control plane instances are registered with both load balancers.
+	// +optional
+	SecondaryControlPlaneLoadBalancer *AWSLoadBalancerSpec `json:"secondaryControlPlaneLoadBalancer,omitempty"`
+
+	// ControlPlaneDNS configures a Route53 alias record pointing to the control plane load balancer.
+	// When set, the record name is used as the control plane endpoint instead of the DNS name of
+	// the load balancer, so that the endpoint does not change if the load balancer is recreated.
+	// +optional
+	ControlPlaneDNS *ControlPlaneDNS `json:"controlPlaneDNS,omitempty"`
+
 	// ImageLookupFormat is the AMI naming format to look up machine images when
 	// a machine does not specify an AMI. When set, this will be used for all
 	// cluster machines unless a machine specifies a different ImageLookupOrg.
@@ -101,6 +114,48 @@ type AWSClusterSpec struct {
 	// BootstrapFormatIgnition feature flag to be enabled).
 	// +optional
 	S3Bucket *S3Bucket `json:"s3Bucket,omitempty"`
+
+	// PlacementGroups is a list of placement groups to be created and deleted together with the cluster.
+	// Machines join one of these groups by setting its name in AWSMachineSpec.PlacementGroupName.
+	// Placement groups are immutable in AWS, so an entry cannot be changed once created, only removed.
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	PlacementGroups []PlacementGroup `json:"placementGroups,omitempty"`
+}
+
+// PlacementGroupStrategy defines the placement strategy of a placement group.
+type PlacementGroupStrategy string
+
+const (
+	// PlacementGroupStrategyCluster packs instances close together inside an Availability Zone.
+	PlacementGroupStrategyCluster = PlacementGroupStrategy("cluster")
+
+	// PlacementGroupStrategySpread places each instance on distinct underlying hardware.
+	PlacementGroupStrategySpread = PlacementGroupStrategy("spread")
+
+	// PlacementGroupStrategyPartition spreads instances across logical partitions that do not share
+	// underlying hardware with each other.
+	PlacementGroupStrategyPartition = PlacementGroupStrategy("partition")
+)
+
+// PlacementGroup defines a placement group managed by the AWSCluster.
+type PlacementGroup struct {
+	// Name of the placement group. It must be unique within the account and region.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=255
+	Name string `json:"name"`
+
+	// Strategy is the placement strategy of the group.
+	// +kubebuilder:validation:Enum=cluster;spread;partition
+	Strategy PlacementGroupStrategy `json:"strategy"`
+
+	// PartitionCount is the number of partitions of the group. Only valid with the partition strategy.
+	// Defaults to 2 in AWS when unset.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=7
+	// +optional
+	PartitionCount *int64 `json:"partitionCount,omitempty"`
 }
 
 // AWSIdentityKind defines allowed AWS identity types.
@@ -115,6 +170,9 @@ var (
 
 	// ClusterStaticIdentityKind defines identity reference kind as AWSClusterStaticIdentity.
 	ClusterStaticIdentityKind = AWSIdentityKind("AWSClusterStaticIdentity")
+
+	// ClusterWebIdentityKind defines identity reference kind as AWSClusterWebIdentity.
+	ClusterWebIdentityKind = AWSIdentityKind("AWSClusterWebIdentity")
 )
 
 // AWSIdentityReference specifies a identity.
@@ -124,7 +182,7 @@ type AWSIdentityReference struct {
 	Name string `json:"name"`
 
 	// Kind of the identity.
-	// +kubebuilder:validation:Enum=AWSClusterControllerIdentity;AWSClusterRoleIdentity;AWSClusterStaticIdentity
+	// +kubebuilder:validation:Enum=AWSClusterControllerIdentity;AWSClusterRoleIdentity;AWSClusterStaticIdentity;AWSClusterWebIdentity
 	Kind AWSIdentityKind `json:"kind"`
 }
 
@@ -234,6 +292,23 @@ type AWSClusterStatus struct {
 	FailureDomains clusterv1.FailureDomains `json:"failureDomains,omitempty"`
 	Bastion        *Instance                `json:"bastion,omitempty"`
 	Conditions     clusterv1.Conditions     `json:"conditions,omitempty"`
+
+	// PlacementGroups lists the names of the placement groups created for this cluster.
+	// +optional
+	PlacementGroups []string `json:"placementGroups,omitempty"`
+}
+
+// ControlPlaneDNS defines a Route53 record for the control plane endpoint.
+type ControlPlaneDNS struct {
+	// HostedZoneID is the ID of the public or private Route53 hosted zone the record is created in.
+	// If the hosted zone is private, the cluster VPC is associated with it.
+	// +kubebuilder:validation:MinLength=1
+	HostedZoneID string `json:"hostedZoneId"`
+
+	// Name is the fully qualified domain name of the record, for example api.my-cluster.example.com.
+	// It must be within the domain of the hosted zone.
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
 }
 
 type S3Bucket struct {
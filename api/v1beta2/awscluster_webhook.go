@@ -56,7 +56,17 @@ func (r *AWSCluster) ValidateCreate() error {
 	allErrs = append(allErrs, r.Spec.AdditionalTags.Validate()...)
 	allErrs = append(allErrs, r.Spec.S3Bucket.Validate()...)
 	allErrs = append(allErrs, r.validateNetwork()...)
+	allErrs = append(allErrs, r.Spec.NetworkSpec.TransitGateway.Validate()...)
+	allErrs = append(allErrs, r.Spec.NetworkSpec.ElasticIPPool.Validate()...)
+	allErrs = append(allErrs, r.Spec.NetworkSpec.ValidateAdditionalRoutes()...)
+	allErrs = append(allErrs, r.Spec.NetworkSpec.NetworkACLs.Validate()...)
+	allErrs = append(allErrs, r.Spec.NetworkSpec.VPC.ValidateFlowLogs()...)
+	allErrs = append(allErrs, r.Spec.NetworkSpec.ValidateSecurityGroupEgressRules()...)
+	allErrs = append(allErrs, r.Spec.NetworkSpec.ValidateAdditionalIngressRules()...)
 	allErrs = append(allErrs, r.validateAdditionalIngressRules()...)
+	allErrs = append(allErrs, r.validateControlPlaneLBs()...)
+	allErrs = append(allErrs, r.Spec.ControlPlaneDNS.Validate()...)
+	allErrs = append(allErrs, validatePlacementGroups(r.Spec.PlacementGroups)...)
 
 	return aggregateObjErrors(r.GroupVersionKind().GroupKind(), r.Name, allErrs)
 }
@@ -128,6 +138,28 @@ func (r *AWSCluster) ValidateUpdate(old runtime.Object) error {
 		)
 	}
 
+	if oldC.Spec.SecondaryControlPlaneLoadBalancer != nil {
+		if r.Spec.SecondaryControlPlaneLoadBalancer == nil {
+			allErrs = append(allErrs,
+				field.Invalid(field.NewPath("spec", "secondaryControlPlaneLoadBalancer"),
+					r.Spec.SecondaryControlPlaneLoadBalancer, "field cannot be removed once set"),
+			)
+		} else {
+			if !cmp.Equal(oldC.Spec.SecondaryControlPlaneLoadBalancer.Scheme, r.Spec.SecondaryControlPlaneLoadBalancer.Scheme) {
+				allErrs = append(allErrs,
+					field.Invalid(field.NewPath("spec", "secondaryControlPlaneLoadBalancer", "scheme"),
+						r.Spec.SecondaryControlPlaneLoadBalancer.Scheme, "field is immutable"),
+				)
+			}
+			if !cmp.Equal(oldC.Spec.SecondaryControlPlaneLoadBalancer.Name, r.Spec.SecondaryControlPlaneLoadBalancer.Name) {
+				allErrs = append(allErrs,
+					field.Invalid(field.NewPath("spec", "secondaryControlPlaneLoadBalancer", "name"),
+						r.Spec.SecondaryControlPlaneLoadBalancer.Name, "field is immutable"),
+				)
+			}
+		}
+	}
+
 	if !cmp.Equal(oldC.Spec.ControlPlaneEndpoint, clusterv1.APIEndpoint{}) &&
 		!cmp.Equal(r.Spec.ControlPlaneEndpoint, oldC.Spec.ControlPlaneEndpoint) {
 		allErrs = append(allErrs,
@@ -135,6 +167,13 @@ func (r *AWSCluster) ValidateUpdate(old runtime.Object) error {
 		)
 	}
 
+	// The control plane endpoint is immutable, so is the DNS record it is derived from.
+	if !cmp.Equal(oldC.Spec.ControlPlaneDNS, r.Spec.ControlPlaneDNS) {
+		allErrs = append(allErrs,
+			field.Invalid(field.NewPath("spec", "controlPlaneDNS"), r.Spec.ControlPlaneDNS, "field is immutable"),
+		)
+	}
+
 	// Modifying VPC id is not allowed because it will cause a new VPC creation if set to nil.
 	if !cmp.Equal(oldC.Spec.NetworkSpec, NetworkSpec{}) &&
 		!cmp.Equal(oldC.Spec.NetworkSpec.VPC, VPCSpec{}) &&
@@ -148,6 +187,19 @@ func (r *AWSCluster) ValidateUpdate(old runtime.Object) error {
 		}
 	}
 
+	if !cmp.Equal(oldC.Spec.NetworkSpec.VPC.IPAMPool, r.Spec.NetworkSpec.VPC.IPAMPool) {
+		allErrs = append(allErrs,
+			field.Invalid(field.NewPath("spec", "network", "vpc", "ipamPool"),
+				r.Spec.NetworkSpec.VPC.IPAMPool, "field is immutable"))
+	}
+
+	// The network ACLs are only looked up when configured, removing the field would leak them.
+	if oldC.Spec.NetworkSpec.NetworkACLs != nil && r.Spec.NetworkSpec.NetworkACLs == nil {
+		allErrs = append(allErrs,
+			field.Invalid(field.NewPath("spec", "network", "networkAcls"),
+				r.Spec.NetworkSpec.NetworkACLs, "field cannot be removed once set, unset its public and private network ACLs instead"))
+	}
+
 	// If a identityRef is already set, do not allow removal of it.
 	if oldC.Spec.IdentityRef != nil && r.Spec.IdentityRef == nil {
 		allErrs = append(allErrs,
@@ -166,6 +218,17 @@ func (r *AWSCluster) ValidateUpdate(old runtime.Object) error {
 	allErrs = append(allErrs, r.Spec.Bastion.Validate()...)
 	allErrs = append(allErrs, r.Spec.AdditionalTags.Validate()...)
 	allErrs = append(allErrs, r.Spec.S3Bucket.Validate()...)
+	allErrs = append(allErrs, r.Spec.NetworkSpec.TransitGateway.Validate()...)
+	allErrs = append(allErrs, r.Spec.NetworkSpec.ElasticIPPool.Validate()...)
+	allErrs = append(allErrs, r.Spec.NetworkSpec.ValidateAdditionalRoutes()...)
+	allErrs = append(allErrs, r.Spec.NetworkSpec.NetworkACLs.Validate()...)
+	allErrs = append(allErrs, r.Spec.NetworkSpec.VPC.ValidateFlowLogs()...)
+	allErrs = append(allErrs, r.Spec.NetworkSpec.ValidateSecurityGroupEgressRules()...)
+	allErrs = append(allErrs, r.Spec.NetworkSpec.ValidateAdditionalIngressRules()...)
+	allErrs = append(allErrs, r.validateAdditionalIngressRules()...)
+	allErrs = append(allErrs, r.validateControlPlaneLBs()...)
+	allErrs = append(allErrs, validatePlacementGroups(r.Spec.PlacementGroups)...)
+	allErrs = append(allErrs, validatePlacementGroupsUpdate(oldC.Spec.PlacementGroups, r.Spec.PlacementGroups)...)
 
 	return aggregateObjErrors(r.GroupVersionKind().GroupKind(), r.Name, allErrs)
 }
@@ -189,6 +252,36 @@ func (r *AWSCluster) validateNetwork() field.ErrorList {
 			allErrs = append(allErrs, field.Invalid(field.NewPath("subnets"), r.Spec.NetworkSpec.Subnets, "IPv6 cannot be used with unmanaged clusters at this time."))
 		}
 	}
+	allErrs = append(allErrs, r.Spec.NetworkSpec.VPC.ValidateIPAMPool()...)
+	allErrs = append(allErrs, r.validateVPCEndpoints()...)
+	return allErrs
+}
+
+func (r *AWSCluster) validateVPCEndpoints() field.ErrorList {
+	var allErrs field.ErrorList
+
+	endpointsPath := field.NewPath("spec", "network", "vpc", "endpoints")
+	serviceNames := map[string]struct{}{}
+	for i, endpoint := range r.Spec.NetworkSpec.VPC.Endpoints {
+		if _, ok := serviceNames[endpoint.ServiceName]; ok {
+			allErrs = append(allErrs, field.Duplicate(endpointsPath.Index(i).Child("serviceName"), endpoint.ServiceName))
+		}
+		serviceNames[endpoint.ServiceName] = struct{}{}
+
+		if !endpoint.IsGateway() {
+			continue
+		}
+		if len(endpoint.SubnetIDs) > 0 {
+			allErrs = append(allErrs, field.Forbidden(endpointsPath.Index(i).Child("subnetIds"), "subnets cannot be set on gateway endpoints"))
+		}
+		if len(endpoint.SecurityGroupIDs) > 0 {
+			allErrs = append(allErrs, field.Forbidden(endpointsPath.Index(i).Child("securityGroupIds"), "security groups cannot be set on gateway endpoints"))
+		}
+		if endpoint.PrivateDNSEnabled != nil {
+			allErrs = append(allErrs, field.Forbidden(endpointsPath.Index(i).Child("privateDnsEnabled"), "private DNS cannot be set on gateway endpoints"))
+		}
+	}
+
 	return allErrs
 }
 
@@ -205,5 +298,52 @@ func (r *AWSCluster) validateAdditionalIngressRules() field.ErrorList {
 		}
 	}
 
+	ingressRulesPath := field.NewPath("spec", "controlPlaneLoadBalancer", "ingressRules")
+	for i := range r.Spec.ControlPlaneLoadBalancer.IngressRules {
+		rule := r.Spec.ControlPlaneLoadBalancer.IngressRules[i]
+		allErrs = append(allErrs, rule.validatePrefixListSources(ingressRulesPath.Index(i))...)
+	}
+
+	return allErrs
+}
+
+func (r *AWSCluster) validateControlPlaneLBs() field.ErrorList {
+	var allErrs field.ErrorList
+
+	secondary := r.Spec.SecondaryControlPlaneLoadBalancer
+	if secondary == nil {
+		return allErrs
+	}
+
+	// The secondary load balancer name cannot be generated, as it would collide with the
+	// default name generated for the primary control plane load balancer.
+	if secondary.Name == nil || *secondary.Name == "" {
+		allErrs = append(allErrs, field.Required(field.NewPath("spec", "secondaryControlPlaneLoadBalancer", "name"), "secondary control plane load balancer name is required"))
+	}
+
+	primary := r.Spec.ControlPlaneLoadBalancer
+	if primary == nil {
+		primary = &AWSLoadBalancerSpec{}
+	}
+
+	if secondary.Name != nil && primary.Name != nil && *secondary.Name == *primary.Name {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "secondaryControlPlaneLoadBalancer", "name"), *secondary.Name, "control plane load balancer names must be unique"))
+	}
+
+	primaryScheme, secondaryScheme := ELBSchemeInternetFacing, ELBSchemeInternetFacing
+	if primary.Scheme != nil {
+		primaryScheme = *primary.Scheme
+	}
+	if secondary.Scheme != nil {
+		secondaryScheme = *secondary.Scheme
+	}
+	if primaryScheme == secondaryScheme {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "secondaryControlPlaneLoadBalancer", "scheme"), secondaryScheme, "control plane load balancers must have different schemes"))
+	}
+
+	if secondary.LoadBalancerType != LoadBalancerTypeNLB {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "secondaryControlPlaneLoadBalancer", "loadBalancerType"), secondary.LoadBalancerType, "secondary control plane load balancer must be a network load balancer"))
+	}
+
 	return allErrs
 }
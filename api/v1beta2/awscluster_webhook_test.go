@@ -251,6 +251,729 @@ func TestAWSClusterValidateCreate(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "accepts vpc endpoints",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					NetworkSpec: NetworkSpec{
+						VPC: VPCSpec{
+							Endpoints: []VPCEndpointSpec{
+								{
+									ServiceName: "s3",
+									Type:        VPCEndpointTypeGateway,
+								},
+								{
+									ServiceName:      "ecr.api",
+									Type:             VPCEndpointTypeInterface,
+									SecurityGroupIDs: []string{"sg-1"},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "rejects duplicate vpc endpoint service names",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					NetworkSpec: NetworkSpec{
+						VPC: VPCSpec{
+							Endpoints: []VPCEndpointSpec{
+								{ServiceName: "sts"},
+								{ServiceName: "sts"},
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "rejects subnets on gateway vpc endpoints",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					NetworkSpec: NetworkSpec{
+						VPC: VPCSpec{
+							Endpoints: []VPCEndpointSpec{
+								{
+									ServiceName: "s3",
+									Type:        VPCEndpointTypeGateway,
+									SubnetIDs:   []string{"subnet-1"},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "accepts transit gateway with route cidrs",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					NetworkSpec: NetworkSpec{
+						TransitGateway: &TransitGatewaySpec{
+							ID:         "tgw-0123456789abcdef0",
+							RouteCIDRs: []string{"10.100.0.0/16", "192.168.0.0/24"},
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "rejects transit gateway with invalid route cidr",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					NetworkSpec: NetworkSpec{
+						TransitGateway: &TransitGatewaySpec{
+							ID:         "tgw-0123456789abcdef0",
+							RouteCIDRs: []string{"10.100.0.0"},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "rejects transit gateway with default route",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					NetworkSpec: NetworkSpec{
+						TransitGateway: &TransitGatewaySpec{
+							ID:         "tgw-0123456789abcdef0",
+							RouteCIDRs: []string{"0.0.0.0/0"},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "accepts elastic ip pool",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					NetworkSpec: NetworkSpec{
+						ElasticIPPool: &ElasticIPPool{
+							PublicIpv4Pool: aws.String("ipv4pool-ec2-0123456789abcdef0"),
+							AllocationIDs:  []string{"eipalloc-0123456789abcdef0"},
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "rejects empty elastic ip pool",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					NetworkSpec: NetworkSpec{
+						ElasticIPPool: &ElasticIPPool{},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "rejects elastic ip pool with invalid allocation id",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					NetworkSpec: NetworkSpec{
+						ElasticIPPool: &ElasticIPPool{
+							AllocationIDs: []string{"eipalloc-1", "1.2.3.4"},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "rejects elastic ip pool with duplicate allocation ids",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					NetworkSpec: NetworkSpec{
+						ElasticIPPool: &ElasticIPPool{
+							AllocationIDs: []string{"eipalloc-1", "eipalloc-1"},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "accepts additional routes",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					NetworkSpec: NetworkSpec{
+						AdditionalRoutes: &AdditionalRoutes{
+							Private: []Route{
+								{
+									DestinationCIDRBlock:   "10.20.0.0/16",
+									VPCPeeringConnectionID: "pcx-0123456789abcdef0",
+								},
+								{
+									DestinationPrefixListID: "pl-0123456789abcdef0",
+									TransitGatewayID:        "tgw-0123456789abcdef0",
+								},
+							},
+						},
+						Subnets: Subnets{
+							{
+								ID: "subnet-1",
+								AdditionalRoutes: []Route{
+									{
+										DestinationCIDRBlock:    "10.30.0.0/16",
+										VirtualPrivateGatewayID: "vgw-0123456789abcdef0",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "rejects additional route with several targets",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					NetworkSpec: NetworkSpec{
+						AdditionalRoutes: &AdditionalRoutes{
+							Public: []Route{
+								{
+									DestinationCIDRBlock:    "10.20.0.0/16",
+									VPCPeeringConnectionID:  "pcx-0123456789abcdef0",
+									VirtualPrivateGatewayID: "vgw-0123456789abcdef0",
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "rejects additional default route",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					NetworkSpec: NetworkSpec{
+						Subnets: Subnets{
+							{
+								ID: "subnet-1",
+								AdditionalRoutes: []Route{
+									{
+										DestinationCIDRBlock: "0.0.0.0/0",
+										TransitGatewayID:     "tgw-0123456789abcdef0",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "rejects duplicate additional route destinations",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					NetworkSpec: NetworkSpec{
+						AdditionalRoutes: &AdditionalRoutes{
+							Private: []Route{
+								{
+									DestinationCIDRBlock:   "10.20.0.0/16",
+									VPCPeeringConnectionID: "pcx-0123456789abcdef0",
+								},
+								{
+									DestinationCIDRBlock: "10.20.0.0/16",
+									TransitGatewayID:     "tgw-0123456789abcdef0",
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "accepts flow logs",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					NetworkSpec: NetworkSpec{
+						VPC: VPCSpec{
+							FlowLogs: []VPCFlowLog{
+								{
+									DestinationType: FlowLogDestinationTypeCloudWatchLogs,
+									DestinationARN:  "arn:aws:logs:us-east-1:123456789012:log-group:flow-logs",
+									IAMRoleARN:      "arn:aws:iam::123456789012:role/flow-logs",
+								},
+								{
+									DestinationType: FlowLogDestinationTypeS3,
+									DestinationARN:  "arn:aws:s3:::flow-logs-bucket/cluster",
+									TrafficType:     FlowLogTrafficTypeReject,
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "rejects cloudwatch flow log without IAM role",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					NetworkSpec: NetworkSpec{
+						VPC: VPCSpec{
+							FlowLogs: []VPCFlowLog{
+								{
+									DestinationType: FlowLogDestinationTypeCloudWatchLogs,
+									DestinationARN:  "arn:aws:logs:us-east-1:123456789012:log-group:flow-logs",
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "rejects s3 flow log with a log group destination",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					NetworkSpec: NetworkSpec{
+						VPC: VPCSpec{
+							FlowLogs: []VPCFlowLog{
+								{
+									DestinationType: FlowLogDestinationTypeS3,
+									DestinationARN:  "arn:aws:logs:us-east-1:123456789012:log-group:flow-logs",
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "accepts security group egress rules",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					NetworkSpec: NetworkSpec{
+						SecurityGroupEgressRules: map[SecurityGroupRole]EgressRules{
+							SecurityGroupNode: {
+								{
+									Description: "HTTPS",
+									Protocol:    SecurityGroupProtocolTCP,
+									FromPort:    443,
+									ToPort:      443,
+									CidrBlocks:  []string{"0.0.0.0/0"},
+								},
+								{
+									Description:                   "Cluster",
+									Protocol:                      SecurityGroupProtocolAll,
+									DestinationSecurityGroupRoles: []SecurityGroupRole{SecurityGroupNode, SecurityGroupControlPlane},
+								},
+							},
+							SecurityGroupBastion: {},
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "rejects security group egress rules without destination",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					NetworkSpec: NetworkSpec{
+						SecurityGroupEgressRules: map[SecurityGroupRole]EgressRules{
+							SecurityGroupNode: {
+								{
+									Protocol: SecurityGroupProtocolTCP,
+									FromPort: 443,
+									ToPort:   443,
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "rejects security group egress rules on the lb security group",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					NetworkSpec: NetworkSpec{
+						SecurityGroupEgressRules: map[SecurityGroupRole]EgressRules{
+							SecurityGroupLB: {
+								{
+									Protocol:   SecurityGroupProtocolAll,
+									CidrBlocks: []string{"10.0.0.0/8"},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "accepts additional ingress rules",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					NetworkSpec: NetworkSpec{
+						AdditionalIngressRules: map[SecurityGroupRole]IngressRules{
+							SecurityGroupNode: {
+								{
+									Description: "VPN",
+									Protocol:    SecurityGroupProtocolTCP,
+									FromPort:    8080,
+									ToPort:      8080,
+									CidrBlocks:  []string{"192.168.0.0/16"},
+								},
+							},
+							SecurityGroupControlPlane: {
+								{
+									Description:              "Monitoring",
+									Protocol:                 SecurityGroupProtocolTCP,
+									FromPort:                 9100,
+									ToPort:                   9100,
+									SourceSecurityGroupRoles: []SecurityGroupRole{SecurityGroupNode},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "rejects additional ingress rules mixing CIDR blocks and source security groups",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					NetworkSpec: NetworkSpec{
+						AdditionalIngressRules: map[SecurityGroupRole]IngressRules{
+							SecurityGroupNode: {
+								{
+									Description:              "VPN",
+									Protocol:                 SecurityGroupProtocolTCP,
+									FromPort:                 8080,
+									ToPort:                   8080,
+									CidrBlocks:               []string{"192.168.0.0/16"},
+									SourceSecurityGroupRoles: []SecurityGroupRole{SecurityGroupControlPlane},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "rejects additional ingress rules with an invalid port range",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					NetworkSpec: NetworkSpec{
+						AdditionalIngressRules: map[SecurityGroupRole]IngressRules{
+							SecurityGroupNode: {
+								{
+									Description: "VPN",
+									Protocol:    SecurityGroupProtocolTCP,
+									FromPort:    8080,
+									ToPort:      80,
+									CidrBlocks:  []string{"192.168.0.0/16"},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "accepts prefix lists as ingress rule sources",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					ControlPlaneLoadBalancer: &AWSLoadBalancerSpec{
+						IngressRules: []IngressRule{
+							{
+								Description:         "VPN",
+								Protocol:            SecurityGroupProtocolTCP,
+								FromPort:            6443,
+								ToPort:              6443,
+								SourcePrefixListIDs: []string{"pl-0123456789abcdef0"},
+							},
+						},
+					},
+					NetworkSpec: NetworkSpec{
+						AdditionalIngressRules: map[SecurityGroupRole]IngressRules{
+							SecurityGroupNode: {
+								{
+									Description:         "VPN",
+									Protocol:            SecurityGroupProtocolTCP,
+									FromPort:            8080,
+									ToPort:              8080,
+									SourcePrefixListIDs: []string{"pl-0123456789abcdef0"},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "rejects control plane load balancer ingress rules mixing prefix lists and CIDR blocks",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					ControlPlaneLoadBalancer: &AWSLoadBalancerSpec{
+						IngressRules: []IngressRule{
+							{
+								Description:         "VPN",
+								Protocol:            SecurityGroupProtocolTCP,
+								FromPort:            6443,
+								ToPort:              6443,
+								CidrBlocks:          []string{"192.168.0.0/16"},
+								SourcePrefixListIDs: []string{"pl-0123456789abcdef0"},
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "rejects additional ingress rules with an invalid prefix list id",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					NetworkSpec: NetworkSpec{
+						AdditionalIngressRules: map[SecurityGroupRole]IngressRules{
+							SecurityGroupNode: {
+								{
+									Description:         "VPN",
+									Protocol:            SecurityGroupProtocolTCP,
+									FromPort:            8080,
+									ToPort:              8080,
+									SourcePrefixListIDs: []string{"sg-0123456789abcdef0"},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "rejects security group egress rules on a security group override",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					NetworkSpec: NetworkSpec{
+						SecurityGroupOverrides: map[SecurityGroupRole]string{
+							SecurityGroupNode: "sg-node",
+						},
+						SecurityGroupEgressRules: map[SecurityGroupRole]EgressRules{
+							SecurityGroupNode: {
+								{
+									Protocol:   SecurityGroupProtocolAll,
+									CidrBlocks: []string{"10.0.0.0/8"},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "accepts network ACLs",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					NetworkSpec: NetworkSpec{
+						NetworkACLs: &NetworkACLs{
+							Public: &NetworkACL{
+								Ingress: []NetworkACLRule{
+									{
+										RuleNumber: 100,
+										Protocol:   NetworkACLProtocolTCP,
+										RuleAction: NetworkACLRuleActionAllow,
+										CIDRBlock:  "0.0.0.0/0",
+										FromPort:   aws.Int64(443),
+										ToPort:     aws.Int64(443),
+									},
+								},
+								Egress: []NetworkACLRule{
+									{
+										RuleNumber: 100,
+										Protocol:   NetworkACLProtocolAll,
+										RuleAction: NetworkACLRuleActionAllow,
+										CIDRBlock:  "0.0.0.0/0",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "rejects network ACL rule without ports for TCP",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					NetworkSpec: NetworkSpec{
+						NetworkACLs: &NetworkACLs{
+							Private: &NetworkACL{
+								Ingress: []NetworkACLRule{
+									{
+										RuleNumber: 100,
+										Protocol:   NetworkACLProtocolTCP,
+										RuleAction: NetworkACLRuleActionAllow,
+										CIDRBlock:  "10.0.0.0/16",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "rejects duplicate network ACL rule numbers",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					NetworkSpec: NetworkSpec{
+						NetworkACLs: &NetworkACLs{
+							Private: &NetworkACL{
+								Egress: []NetworkACLRule{
+									{
+										RuleNumber: 100,
+										Protocol:   NetworkACLProtocolAll,
+										RuleAction: NetworkACLRuleActionAllow,
+										CIDRBlock:  "10.0.0.0/16",
+									},
+									{
+										RuleNumber:    100,
+										Protocol:      NetworkACLProtocolAll,
+										RuleAction:    NetworkACLRuleActionDeny,
+										IPv6CIDRBlock: "::/0",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "accepts control plane dns",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					ControlPlaneDNS: &ControlPlaneDNS{
+						HostedZoneID: "Z0123456789ABCDEFGHIJ",
+						Name:         "api.test-cluster.example.com.",
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "rejects control plane dns with invalid name",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					ControlPlaneDNS: &ControlPlaneDNS{
+						HostedZoneID: "Z0123456789ABCDEFGHIJ",
+						Name:         "api_test-cluster.example.com",
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "accepts placement groups",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					PlacementGroups: []PlacementGroup{
+						{Name: "pg-cluster", Strategy: PlacementGroupStrategyCluster},
+						{Name: "pg-partition", Strategy: PlacementGroupStrategyPartition, PartitionCount: aws.Int64(3)},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "rejects duplicate placement group names",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					PlacementGroups: []PlacementGroup{
+						{Name: "pg", Strategy: PlacementGroupStrategyCluster},
+						{Name: "pg", Strategy: PlacementGroupStrategySpread},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "rejects partition count on a spread placement group",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					PlacementGroups: []PlacementGroup{
+						{Name: "pg-spread", Strategy: PlacementGroupStrategySpread, PartitionCount: aws.Int64(3)},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "accepts ipam pool",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					NetworkSpec: NetworkSpec{
+						VPC: VPCSpec{
+							IPAMPool: &IPAMPool{
+								Name:          "cluster-pool",
+								NetmaskLength: 18,
+							},
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "rejects ipam pool without id or name",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					NetworkSpec: NetworkSpec{
+						VPC: VPCSpec{
+							IPAMPool: &IPAMPool{},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "rejects ipam pool with cidr block",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					NetworkSpec: NetworkSpec{
+						VPC: VPCSpec{
+							CidrBlock: "10.0.0.0/16",
+							IPAMPool: &IPAMPool{
+								ID: "ipam-pool-0123456789abcdef0",
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
 		{
 			name: "rejects ingress rules with cidr block and source security group id",
 			cluster: &AWSCluster{
@@ -335,6 +1058,60 @@ func TestAWSClusterValidateCreate(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "accepts an internal secondary network load balancer",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					ControlPlaneLoadBalancer: &AWSLoadBalancerSpec{
+						LoadBalancerType: LoadBalancerTypeNLB,
+					},
+					SecondaryControlPlaneLoadBalancer: &AWSLoadBalancerSpec{
+						Name:             aws.String("internal-apiserver"),
+						Scheme:           &ELBSchemeInternal,
+						LoadBalancerType: LoadBalancerTypeNLB,
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "rejects a secondary load balancer without a name",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					SecondaryControlPlaneLoadBalancer: &AWSLoadBalancerSpec{
+						Scheme:           &ELBSchemeInternal,
+						LoadBalancerType: LoadBalancerTypeNLB,
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "rejects a secondary load balancer with the same scheme as the primary",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					SecondaryControlPlaneLoadBalancer: &AWSLoadBalancerSpec{
+						Name:             aws.String("secondary-apiserver"),
+						Scheme:           &ELBSchemeInternetFacing,
+						LoadBalancerType: LoadBalancerTypeNLB,
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "rejects a secondary load balancer that is not a network load balancer",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					SecondaryControlPlaneLoadBalancer: &AWSLoadBalancerSpec{
+						Name:             aws.String("internal-apiserver"),
+						Scheme:           &ELBSchemeInternal,
+						LoadBalancerType: LoadBalancerTypeClassic,
+					},
+				},
+			},
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -641,6 +1418,162 @@ func TestAWSClusterValidateUpdate(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "secondaryControlPlaneLoadBalancer cannot be removed once set",
+			oldCluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					SecondaryControlPlaneLoadBalancer: &AWSLoadBalancerSpec{
+						Name:             aws.String("internal-apiserver"),
+						Scheme:           &ELBSchemeInternal,
+						LoadBalancerType: LoadBalancerTypeNLB,
+					},
+				},
+			},
+			newCluster: &AWSCluster{
+				Spec: AWSClusterSpec{},
+			},
+			wantErr: true,
+		},
+		{
+			name: "secondaryControlPlaneLoadBalancer name is immutable",
+			oldCluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					SecondaryControlPlaneLoadBalancer: &AWSLoadBalancerSpec{
+						Name:             aws.String("internal-apiserver"),
+						Scheme:           &ELBSchemeInternal,
+						LoadBalancerType: LoadBalancerTypeNLB,
+					},
+				},
+			},
+			newCluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					SecondaryControlPlaneLoadBalancer: &AWSLoadBalancerSpec{
+						Name:             aws.String("internal-apiserver-2"),
+						Scheme:           &ELBSchemeInternal,
+						LoadBalancerType: LoadBalancerTypeNLB,
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "controlPlaneDNS is immutable",
+			oldCluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					ControlPlaneDNS: &ControlPlaneDNS{
+						HostedZoneID: "Z0123456789ABCDEFGHIJ",
+						Name:         "api.test-cluster.example.com",
+					},
+				},
+			},
+			newCluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					ControlPlaneDNS: &ControlPlaneDNS{
+						HostedZoneID: "Z0123456789ABCDEFGHIJ",
+						Name:         "api2.test-cluster.example.com",
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "placement groups are immutable",
+			oldCluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					PlacementGroups: []PlacementGroup{
+						{Name: "pg", Strategy: PlacementGroupStrategyPartition, PartitionCount: aws.Int64(2)},
+					},
+				},
+			},
+			newCluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					PlacementGroups: []PlacementGroup{
+						{Name: "pg", Strategy: PlacementGroupStrategyPartition, PartitionCount: aws.Int64(3)},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "placement groups can be added and removed",
+			oldCluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					PlacementGroups: []PlacementGroup{
+						{Name: "pg-cluster", Strategy: PlacementGroupStrategyCluster},
+					},
+				},
+			},
+			newCluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					PlacementGroups: []PlacementGroup{
+						{Name: "pg-spread", Strategy: PlacementGroupStrategySpread},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "ipamPool is immutable",
+			oldCluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					NetworkSpec: NetworkSpec{
+						VPC: VPCSpec{
+							IPAMPool: &IPAMPool{
+								ID: "ipam-pool-0123456789abcdef0",
+							},
+						},
+					},
+				},
+			},
+			newCluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					NetworkSpec: NetworkSpec{
+						VPC: VPCSpec{
+							IPAMPool: &IPAMPool{
+								ID: "ipam-pool-0123456789abcdef1",
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "networkAcls cannot be removed once set",
+			oldCluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					NetworkSpec: NetworkSpec{
+						NetworkACLs: &NetworkACLs{},
+					},
+				},
+			},
+			newCluster: &AWSCluster{
+				Spec: AWSClusterSpec{},
+			},
+			wantErr: true,
+		},
+		{
+			name: "rejects control plane load balancer ingress rules with an invalid prefix list id",
+			oldCluster: &AWSCluster{
+				Spec: AWSClusterSpec{},
+			},
+			newCluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					ControlPlaneLoadBalancer: &AWSLoadBalancerSpec{
+						IngressRules: []IngressRule{
+							{
+								Description:         "VPN",
+								Protocol:            SecurityGroupProtocolTCP,
+								FromPort:            6443,
+								ToPort:              6443,
+								SourcePrefixListIDs: []string{"sg-0123456789abcdef0"},
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
 		{
 			name: "Should fail if controlPlaneLoadBalancer healthcheckprotocol is changed to non-default if it was not set before update",
 			oldCluster: &AWSCluster{
@@ -48,6 +48,15 @@ func (r *AWSClusterTemplate) ValidateCreate() error {
 
 	allErrs = append(allErrs, r.Spec.Template.Spec.Bastion.Validate()...)
 	allErrs = append(allErrs, validateSSHKeyName(r.Spec.Template.Spec.SSHKeyName)...)
+	allErrs = append(allErrs, r.Spec.Template.Spec.NetworkSpec.VPC.ValidateIPAMPool()...)
+	allErrs = append(allErrs, r.Spec.Template.Spec.NetworkSpec.TransitGateway.Validate()...)
+	allErrs = append(allErrs, r.Spec.Template.Spec.NetworkSpec.ElasticIPPool.Validate()...)
+	allErrs = append(allErrs, r.Spec.Template.Spec.NetworkSpec.ValidateAdditionalRoutes()...)
+	allErrs = append(allErrs, r.Spec.Template.Spec.NetworkSpec.NetworkACLs.Validate()...)
+	allErrs = append(allErrs, r.Spec.Template.Spec.NetworkSpec.VPC.ValidateFlowLogs()...)
+	allErrs = append(allErrs, r.Spec.Template.Spec.NetworkSpec.ValidateSecurityGroupEgressRules()...)
+	allErrs = append(allErrs, r.Spec.Template.Spec.NetworkSpec.ValidateAdditionalIngressRules()...)
+	allErrs = append(allErrs, r.Spec.Template.Spec.ControlPlaneDNS.Validate()...)
 
 	return aggregateObjErrors(r.GroupVersionKind().GroupKind(), r.Name, allErrs)
 }
@@ -0,0 +1,110 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta2
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+)
+
+// log is for logging in this package.
+var _ = ctrl.Log.WithName("awsclusterwebidentity-resource")
+
+func (r *AWSClusterWebIdentity) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(r).
+		Complete()
+}
+
+// +kubebuilder:webhook:verbs=create;update,path=/validate-infrastructure-cluster-x-k8s-io-v1beta2-awsclusterwebidentity,mutating=false,failurePolicy=fail,matchPolicy=Equivalent,groups=infrastructure.cluster.x-k8s.io,resources=awsclusterwebidentities,versions=v1beta2,name=validation.awsclusterwebidentity.infrastructure.cluster.x-k8s.io,sideEffects=None,admissionReviewVersions=v1;v1beta1
+// +kubebuilder:webhook:verbs=create;update,path=/mutate-infrastructure-cluster-x-k8s-io-v1beta2-awsclusterwebidentity,mutating=true,failurePolicy=fail,matchPolicy=Equivalent,groups=infrastructure.cluster.x-k8s.io,resources=awsclusterwebidentities,versions=v1beta2,name=default.awsclusterwebidentity.infrastructure.cluster.x-k8s.io,sideEffects=None,admissionReviewVersions=v1;v1beta1
+
+var (
+	_ webhook.Validator = &AWSClusterWebIdentity{}
+	_ webhook.Defaulter = &AWSClusterWebIdentity{}
+)
+
+// ValidateCreate will do any extra validation when creating an AWSClusterWebIdentity.
+func (r *AWSClusterWebIdentity) ValidateCreate() error {
+	return aggregateObjErrors(r.GroupVersionKind().GroupKind(), r.Name, r.validateSpec())
+}
+
+// ValidateDelete allows you to add any extra validation when deleting an AWSClusterWebIdentity.
+func (r *AWSClusterWebIdentity) ValidateDelete() error {
+	return nil
+}
+
+// ValidateUpdate will do any extra validation when updating an AWSClusterWebIdentity.
+func (r *AWSClusterWebIdentity) ValidateUpdate(old runtime.Object) error {
+	oldP, ok := old.(*AWSClusterWebIdentity)
+	if !ok {
+		return apierrors.NewBadRequest(fmt.Sprintf("expected an AWSClusterWebIdentity but got a %T", old))
+	}
+
+	allErrs := r.validateSpec()
+
+	if oldP.Spec.TokenSecretRef != "" && oldP.Spec.TokenSecretRef != r.Spec.TokenSecretRef {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "tokenSecretRef"),
+			r.Spec.TokenSecretRef, "field cannot be updated"))
+	}
+
+	return aggregateObjErrors(r.GroupVersionKind().GroupKind(), r.Name, allErrs)
+}
+
+// Default will set default values for the AWSClusterWebIdentity.
+func (r *AWSClusterWebIdentity) Default() {
+	SetDefaults_Labels(&r.ObjectMeta)
+	if r.Spec.TokenFile == "" && r.Spec.TokenSecretRef == "" {
+		r.Spec.TokenFile = DefaultWebIdentityTokenFile
+	}
+}
+
+func (r *AWSClusterWebIdentity) validateSpec() field.ErrorList {
+	var allErrs field.ErrorList
+
+	specPath := field.NewPath("spec")
+	if !strings.HasPrefix(r.Spec.RoleArn, "arn:") {
+		allErrs = append(allErrs, field.Invalid(specPath.Child("roleARN"), r.Spec.RoleArn, "must be an IAM role ARN"))
+	}
+
+	switch {
+	case r.Spec.TokenFile != "" && r.Spec.TokenSecretRef != "":
+		allErrs = append(allErrs, field.Forbidden(specPath, "only one of tokenFile or tokenSecretRef may be specified, specifying both is forbidden"))
+	case r.Spec.TokenFile == "" && r.Spec.TokenSecretRef == "":
+		allErrs = append(allErrs, field.Required(specPath, "either tokenFile or tokenSecretRef must be set"))
+	case r.Spec.TokenFile != "" && !filepath.IsAbs(r.Spec.TokenFile):
+		allErrs = append(allErrs, field.Invalid(specPath.Child("tokenFile"), r.Spec.TokenFile, "must be an absolute path"))
+	}
+
+	// Validate selector parses as Selector
+	if r.Spec.AllowedNamespaces != nil {
+		_, err := metav1.LabelSelectorAsSelector(&r.Spec.AllowedNamespaces.Selector)
+		if err != nil {
+			allErrs = append(allErrs, field.Invalid(specPath.Child("allowedNamespaces", "selector"), r.Spec.AllowedNamespaces.Selector, err.Error()))
+		}
+	}
+
+	return allErrs
+}
@@ -0,0 +1,184 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta2
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func TestAWSClusterWebIdentityCreateValidation(t *testing.T) {
+	tests := []struct {
+		name      string
+		spec      AWSClusterWebIdentitySpec
+		wantError bool
+	}{
+		{
+			name: "should default to the projected service account token",
+			spec: AWSClusterWebIdentitySpec{
+				AWSRoleSpec: AWSRoleSpec{RoleArn: "arn:aws:iam::123456789012:role/capa"},
+			},
+			wantError: false,
+		},
+		{
+			name: "should accept a token secret",
+			spec: AWSClusterWebIdentitySpec{
+				AWSRoleSpec:    AWSRoleSpec{RoleArn: "arn:aws:iam::123456789012:role/capa"},
+				TokenSecretRef: "oidc-token",
+			},
+			wantError: false,
+		},
+		{
+			name: "should reject both a token file and a token secret",
+			spec: AWSClusterWebIdentitySpec{
+				AWSRoleSpec:    AWSRoleSpec{RoleArn: "arn:aws:iam::123456789012:role/capa"},
+				TokenFile:      "/var/run/secrets/token",
+				TokenSecretRef: "oidc-token",
+			},
+			wantError: true,
+		},
+		{
+			name: "should reject a relative token file",
+			spec: AWSClusterWebIdentitySpec{
+				AWSRoleSpec: AWSRoleSpec{RoleArn: "arn:aws:iam::123456789012:role/capa"},
+				TokenFile:   "token",
+			},
+			wantError: true,
+		},
+		{
+			name: "should reject an invalid role ARN",
+			spec: AWSClusterWebIdentitySpec{
+				AWSRoleSpec: AWSRoleSpec{RoleArn: "capa"},
+			},
+			wantError: true,
+		},
+		{
+			name: "should reject an invalid selector",
+			spec: AWSClusterWebIdentitySpec{
+				AWSClusterIdentitySpec: AWSClusterIdentitySpec{
+					AllowedNamespaces: &AllowedNamespaces{
+						Selector: metav1.LabelSelector{
+							MatchLabels: map[string]string{"-123-foo": "bar"},
+						},
+					},
+				},
+				AWSRoleSpec: AWSRoleSpec{RoleArn: "arn:aws:iam::123456789012:role/capa"},
+			},
+			wantError: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			identity := &AWSClusterWebIdentity{
+				TypeMeta: metav1.TypeMeta{
+					APIVersion: GroupVersion.String(),
+					Kind:       string(ClusterWebIdentityKind),
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "web",
+				},
+				Spec: tt.spec,
+			}
+
+			ctx := context.TODO()
+			if err := testEnv.Create(ctx, identity); (err != nil) != tt.wantError {
+				t.Errorf("ValidateCreate() error = %v, wantErr %v", err, tt.wantError)
+			}
+			testEnv.Delete(ctx, identity)
+		})
+	}
+}
+
+func TestAWSClusterWebIdentityDefault(t *testing.T) {
+	g := NewWithT(t)
+
+	identity := &AWSClusterWebIdentity{
+		Spec: AWSClusterWebIdentitySpec{
+			AWSRoleSpec: AWSRoleSpec{RoleArn: "arn:aws:iam::123456789012:role/capa"},
+		},
+	}
+	identity.Default()
+	g.Expect(identity.Spec.TokenFile).To(Equal(DefaultWebIdentityTokenFile))
+
+	identity = &AWSClusterWebIdentity{
+		Spec: AWSClusterWebIdentitySpec{
+			AWSRoleSpec:    AWSRoleSpec{RoleArn: "arn:aws:iam::123456789012:role/capa"},
+			TokenSecretRef: "oidc-token",
+		},
+	}
+	identity.Default()
+	g.Expect(identity.Spec.TokenFile).To(BeEmpty())
+}
+
+func TestAWSClusterWebIdentityValidateUpdate(t *testing.T) {
+	g := NewWithT(t)
+
+	webIdentity := &AWSClusterWebIdentity{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: GroupVersion.String(),
+			Kind:       string(ClusterWebIdentityKind),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "web",
+		},
+		Spec: AWSClusterWebIdentitySpec{
+			AWSRoleSpec:    AWSRoleSpec{RoleArn: "arn:aws:iam::123456789012:role/capa"},
+			TokenSecretRef: "oidc-token",
+		},
+	}
+
+	ctx := context.TODO()
+	g.Expect(testEnv.Create(ctx, webIdentity)).To(Succeed())
+	defer testEnv.Delete(ctx, webIdentity)
+
+	tests := []struct {
+		name      string
+		spec      AWSClusterWebIdentitySpec
+		wantError bool
+	}{
+		{
+			name: "should allow changing the role",
+			spec: AWSClusterWebIdentitySpec{
+				AWSRoleSpec:    AWSRoleSpec{RoleArn: "arn:aws:iam::123456789012:role/other"},
+				TokenSecretRef: "oidc-token",
+			},
+			wantError: false,
+		},
+		{
+			name: "should not allow changing the token secret",
+			spec: AWSClusterWebIdentitySpec{
+				AWSRoleSpec:    AWSRoleSpec{RoleArn: "arn:aws:iam::123456789012:role/capa"},
+				TokenSecretRef: "other-token",
+			},
+			wantError: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			identity := &AWSClusterWebIdentity{}
+			g.Expect(testEnv.Get(ctx, client.ObjectKeyFromObject(webIdentity), identity)).To(Succeed())
+			identity.Spec = tt.spec
+			if err := testEnv.Update(ctx, identity); (err != nil) != tt.wantError {
+				t.Errorf("ValidateUpdate() error = %v, wantErr %v", err, tt.wantError)
+			}
+		})
+	}
+}
@@ -20,6 +20,16 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+const (
+	// DefaultWebIdentityTokenFile is the path of the projected service account token used by an
+	// AWSClusterWebIdentity that does not set a token source.
+	DefaultWebIdentityTokenFile = "/var/run/secrets/sts.amazonaws.com/serviceaccount/token"
+
+	// WebIdentityTokenSecretKey is the data key of the OIDC token in the secret referenced by an
+	// AWSClusterWebIdentity.
+	WebIdentityTokenSecretKey = "Token"
+)
+
 // AWSClusterIdentitySpec defines the Spec struct for AWSClusterIdentity types.
 type AWSClusterIdentitySpec struct {
 	// AllowedNamespaces is used to identify which namespaces are allowed to use the identity from.
@@ -181,6 +191,52 @@ type AWSClusterControllerIdentitySpec struct {
 	AWSClusterIdentitySpec `json:",inline"`
 }
 
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:path=awsclusterwebidentities,scope=Cluster,categories=cluster-api,shortName=awswi
+// +kubebuilder:storageversion
+// +k8s:defaulter-gen=true
+
+// AWSClusterWebIdentity is the Schema for the awsclusterwebidentities API
+// It is used to assume a role by exchanging an OIDC token, such as a projected service account token,
+// with AWS STS, so that no long-lived credentials have to be stored in the management cluster.
+type AWSClusterWebIdentity struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec for this AWSClusterWebIdentity.
+	Spec AWSClusterWebIdentitySpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +k8s:defaulter-gen=true
+
+// AWSClusterWebIdentityList contains a list of AWSClusterWebIdentity.
+type AWSClusterWebIdentityList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AWSClusterWebIdentity `json:"items"`
+}
+
+// AWSClusterWebIdentitySpec defines the specifications for AWSClusterWebIdentity.
+type AWSClusterWebIdentitySpec struct {
+	AWSClusterIdentitySpec `json:",inline"`
+	// The role to assume. Its trust policy must allow the OIDC provider
+	// that issued the token to call sts:AssumeRoleWithWebIdentity.
+	AWSRoleSpec `json:",inline"`
+
+	// TokenFile is the path, inside the controller container, of a projected service account
+	// token to exchange for credentials. The file is read again every time the credentials are renewed,
+	// so the kubelet can rotate the token.
+	// Defaults to /var/run/secrets/sts.amazonaws.com/serviceaccount/token when TokenSecretRef is not set.
+	// +optional
+	TokenFile string `json:"tokenFile,omitempty"`
+
+	// TokenSecretRef is the name of a secret, in the controller namespace, containing an OIDC token
+	// under the Token data key. It is mutually exclusive with TokenFile.
+	// +optional
+	TokenSecretRef string `json:"tokenSecretRef,omitempty"`
+}
+
 func init() {
 	SchemeBuilder.Register(
 		&AWSClusterStaticIdentity{},
@@ -189,5 +245,7 @@ func init() {
 		&AWSClusterRoleIdentityList{},
 		&AWSClusterControllerIdentity{},
 		&AWSClusterControllerIdentityList{},
+		&AWSClusterWebIdentity{},
+		&AWSClusterWebIdentityList{},
 	)
 }
@@ -55,6 +55,11 @@ type AWSMachineSpec struct {
 	// +optional
 	InstanceMetadataOptions *InstanceMetadataOptions `json:"instanceMetadataOptions,omitempty"`
 
+	// MaintenanceOptions configures the automatic recovery, termination and stop protection, and the behavior
+	// on instance-initiated shutdown of the EC2 instance. Changes are applied to the existing instance.
+	// +optional
+	MaintenanceOptions *InstanceMaintenanceOptions `json:"maintenanceOptions,omitempty"`
+
 	// AMI is the reference to the AMI from which to create the machine instance.
 	AMI AMIReference `json:"ami,omitempty"`
 
@@ -102,6 +107,12 @@ type AWSMachineSpec struct {
 	// +optional
 	PublicIP *bool `json:"publicIP,omitempty"`
 
+	// ElasticIP associates an Elastic IP address with the instance once it is running, giving the machine a
+	// stable public IPv4 address. The address is either reused from existing addresses or allocated for the machine.
+	// Only addresses allocated for the machine are released when the machine is deleted.
+	// +optional
+	ElasticIP *ElasticIPSpec `json:"elasticIP,omitempty"`
+
 	// AdditionalSecurityGroups is an array of references to security groups that should be applied to the
 	// instance. These security groups would be set in addition to any security groups defined
 	// at the cluster level or in the actuator. It is possible to specify either IDs of Filters. Using Filters
@@ -132,6 +143,11 @@ type AWSMachineSpec struct {
 	// +kubebuilder:validation:MaxItems=2
 	NetworkInterfaces []string `json:"networkInterfaces,omitempty"`
 
+	// AdditionalNetworkInterfaces is a list of secondary network interfaces to create and attach when launching
+	// the instance. They are deleted when the instance is terminated.
+	// +optional
+	AdditionalNetworkInterfaces []AdditionalNetworkInterface `json:"additionalNetworkInterfaces,omitempty"`
+
 	// UncompressedUserData specify whether the user data is gzip-compressed before it is sent to ec2 instance.
 	// cloud-init has built-in support for gzip-compressed user data
 	// user data stored in aws secret manager is always gzip-compressed.
@@ -156,10 +172,27 @@ type AWSMachineSpec struct {
 	// +optional
 	PlacementGroupName string `json:"placementGroupName,omitempty"`
 
+	// PlacementGroupPartition is the partition number within the placement group in which to launch the instance.
+	// This value is only valid if the placement group, referred in `PlacementGroupName`, was created with
+	// strategy set to partition.
+	// +kubebuilder:validation:Minimum:=1
+	// +kubebuilder:validation:Maximum:=7
+	// +optional
+	PlacementGroupPartition int64 `json:"placementGroupPartition,omitempty"`
+
 	// Tenancy indicates if instance should run on shared or single-tenant hardware.
 	// +optional
 	// +kubebuilder:validation:Enum:=default;dedicated;host
 	Tenancy string `json:"tenancy,omitempty"`
+
+	// CapacityReservation allows users to launch the instance into an On-Demand Capacity Reservation
+	// or a Capacity Reservation resource group, or to keep it out of any Capacity Reservation.
+	// +optional
+	CapacityReservation *CapacityReservationSpecification `json:"capacityReservation,omitempty"`
+
+	// PrivateDNSName is the options for the hostname of the instance and the DNS records that resolve it.
+	// +optional
+	PrivateDNSName *PrivateDNSName `json:"privateDnsName,omitempty"`
 }
 
 // CloudInit defines options related to the bootstrapping systems where
@@ -217,6 +250,11 @@ type AWSMachineStatus struct {
 	// +optional
 	InstanceState *InstanceState `json:"instanceState,omitempty"`
 
+	// MaintenanceOptions are the maintenance options last applied to the instance.
+	// Options removed from the spec since are kept, as they are left unchanged on the instance.
+	// +optional
+	MaintenanceOptions *InstanceMaintenanceOptions `json:"maintenanceOptions,omitempty"`
+
 	// FailureReason will be set in the event that there is a terminal problem
 	// reconciling the Machine and will contain a succinct value suitable
 	// for machine interpretation.
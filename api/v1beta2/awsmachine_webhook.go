@@ -55,6 +55,11 @@ func (r *AWSMachine) ValidateCreate() error {
 	allErrs = append(allErrs, r.validateNonRootVolumes()...)
 	allErrs = append(allErrs, r.validateSSHKeyName()...)
 	allErrs = append(allErrs, r.validateAdditionalSecurityGroups()...)
+	allErrs = append(allErrs, r.Spec.CapacityReservation.Validate(field.NewPath("spec", "capacityReservation"), r.Spec.SpotMarketOptions)...)
+	allErrs = append(allErrs, r.Spec.MaintenanceOptions.Validate(field.NewPath("spec", "maintenanceOptions"), r.Spec.SpotMarketOptions)...)
+	allErrs = append(allErrs, r.validatePlacementGroupPartition()...)
+	allErrs = append(allErrs, validateAdditionalNetworkInterfaces(field.NewPath("spec", "additionalNetworkInterfaces"), r.Spec.AdditionalNetworkInterfaces, len(r.Spec.NetworkInterfaces), r.Spec.PublicIP)...)
+	allErrs = append(allErrs, r.Spec.ElasticIP.Validate(field.NewPath("spec", "elasticIP"), len(r.Spec.NetworkInterfaces)+len(r.Spec.AdditionalNetworkInterfaces))...)
 	allErrs = append(allErrs, r.Spec.AdditionalTags.Validate()...)
 
 	return aggregateObjErrors(r.GroupVersionKind().GroupKind(), r.Name, allErrs)
@@ -80,6 +85,7 @@ func (r *AWSMachine) ValidateUpdate(old runtime.Object) error {
 	allErrs = append(allErrs, r.validateCloudInitSecret()...)
 	allErrs = append(allErrs, r.validateAdditionalSecurityGroups()...)
 	allErrs = append(allErrs, r.Spec.AdditionalTags.Validate()...)
+	allErrs = append(allErrs, r.Spec.MaintenanceOptions.Validate(field.NewPath("spec", "maintenanceOptions"), r.Spec.SpotMarketOptions)...)
 
 	newAWSMachineSpec := newAWSMachine["spec"].(map[string]interface{})
 	oldAWSMachineSpec := oldAWSMachine["spec"].(map[string]interface{})
@@ -100,6 +106,10 @@ func (r *AWSMachine) ValidateUpdate(old runtime.Object) error {
 	delete(oldAWSMachineSpec, "additionalSecurityGroups")
 	delete(newAWSMachineSpec, "additionalSecurityGroups")
 
+	// allow changes to maintenanceOptions, they are applied to the existing instance
+	delete(oldAWSMachineSpec, "maintenanceOptions")
+	delete(newAWSMachineSpec, "maintenanceOptions")
+
 	// allow changes to secretPrefix, secretCount, and secureSecretsBackend
 	if cloudInit, ok := oldAWSMachineSpec["cloudInit"].(map[string]interface{}); ok {
 		delete(cloudInit, "secretPrefix")
@@ -257,6 +267,16 @@ func (r *AWSMachine) validateAdditionalSecurityGroups() field.ErrorList {
 	return allErrs
 }
 
+func (r *AWSMachine) validatePlacementGroupPartition() field.ErrorList {
+	var allErrs field.ErrorList
+
+	if r.Spec.PlacementGroupPartition != 0 && r.Spec.PlacementGroupName == "" {
+		allErrs = append(allErrs, field.Required(field.NewPath("spec", "placementGroupName"), "placementGroupName must be set when placementGroupPartition is set"))
+	}
+
+	return allErrs
+}
+
 func (r *AWSMachine) validateSSHKeyName() field.ErrorList {
 	return validateSSHKeyName(r.Spec.SSHKeyName)
 }
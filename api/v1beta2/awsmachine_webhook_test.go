@@ -248,6 +248,207 @@ func TestAWSMachineCreate(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "capacity reservation preference is accepted",
+			machine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					InstanceType: "test",
+					CapacityReservation: &CapacityReservationSpecification{
+						Preference: CapacityReservationPreferenceNone,
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "capacity reservation target id is accepted",
+			machine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					InstanceType: "test",
+					CapacityReservation: &CapacityReservationSpecification{
+						Target: &CapacityReservationTarget{
+							ID: aws.String("cr-0123456789abcdef0"),
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "capacity reservation target can't have both id and resource group arn",
+			machine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					InstanceType: "test",
+					CapacityReservation: &CapacityReservationSpecification{
+						Target: &CapacityReservationTarget{
+							ID:               aws.String("cr-0123456789abcdef0"),
+							ResourceGroupARN: aws.String("arn:aws:resource-groups:us-east-1:123456789012:group/reservations"),
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "capacity reservation preference can't be set with a target",
+			machine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					InstanceType: "test",
+					CapacityReservation: &CapacityReservationSpecification{
+						Preference: CapacityReservationPreferenceOpen,
+						Target: &CapacityReservationTarget{
+							ResourceGroupARN: aws.String("arn:aws:resource-groups:us-east-1:123456789012:group/reservations"),
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "capacity reservation target can't be set for spot instances",
+			machine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					InstanceType:      "test",
+					SpotMarketOptions: &SpotMarketOptions{},
+					CapacityReservation: &CapacityReservationSpecification{
+						Target: &CapacityReservationTarget{
+							ID: aws.String("cr-0123456789abcdef0"),
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "placement group partition is accepted with a placement group",
+			machine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					InstanceType:            "test",
+					PlacementGroupName:      "pg-partition",
+					PlacementGroupPartition: 2,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "placement group partition requires a placement group",
+			machine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					InstanceType:            "test",
+					PlacementGroupPartition: 2,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "additional network interfaces are accepted",
+			machine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					InstanceType: "test",
+					AdditionalNetworkInterfaces: []AdditionalNetworkInterface{
+						{
+							DeviceIndex:   1,
+							InterfaceType: NetworkInterfaceTypeEFA,
+						},
+						{
+							DeviceIndex: 2,
+							Subnet:      &AWSResourceReference{ID: aws.String("subnet-1")},
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "additional network interfaces with duplicate device index",
+			machine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					InstanceType: "test",
+					AdditionalNetworkInterfaces: []AdditionalNetworkInterface{
+						{DeviceIndex: 1},
+						{DeviceIndex: 1},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "additional network interface using the device index of an existing network interface",
+			machine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					InstanceType:      "test",
+					NetworkInterfaces: []string{"eni-1", "eni-2"},
+					AdditionalNetworkInterfaces: []AdditionalNetworkInterface{
+						{DeviceIndex: 1},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "elastic IP from a public IPv4 pool is accepted",
+			machine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					InstanceType: "test",
+					ElasticIP: &ElasticIPSpec{
+						AllocationIDs:  []string{"eipalloc-1"},
+						PublicIpv4Pool: aws.String("ipv4pool-ec2-1"),
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "elastic IP with an invalid allocation id",
+			machine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					InstanceType: "test",
+					ElasticIP: &ElasticIPSpec{
+						AllocationIDs: []string{"eni-1"},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "elastic IP with several network interfaces",
+			machine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					InstanceType: "test",
+					ElasticIP:    &ElasticIPSpec{},
+					AdditionalNetworkInterfaces: []AdditionalNetworkInterface{
+						{DeviceIndex: 1},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "stop protection for spot instances",
+			machine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					InstanceType:      "test",
+					SpotMarketOptions: &SpotMarketOptions{},
+					MaintenanceOptions: &InstanceMaintenanceOptions{
+						DisableAPIStop: aws.Bool(true),
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "additional network interfaces with public IP",
+			machine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					InstanceType: "test",
+					PublicIP:     aws.Bool(true),
+					AdditionalNetworkInterfaces: []AdditionalNetworkInterface{
+						{DeviceIndex: 1},
+					},
+				},
+			},
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -302,6 +503,23 @@ func TestAWSMachineUpdate(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "change in maintenance options",
+			oldMachine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					InstanceType: "test",
+				},
+			},
+			newMachine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					InstanceType: "test",
+					MaintenanceOptions: &InstanceMaintenanceOptions{
+						DisableAPITermination: aws.Bool(true),
+					},
+				},
+			},
+			wantErr: false,
+		},
 		{
 			name: "change in fields other than providerid, tags and securitygroups",
 			oldMachine: &AWSMachine{
@@ -116,6 +116,17 @@ func (r *AWSMachineTemplate) validateAdditionalSecurityGroups() field.ErrorList
 	return allErrs
 }
 
+func (r *AWSMachineTemplate) validatePlacementGroupPartition() field.ErrorList {
+	var allErrs field.ErrorList
+
+	spec := r.Spec.Template.Spec
+	if spec.PlacementGroupPartition != 0 && spec.PlacementGroupName == "" {
+		allErrs = append(allErrs, field.Required(field.NewPath("spec", "template", "spec", "placementGroupName"), "placementGroupName must be set when placementGroupPartition is set"))
+	}
+
+	return allErrs
+}
+
 func (r *AWSMachineTemplate) validateCloudInitSecret() field.ErrorList {
 	var allErrs field.ErrorList
 
@@ -203,6 +214,11 @@ func (r *AWSMachineTemplateWebhook) ValidateCreate(_ context.Context, raw runtim
 	allErrs = append(allErrs, obj.validateNonRootVolumes()...)
 	allErrs = append(allErrs, obj.validateSSHKeyName()...)
 	allErrs = append(allErrs, obj.validateAdditionalSecurityGroups()...)
+	allErrs = append(allErrs, spec.CapacityReservation.Validate(field.NewPath("spec", "template", "spec", "capacityReservation"), spec.SpotMarketOptions)...)
+	allErrs = append(allErrs, spec.MaintenanceOptions.Validate(field.NewPath("spec", "template", "spec", "maintenanceOptions"), spec.SpotMarketOptions)...)
+	allErrs = append(allErrs, obj.validatePlacementGroupPartition()...)
+	allErrs = append(allErrs, validateAdditionalNetworkInterfaces(field.NewPath("spec", "template", "spec", "additionalNetworkInterfaces"), spec.AdditionalNetworkInterfaces, len(spec.NetworkInterfaces), spec.PublicIP)...)
+	allErrs = append(allErrs, spec.ElasticIP.Validate(field.NewPath("spec", "template", "spec", "elasticIP"), len(spec.NetworkInterfaces)+len(spec.AdditionalNetworkInterfaces))...)
 	allErrs = append(allErrs, obj.Spec.Template.Spec.AdditionalTags.Validate()...)
 
 	return aggregateObjErrors(obj.GroupVersionKind().GroupKind(), obj.Name, allErrs)
@@ -0,0 +1,60 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta2
+
+import (
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// Validate checks that the specification sets either a preference or a target, and that the target is either a
+// Capacity Reservation or a Capacity Reservation resource group. As Capacity Reservations only hold On-Demand
+// capacity, a target cannot be set together with Spot market options.
+func (c *CapacityReservationSpecification) Validate(fldPath *field.Path, spotMarketOptions *SpotMarketOptions) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if c == nil {
+		return allErrs
+	}
+
+	if c.Target == nil {
+		return allErrs
+	}
+
+	targetPath := fldPath.Child("target")
+	if c.Preference != "" {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("preference"), "cannot be set together with a target"))
+	}
+	if spotMarketOptions != nil {
+		allErrs = append(allErrs, field.Forbidden(targetPath, "cannot be set for Spot instances"))
+	}
+
+	id, arn := c.Target.ID, c.Target.ResourceGroupARN
+	switch {
+	case id == nil && arn == nil:
+		allErrs = append(allErrs, field.Required(targetPath, "either id or resourceGroupArn must be set"))
+	case id != nil && arn != nil:
+		allErrs = append(allErrs, field.Forbidden(targetPath, "only one of id or resourceGroupArn may be specified, specifying both is forbidden"))
+	case id != nil && !strings.HasPrefix(*id, "cr-"):
+		allErrs = append(allErrs, field.Invalid(targetPath.Child("id"), *id, "must be a Capacity Reservation id starting with \"cr-\""))
+	case arn != nil && !strings.HasPrefix(*arn, "arn:"):
+		allErrs = append(allErrs, field.Invalid(targetPath.Child("resourceGroupArn"), *arn, "must be a resource group ARN"))
+	}
+
+	return allErrs
+}
@@ -34,6 +34,13 @@ const (
 	// SourcePrincipalUsageUnauthorizedReason used when AWSCluster is not in the intersection of source identity allowed namespaces
 	// and allowed namespaces of the identities that source identity depends to.
 	SourcePrincipalUsageUnauthorizedReason = "SourcePrincipalUsageUnauthorized"
+	// PrincipalCredentialValidCondition reports on whether the temporary credentials of the Principal are still valid
+	// and could be renewed ahead of their expiry.
+	PrincipalCredentialValidCondition clusterv1.ConditionType = "PrincipalCredentialValid"
+	// PrincipalCredentialExpiringReason used when the credentials could not be renewed and are about to expire.
+	PrincipalCredentialExpiringReason = "PrincipalCredentialExpiring"
+	// PrincipalCredentialInvalidReason used when the credentials have expired and could not be renewed.
+	PrincipalCredentialInvalidReason = "PrincipalCredentialInvalid"
 )
 
 const (
@@ -53,6 +60,14 @@ const (
 	SubnetsReconciliationFailedReason = "SubnetsReconciliationFailed"
 )
 
+const (
+	// NetworkACLsReadyCondition reports on the successful reconciliation of the network ACLs of the subnets.
+	// Only applicable to managed clusters with network ACLs configured.
+	NetworkACLsReadyCondition clusterv1.ConditionType = "NetworkACLsReady"
+	// NetworkACLsReconciliationFailedReason used to report failures while reconciling network ACLs.
+	NetworkACLsReconciliationFailedReason = "NetworkACLsReconciliationFailed"
+)
+
 const (
 	// InternetGatewayReadyCondition reports on the successful reconciliation of internet gateways.
 	// Only applicable to managed clusters.
@@ -61,6 +76,14 @@ const (
 	InternetGatewayFailedReason = "InternetGatewayFailed"
 )
 
+const (
+	// CarrierGatewayReadyCondition reports on the successful reconciliation of the carrier gateway.
+	// Only applicable to managed clusters with subnets in Wavelength Zones.
+	CarrierGatewayReadyCondition clusterv1.ConditionType = "CarrierGatewayReady"
+	// CarrierGatewayFailedReason used when errors occur during carrier gateway reconciliation.
+	CarrierGatewayFailedReason = "CarrierGatewayFailed"
+)
+
 const (
 	// EgressOnlyInternetGatewayReadyCondition reports on the successful reconciliation of egress only internet gateways.
 	// Only applicable to managed clusters.
@@ -87,6 +110,32 @@ const (
 	RouteTableReconciliationFailedReason = "RouteTableReconciliationFailed"
 )
 
+const (
+	// TransitGatewayAttachmentReadyCondition reports successful reconciliation of the transit gateway attachment.
+	// Only applicable to managed clusters with a transit gateway configured.
+	TransitGatewayAttachmentReadyCondition clusterv1.ConditionType = "TransitGatewayAttachmentReady"
+	// TransitGatewayAttachmentFailedReason used when errors occur during transit gateway attachment reconciliation.
+	TransitGatewayAttachmentFailedReason = "TransitGatewayAttachmentFailed"
+	// WaitForTransitGatewayAttachmentReason used while waiting for the transit gateway attachment to become available.
+	WaitForTransitGatewayAttachmentReason = "WaitForTransitGatewayAttachment"
+)
+
+const (
+	// VpcEndpointsReadyCondition reports successful reconciliation of VPC endpoints.
+	// Only applicable to managed clusters with VPC endpoints configured.
+	VpcEndpointsReadyCondition clusterv1.ConditionType = "VpcEndpointsReady"
+	// VpcEndpointsReconciliationFailedReason used when any errors occur during reconciliation of VPC endpoints.
+	VpcEndpointsReconciliationFailedReason = "VpcEndpointsReconciliationFailed"
+)
+
+const (
+	// VpcFlowLogsReadyCondition reports successful reconciliation of VPC flow logs.
+	// Only applicable to managed clusters with flow logs configured.
+	VpcFlowLogsReadyCondition clusterv1.ConditionType = "VpcFlowLogsReady"
+	// VpcFlowLogsReconciliationFailedReason used when any errors occur during reconciliation of VPC flow logs.
+	VpcFlowLogsReconciliationFailedReason = "VpcFlowLogsReconciliationFailed"
+)
+
 const (
 	// SecondaryCidrsReadyCondition reports successful reconciliation of secondary CIDR blocks.
 	// Only applicable to managed clusters.
@@ -102,6 +151,13 @@ const (
 	ClusterSecurityGroupReconciliationFailedReason = "SecurityGroupReconciliationFailed"
 )
 
+const (
+	// PlacementGroupsReadyCondition reports successful reconciliation of the placement groups declared in the cluster spec.
+	PlacementGroupsReadyCondition clusterv1.ConditionType = "PlacementGroupsReady"
+	// PlacementGroupsReconciliationFailedReason used when any errors occur during reconciliation of placement groups.
+	PlacementGroupsReconciliationFailedReason = "PlacementGroupsReconciliationFailed"
+)
+
 const (
 	// BastionHostReadyCondition reports whether a bastion host is ready. Depending on the configuration, a cluster
 	// may not require a bastion host and this condition will be skipped.
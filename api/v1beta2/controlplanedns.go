@@ -0,0 +1,53 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta2
+
+import (
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// Validate validates ControlPlaneDNS fields.
+func (d *ControlPlaneDNS) Validate() []*field.Error {
+	var errs field.ErrorList
+
+	if d == nil {
+		return errs
+	}
+
+	dnsPath := field.NewPath("spec", "controlPlaneDNS")
+	if d.HostedZoneID == "" {
+		errs = append(errs, field.Required(dnsPath.Child("hostedZoneId"), "can't be empty"))
+	}
+
+	if d.Name == "" {
+		errs = append(errs, field.Required(dnsPath.Child("name"), "can't be empty"))
+	} else {
+		for _, msg := range validation.IsDNS1123Subdomain(d.FQDN()) {
+			errs = append(errs, field.Invalid(dnsPath.Child("name"), d.Name, msg))
+		}
+	}
+
+	return errs
+}
+
+// FQDN returns the name of the record without the trailing dot.
+func (d *ControlPlaneDNS) FQDN() string {
+	return strings.ToLower(strings.TrimSuffix(d.Name, "."))
+}
@@ -0,0 +1,62 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta2
+
+import (
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// Validate checks the allocation IDs, filters and public IPv4 pool of the Elastic IP specification. The address
+// is associated with the primary network interface of the instance, so the instance cannot be launched with
+// several network interfaces.
+func (e *ElasticIPSpec) Validate(fldPath *field.Path, networkInterfaces int) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if e == nil {
+		return allErrs
+	}
+
+	if networkInterfaces > 1 {
+		allErrs = append(allErrs, field.Forbidden(fldPath, "cannot be set for instances with several network interfaces"))
+	}
+
+	if e.PublicIpv4Pool != nil && !strings.HasPrefix(*e.PublicIpv4Pool, "ipv4pool-ec2-") {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("publicIpv4Pool"), *e.PublicIpv4Pool, "must be a public IPv4 pool id"))
+	}
+
+	seen := make(map[string]bool, len(e.AllocationIDs))
+	for i, id := range e.AllocationIDs {
+		if !strings.HasPrefix(id, "eipalloc-") {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("allocationIds").Index(i), id, "must be an Elastic IP allocation id"))
+			continue
+		}
+		if seen[id] {
+			allErrs = append(allErrs, field.Duplicate(fldPath.Child("allocationIds").Index(i), id))
+		}
+		seen[id] = true
+	}
+
+	for i, f := range e.Filters {
+		if len(f.Values) == 0 {
+			allErrs = append(allErrs, field.Required(fldPath.Child("filters").Index(i).Child("values"), "at least one value must be set"))
+		}
+	}
+
+	return allErrs
+}
@@ -0,0 +1,68 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta2
+
+import (
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// Validate will validate the Elastic IP pool fields.
+func (p *ElasticIPPool) Validate() field.ErrorList {
+	var errs field.ErrorList
+
+	if p == nil {
+		return errs
+	}
+
+	poolPath := field.NewPath("spec", "network", "elasticIpPool")
+	if p.PublicIpv4Pool == nil && len(p.AllocationIDs) == 0 {
+		errs = append(errs, field.Required(poolPath, "one of publicIpv4Pool or allocationIds must be set"))
+	}
+
+	if p.PublicIpv4Pool != nil && !strings.HasPrefix(*p.PublicIpv4Pool, "ipv4pool-ec2-") {
+		errs = append(errs, field.Invalid(poolPath.Child("publicIpv4Pool"), *p.PublicIpv4Pool, "must be a public IPv4 pool id"))
+	}
+
+	seen := make(map[string]bool, len(p.AllocationIDs))
+	for i, id := range p.AllocationIDs {
+		if !strings.HasPrefix(id, "eipalloc-") {
+			errs = append(errs, field.Invalid(poolPath.Child("allocationIds").Index(i), id, "must be an Elastic IP allocation id"))
+			continue
+		}
+		if seen[id] {
+			errs = append(errs, field.Duplicate(poolPath.Child("allocationIds").Index(i), id))
+		}
+		seen[id] = true
+	}
+
+	return errs
+}
+
+// HasAllocationID returns true if the allocation id is one of the pre-allocated addresses of the pool.
+func (p *ElasticIPPool) HasAllocationID(id string) bool {
+	if p == nil {
+		return false
+	}
+	for _, allocationID := range p.AllocationIDs {
+		if allocationID == id {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,71 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta2
+
+import (
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// GetTrafficType returns the type of traffic captured by the flow log, ALL when not set.
+func (f *VPCFlowLog) GetTrafficType() FlowLogTrafficType {
+	if f.TrafficType == "" {
+		return FlowLogTrafficTypeAll
+	}
+	return f.TrafficType
+}
+
+// ValidateFlowLogs will validate the flow logs of a VPC.
+func (v *VPCSpec) ValidateFlowLogs() field.ErrorList {
+	var errs field.ErrorList
+
+	flowLogsPath := field.NewPath("spec", "network", "vpc", "flowLogs")
+	destinations := make(map[string]struct{}, len(v.FlowLogs))
+	for i := range v.FlowLogs {
+		flowLog := v.FlowLogs[i]
+		flowLogPath := flowLogsPath.Index(i)
+
+		switch flowLog.DestinationType {
+		case FlowLogDestinationTypeCloudWatchLogs:
+			if !strings.HasPrefix(flowLog.DestinationARN, "arn:") || !strings.Contains(flowLog.DestinationARN, ":logs:") {
+				errs = append(errs, field.Invalid(flowLogPath.Child("destinationArn"), flowLog.DestinationARN, "must be the ARN of a CloudWatch Logs log group"))
+			}
+			if flowLog.IAMRoleARN == "" {
+				errs = append(errs, field.Required(flowLogPath.Child("iamRoleArn"), "iamRoleArn is required to publish to CloudWatch Logs"))
+			}
+		case FlowLogDestinationTypeS3:
+			if !strings.HasPrefix(flowLog.DestinationARN, "arn:") || !strings.Contains(flowLog.DestinationARN, ":s3:::") {
+				errs = append(errs, field.Invalid(flowLogPath.Child("destinationArn"), flowLog.DestinationARN, "must be the ARN of an S3 bucket"))
+			}
+			if flowLog.IAMRoleARN != "" {
+				errs = append(errs, field.Forbidden(flowLogPath.Child("iamRoleArn"), "iamRoleArn cannot be set for S3 destinations"))
+			}
+		default:
+			errs = append(errs, field.NotSupported(flowLogPath.Child("destinationType"), flowLog.DestinationType,
+				[]string{string(FlowLogDestinationTypeCloudWatchLogs), string(FlowLogDestinationTypeS3)}))
+		}
+
+		key := string(flowLog.GetTrafficType()) + "/" + flowLog.DestinationARN
+		if _, ok := destinations[key]; ok {
+			errs = append(errs, field.Duplicate(flowLogPath, flowLog.DestinationARN))
+		}
+		destinations[key] = struct{}{}
+	}
+
+	return errs
+}
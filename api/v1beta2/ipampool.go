@@ -0,0 +1,55 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta2
+
+import (
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// DefaultIPAMPoolNetmaskLength is the netmask length of the VPC CIDR block allocated from an IPAM pool
+// when none is specified.
+const DefaultIPAMPoolNetmaskLength = 16
+
+// ValidateIPAMPool will validate the IPAM pool fields of a VPC on cluster creation.
+func (v *VPCSpec) ValidateIPAMPool() field.ErrorList {
+	var errs field.ErrorList
+
+	if v.IPAMPool == nil {
+		return errs
+	}
+
+	poolPath := field.NewPath("spec", "network", "vpc", "ipamPool")
+	if v.ID != "" {
+		errs = append(errs, field.Forbidden(poolPath, "ipamPool cannot be used with an existing vpc"))
+	}
+	if v.CidrBlock != "" {
+		errs = append(errs, field.Forbidden(poolPath, "cidrBlock and ipamPool cannot be used together"))
+	}
+	if v.IPAMPool.ID == "" && v.IPAMPool.Name == "" {
+		errs = append(errs, field.Required(poolPath, "ipamPool must have either id or name"))
+	}
+
+	return errs
+}
+
+// GetNetmaskLength returns the netmask length of the CIDR block to allocate from the pool.
+func (p *IPAMPool) GetNetmaskLength() int64 {
+	if p.NetmaskLength == 0 {
+		return DefaultIPAMPoolNetmaskLength
+	}
+	return p.NetmaskLength
+}
@@ -0,0 +1,37 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta2
+
+import (
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// Validate checks that stop protection is not enabled for Spot instances, which cannot be protected from
+// being stopped.
+func (o *InstanceMaintenanceOptions) Validate(fldPath *field.Path, spotMarketOptions *SpotMarketOptions) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if o == nil {
+		return allErrs
+	}
+
+	if spotMarketOptions != nil && o.DisableAPIStop != nil && *o.DisableAPIStop {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("disableApiStop"), "cannot be enabled for Spot instances"))
+	}
+
+	return allErrs
+}
@@ -36,6 +36,31 @@ type NetworkStatus struct {
 
 	// APIServerELB is the Kubernetes api server load balancer.
 	APIServerELB LoadBalancer `json:"apiServerElb,omitempty"`
+
+	// SecondaryAPIServerELB is the secondary Kubernetes api server load balancer.
+	SecondaryAPIServerELB LoadBalancer `json:"secondaryAPIServerELB,omitempty"`
+
+	// VPCEndpoints is the list of VPC endpoints managed by the provider in the cluster VPC.
+	// +optional
+	VPCEndpoints []VPCEndpoint `json:"vpcEndpoints,omitempty"`
+
+	// TransitGatewayAttachmentID is the id of the transit gateway attachment of the cluster VPC.
+	// +optional
+	TransitGatewayAttachmentID string `json:"transitGatewayAttachmentId,omitempty"`
+
+	// FlowLogIDs is the list of the ids of the flow logs managed by the provider for the cluster VPC.
+	// +optional
+	FlowLogIDs []string `json:"flowLogIds,omitempty"`
+
+	// AssociatedHostedZoneIDs are the ids of the private hosted zones the provider associated the cluster VPC with.
+	// The associations are removed when the cluster is deleted, even if the VPC is not managed by the provider.
+	// +optional
+	AssociatedHostedZoneIDs []string `json:"associatedHostedZoneIds,omitempty"`
+
+	// AdditionalRoutes are the additional routes the provider created in the route tables of the subnets.
+	// Only these routes are deleted when they are removed from the spec.
+	// +optional
+	AdditionalRoutes []RouteTableRoutes `json:"additionalRoutes,omitempty"`
 }
 
 // ELBScheme defines the scheme of a load balancer.
@@ -228,6 +253,78 @@ type NetworkSpec struct {
 	// This is optional - if not provided new security groups will be created for the cluster
 	// +optional
 	SecurityGroupOverrides map[SecurityGroupRole]string `json:"securityGroupOverrides,omitempty"`
+
+	// TransitGateway configures the attachment of a managed VPC to an existing transit gateway.
+	// Not applicable to unmanaged VPCs.
+	// +optional
+	TransitGateway *TransitGatewaySpec `json:"transitGateway,omitempty"`
+
+	// ElasticIPPool configures the source of the Elastic IP addresses used by the NAT gateways
+	// and the subnet mappings of internet-facing network load balancers.
+	// When not set, addresses are allocated from the Amazon pool of public IPv4 addresses.
+	// +optional
+	ElasticIPPool *ElasticIPPool `json:"elasticIpPool,omitempty"`
+
+	// AdditionalRoutes configures routes added to the route tables of all the public or private subnets
+	// of a managed VPC, on top of the default routes to the internet, NAT and egress only internet gateways.
+	// Not applicable to unmanaged VPCs.
+	// +optional
+	AdditionalRoutes *AdditionalRoutes `json:"additionalRoutes,omitempty"`
+
+	// NetworkACLs configures the network ACLs associated with the public and private subnets of a managed VPC.
+	// When not set, the subnets use the default network ACL of the VPC.
+	// Not applicable to unmanaged VPCs.
+	// +optional
+	NetworkACLs *NetworkACLs `json:"networkAcls,omitempty"`
+
+	// SecurityGroupEgressRules is an optional set of outbound rules, keyed by role, that replace
+	// the default allow-all egress rule of the security groups created for the cluster.
+	// Roles without rules keep the egress rules they already have.
+	// Not applicable to security group overrides.
+	// +optional
+	SecurityGroupEgressRules map[SecurityGroupRole]EgressRules `json:"securityGroupEgressRules,omitempty"`
+
+	// AdditionalIngressRules is an optional set of inbound rules, keyed by role, added to the security groups
+	// created for the cluster on top of the rules managed by the provider.
+	// The sources of a rule are either CIDR blocks or security groups, given by id or by the role of another
+	// security group of the cluster.
+	// Not applicable to security group overrides.
+	// +optional
+	AdditionalIngressRules map[SecurityGroupRole]IngressRules `json:"additionalIngressRules,omitempty"`
+}
+
+// ElasticIPPool defines the source of the Elastic IP addresses consumed by the cluster.
+type ElasticIPPool struct {
+	// PublicIpv4Pool is the ID of a public IPv4 pool (BYOIP) to allocate addresses from
+	// when no pre-allocated address is available.
+	// Addresses allocated from the pool are owned by the cluster and released on delete.
+	// +optional
+	PublicIpv4Pool *string `json:"publicIpv4Pool,omitempty"`
+
+	// AllocationIDs is a list of pre-allocated Elastic IP allocation IDs.
+	// Unassociated addresses from this list are consumed, in order, before any address is allocated.
+	// These addresses are never released by the controller.
+	// +optional
+	AllocationIDs []string `json:"allocationIds,omitempty"`
+}
+
+// TransitGatewaySpec configures the attachment of the VPC to a transit gateway.
+type TransitGatewaySpec struct {
+	// ID is the id of the existing transit gateway to attach the VPC to.
+	// If the transit gateway is shared from another account, the attachment must be accepted
+	// in the owner account before it becomes available.
+	// +kubebuilder:validation:MinLength=1
+	ID string `json:"id"`
+
+	// SubnetIDs are the subnets in which the transit gateway attachment network interfaces are created.
+	// Defaults to the private subnets of the cluster, one per availability zone.
+	// +optional
+	SubnetIDs []string `json:"subnetIds,omitempty"`
+
+	// RouteCIDRs is a list of IPv4 CIDR blocks routed through the transit gateway from the
+	// private subnets of the cluster.
+	// +optional
+	RouteCIDRs []string `json:"routeCidrs,omitempty"`
 }
 
 // IPv6 contains ipv6 specific settings for the network.
@@ -245,6 +342,25 @@ type IPv6 struct {
 	EgressOnlyInternetGatewayID *string `json:"egressOnlyInternetGatewayId,omitempty"`
 }
 
+// IPAMPool defines the AWS VPC IP Address Manager (IPAM) pool to allocate the VPC CIDR block from.
+type IPAMPool struct {
+	// ID is the ID of the IPAM pool to allocate the VPC CIDR block from.
+	// +optional
+	ID string `json:"id,omitempty"`
+
+	// Name is the name of the IPAM pool to allocate the VPC CIDR block from.
+	// It is used to look up the pool when ID is not set.
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// NetmaskLength is the netmask length of the IPv4 CIDR block to allocate from the pool.
+	// Defaults to 16.
+	// +kubebuilder:validation:Minimum=16
+	// +kubebuilder:validation:Maximum=28
+	// +optional
+	NetmaskLength int64 `json:"netmaskLength,omitempty"`
+}
+
 // VPCSpec configures an AWS VPC.
 type VPCSpec struct {
 	// ID is the vpc-id of the VPC this provider should use to create resources.
@@ -252,8 +368,15 @@ type VPCSpec struct {
 
 	// CidrBlock is the CIDR block to be used when the provider creates a managed VPC.
 	// Defaults to 10.0.0.0/16.
+	// Mutually exclusive with IPAMPool. When IPAMPool is set, it is populated with the
+	// CIDR block allocated from the pool once the VPC has been created.
 	CidrBlock string `json:"cidrBlock,omitempty"`
 
+	// IPAMPool defines the AWS VPC IP Address Manager (IPAM) pool the IPv4 CIDR block of a
+	// managed VPC is allocated from. Mutually exclusive with CidrBlock.
+	// +optional
+	IPAMPool *IPAMPool `json:"ipamPool,omitempty"`
+
 	// IPv6 contains ipv6 specific settings for the network. Supported only in managed clusters.
 	// This field cannot be set on AWSCluster object.
 	// +optional
@@ -263,6 +386,11 @@ type VPCSpec struct {
 	// +optional
 	InternetGatewayID *string `json:"internetGatewayId,omitempty"`
 
+	// CarrierGatewayID is the id of the carrier gateway associated with the VPC.
+	// A carrier gateway is created for managed VPCs with subnets in Wavelength Zones.
+	// +optional
+	CarrierGatewayID *string `json:"carrierGatewayId,omitempty"`
+
 	// Tags is a collection of tags describing the resource.
 	Tags Tags `json:"tags,omitempty"`
 
@@ -282,6 +410,67 @@ type VPCSpec struct {
 	// +kubebuilder:default=Ordered
 	// +kubebuilder:validation:Enum=Ordered;Random
 	AvailabilityZoneSelection *AZSelectionScheme `json:"availabilityZoneSelection,omitempty"`
+
+	// Endpoints is a list of VPC endpoints (AWS PrivateLink) to create in a managed VPC,
+	// so that nodes can reach AWS services such as S3, EC2, ECR, STS, SSM or Secrets Manager
+	// without going through a NAT gateway.
+	// Endpoints are not created for unmanaged VPCs.
+	// +optional
+	Endpoints []VPCEndpointSpec `json:"endpoints,omitempty"`
+
+	// FlowLogs is a list of flow logs publishing the IP traffic of a managed VPC to CloudWatch Logs or S3.
+	// Flow logs cannot be modified: a changed flow log is deleted and created again.
+	// Flow logs are not created for unmanaged VPCs.
+	// +optional
+	FlowLogs []VPCFlowLog `json:"flowLogs,omitempty"`
+}
+
+// FlowLogDestinationType defines the type of the destination of a flow log.
+type FlowLogDestinationType string
+
+var (
+	// FlowLogDestinationTypeCloudWatchLogs publishes the flow log to a CloudWatch Logs log group.
+	FlowLogDestinationTypeCloudWatchLogs = FlowLogDestinationType("cloud-watch-logs")
+
+	// FlowLogDestinationTypeS3 publishes the flow log to an S3 bucket.
+	FlowLogDestinationTypeS3 = FlowLogDestinationType("s3")
+)
+
+// FlowLogTrafficType defines the type of traffic captured by a flow log.
+type FlowLogTrafficType string
+
+var (
+	// FlowLogTrafficTypeAccept captures the accepted traffic only.
+	FlowLogTrafficTypeAccept = FlowLogTrafficType("ACCEPT")
+
+	// FlowLogTrafficTypeReject captures the rejected traffic only.
+	FlowLogTrafficTypeReject = FlowLogTrafficType("REJECT")
+
+	// FlowLogTrafficTypeAll captures the accepted and rejected traffic.
+	FlowLogTrafficTypeAll = FlowLogTrafficType("ALL")
+)
+
+// VPCFlowLog configures a flow log of the VPC.
+type VPCFlowLog struct {
+	// DestinationType is the type of the destination the flow log is published to.
+	// +kubebuilder:validation:Enum=cloud-watch-logs;s3
+	DestinationType FlowLogDestinationType `json:"destinationType"`
+
+	// DestinationARN is the ARN of the CloudWatch Logs log group or of the S3 bucket, optionally
+	// followed by a folder, the flow log is published to.
+	// +kubebuilder:validation:MinLength=1
+	DestinationARN string `json:"destinationArn"`
+
+	// TrafficType is the type of traffic captured by the flow log.
+	// +kubebuilder:validation:Enum=ACCEPT;REJECT;ALL
+	// +kubebuilder:default=ALL
+	// +optional
+	TrafficType FlowLogTrafficType `json:"trafficType,omitempty"`
+
+	// IAMRoleARN is the ARN of the IAM role assumed by the flow logs service to publish to CloudWatch Logs.
+	// Required for the cloud-watch-logs destination type, not applicable to S3.
+	// +optional
+	IAMRoleARN string `json:"iamRoleArn,omitempty"`
 }
 
 // String returns a string representation of the VPC.
@@ -304,6 +493,85 @@ func (v *VPCSpec) IsIPv6Enabled() bool {
 	return v.IPv6 != nil
 }
 
+// VPCEndpointType defines the type of a VPC endpoint.
+type VPCEndpointType string
+
+var (
+	// VPCEndpointTypeInterface is an interface endpoint, backed by network interfaces in the cluster subnets.
+	VPCEndpointTypeInterface = VPCEndpointType("Interface")
+
+	// VPCEndpointTypeGateway is a gateway endpoint, reached through routes in the cluster route tables.
+	// Only supported for S3 and DynamoDB.
+	VPCEndpointTypeGateway = VPCEndpointType("Gateway")
+)
+
+// VPCEndpointSpec configures a VPC endpoint.
+type VPCEndpointSpec struct {
+	// ServiceName is the name of the AWS service to connect to, for example "s3", "ecr.api" or "sts".
+	// Short names are expanded to the regional service name (com.amazonaws.<region>.<service>),
+	// fully qualified service names are used as is.
+	// +kubebuilder:validation:MinLength=1
+	ServiceName string `json:"serviceName"`
+
+	// Type is the type of the VPC endpoint.
+	// Defaults to Interface.
+	// +kubebuilder:default=Interface
+	// +kubebuilder:validation:Enum=Interface;Gateway
+	// +optional
+	Type VPCEndpointType `json:"type,omitempty"`
+
+	// PrivateDNSEnabled associates a private hosted zone with the VPC so that the public
+	// service DNS name resolves to the endpoint. Only applicable to interface endpoints.
+	// Defaults to true.
+	// +optional
+	PrivateDNSEnabled *bool `json:"privateDnsEnabled,omitempty"`
+
+	// SubnetIDs are the subnets in which the interface endpoint network interfaces are created.
+	// Defaults to the private subnets of the cluster, one per availability zone.
+	// Only applicable to interface endpoints.
+	// +optional
+	SubnetIDs []string `json:"subnetIds,omitempty"`
+
+	// SecurityGroupIDs are the security groups associated with the interface endpoint network interfaces.
+	// If not set, the default security group of the VPC is used.
+	// Only applicable to interface endpoints.
+	// +optional
+	SecurityGroupIDs []string `json:"securityGroupIds,omitempty"`
+}
+
+// IsGateway returns true if the endpoint is a gateway endpoint.
+func (v *VPCEndpointSpec) IsGateway() bool {
+	return v.Type == VPCEndpointTypeGateway
+}
+
+// VPCEndpoint describes a VPC endpoint created by the provider.
+type VPCEndpoint struct {
+	// ID is the id of the VPC endpoint.
+	ID string `json:"id"`
+
+	// ServiceName is the fully qualified name of the service the endpoint connects to.
+	ServiceName string `json:"serviceName"`
+
+	// Type is the type of the VPC endpoint.
+	Type VPCEndpointType `json:"type"`
+
+	// State is the current state of the VPC endpoint.
+	// +optional
+	State string `json:"state,omitempty"`
+}
+
+// ZoneType defines the type of the zone of a subnet.
+type ZoneType string
+
+const (
+	// ZoneTypeAvailabilityZone defines a standard availability zone of a region.
+	ZoneTypeAvailabilityZone = ZoneType("availability-zone")
+	// ZoneTypeLocalZone defines an AWS Local Zone.
+	ZoneTypeLocalZone = ZoneType("local-zone")
+	// ZoneTypeWavelengthZone defines an AWS Wavelength Zone.
+	ZoneTypeWavelengthZone = ZoneType("wavelength-zone")
+)
+
 // SubnetSpec configures an AWS Subnet.
 type SubnetSpec struct {
 	// ID defines a unique identifier to reference this resource.
@@ -341,6 +609,170 @@ type SubnetSpec struct {
 
 	// Tags is a collection of tags describing the resource.
 	Tags Tags `json:"tags,omitempty"`
+
+	// ZoneType is the type of the zone of the subnet. It is detected by the controller.
+	// Subnets in Local Zones and Wavelength Zones are edge subnets: they are never used for NAT gateways,
+	// control plane load balancers, the bastion host or as failure domains of the cluster, but machines
+	// can be placed in them explicitly.
+	// +kubebuilder:validation:Enum=availability-zone;local-zone;wavelength-zone
+	// +optional
+	ZoneType *ZoneType `json:"zoneType,omitempty"`
+
+	// ParentZoneName is the name of the availability zone a Local Zone or Wavelength Zone is attached to.
+	// Private subnets in Local Zones are routed through the NAT gateway of their parent zone.
+	// It is detected by the controller.
+	// +optional
+	ParentZoneName *string `json:"parentZoneName,omitempty"`
+
+	// AdditionalRoutes is a list of routes added to the route table of the subnet, on top of the
+	// default routes and of the additional routes of its subnet class.
+	// A route with the same destination as a route of the subnet class takes precedence over it.
+	// Ignored unless the subnet is managed by the provider.
+	// +optional
+	AdditionalRoutes []Route `json:"additionalRoutes,omitempty"`
+}
+
+// AdditionalRoutes defines additional routes for the route tables of a class of subnets.
+type AdditionalRoutes struct {
+	// Public is a list of routes added to the route tables of the public subnets.
+	// +optional
+	Public []Route `json:"public,omitempty"`
+
+	// Private is a list of routes added to the route tables of the private subnets.
+	// +optional
+	Private []Route `json:"private,omitempty"`
+}
+
+// RouteTableRoutes records routes created by the provider in a route table.
+type RouteTableRoutes struct {
+	// RouteTableID is the id of the route table.
+	RouteTableID string `json:"routeTableId"`
+
+	// Destinations are the destinations of the routes: IPv4 CIDR blocks, IPv6 CIDR blocks or prefix list ids.
+	// +optional
+	Destinations []string `json:"destinations,omitempty"`
+}
+
+// Route defines a route of a subnet route table.
+// Exactly one destination and exactly one target must be set.
+type Route struct {
+	// DestinationCIDRBlock is the IPv4 CIDR block matched by the route.
+	// +optional
+	DestinationCIDRBlock string `json:"destinationCidrBlock,omitempty"`
+
+	// DestinationIPv6CIDRBlock is the IPv6 CIDR block matched by the route.
+	// +optional
+	DestinationIPv6CIDRBlock string `json:"destinationIpv6CidrBlock,omitempty"`
+
+	// DestinationPrefixListID is the ID of the managed prefix list matched by the route.
+	// +optional
+	DestinationPrefixListID string `json:"destinationPrefixListId,omitempty"`
+
+	// VPCPeeringConnectionID is the ID of the VPC peering connection to route the traffic to.
+	// +optional
+	VPCPeeringConnectionID string `json:"vpcPeeringConnectionId,omitempty"`
+
+	// VirtualPrivateGatewayID is the ID of the virtual private gateway to route the traffic to.
+	// +optional
+	VirtualPrivateGatewayID string `json:"virtualPrivateGatewayId,omitempty"`
+
+	// TransitGatewayID is the ID of the transit gateway to route the traffic to.
+	// +optional
+	TransitGatewayID string `json:"transitGatewayId,omitempty"`
+}
+
+// NetworkACLs defines the network ACLs of the public and private subnets.
+type NetworkACLs struct {
+	// Public is the network ACL associated with the public subnets.
+	// When not set, the public subnets use the default network ACL of the VPC.
+	// +optional
+	Public *NetworkACL `json:"public,omitempty"`
+
+	// Private is the network ACL associated with the private subnets.
+	// When not set, the private subnets use the default network ACL of the VPC.
+	// +optional
+	Private *NetworkACL `json:"private,omitempty"`
+}
+
+// NetworkACL defines the rules of a network ACL.
+// Traffic not matched by any rule is denied.
+type NetworkACL struct {
+	// Ingress is the list of rules evaluated for the traffic entering the subnets.
+	// +optional
+	Ingress []NetworkACLRule `json:"ingress,omitempty"`
+
+	// Egress is the list of rules evaluated for the traffic leaving the subnets.
+	// +optional
+	Egress []NetworkACLRule `json:"egress,omitempty"`
+}
+
+// NetworkACLProtocol defines the protocol matched by a network ACL rule.
+type NetworkACLProtocol string
+
+const (
+	// NetworkACLProtocolAll matches all the protocols.
+	NetworkACLProtocolAll = NetworkACLProtocol("-1")
+	// NetworkACLProtocolTCP matches TCP.
+	NetworkACLProtocolTCP = NetworkACLProtocol("tcp")
+	// NetworkACLProtocolUDP matches UDP.
+	NetworkACLProtocolUDP = NetworkACLProtocol("udp")
+	// NetworkACLProtocolICMP matches ICMP.
+	NetworkACLProtocolICMP = NetworkACLProtocol("icmp")
+	// NetworkACLProtocolICMPv6 matches ICMPv6.
+	NetworkACLProtocolICMPv6 = NetworkACLProtocol("icmpv6")
+)
+
+// NetworkACLRuleAction defines whether the traffic matched by a network ACL rule is allowed or denied.
+type NetworkACLRuleAction string
+
+const (
+	// NetworkACLRuleActionAllow allows the traffic matched by the rule.
+	NetworkACLRuleActionAllow = NetworkACLRuleAction("allow")
+	// NetworkACLRuleActionDeny denies the traffic matched by the rule.
+	NetworkACLRuleActionDeny = NetworkACLRuleAction("deny")
+)
+
+// NetworkACLRule defines a rule of a network ACL.
+// Rules are evaluated in increasing order of their rule number, the first matching rule applies.
+type NetworkACLRule struct {
+	// RuleNumber is the number of the rule, unique per direction.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=32766
+	RuleNumber int64 `json:"ruleNumber"`
+
+	// Protocol is the protocol matched by the rule.
+	// +kubebuilder:validation:Enum="-1";tcp;udp;icmp;icmpv6
+	Protocol NetworkACLProtocol `json:"protocol"`
+
+	// RuleAction defines whether the matched traffic is allowed or denied.
+	// +kubebuilder:validation:Enum=allow;deny
+	RuleAction NetworkACLRuleAction `json:"ruleAction"`
+
+	// CIDRBlock is the IPv4 CIDR block matched by the rule.
+	// Exactly one of CIDRBlock and IPv6CIDRBlock must be set.
+	// +optional
+	CIDRBlock string `json:"cidrBlock,omitempty"`
+
+	// IPv6CIDRBlock is the IPv6 CIDR block matched by the rule.
+	// Exactly one of CIDRBlock and IPv6CIDRBlock must be set.
+	// +optional
+	IPv6CIDRBlock string `json:"ipv6CidrBlock,omitempty"`
+
+	// FromPort is the first port of the range matched by the rule. Required for TCP and UDP.
+	// +optional
+	FromPort *int64 `json:"fromPort,omitempty"`
+
+	// ToPort is the last port of the range matched by the rule. Required for TCP and UDP.
+	// +optional
+	ToPort *int64 `json:"toPort,omitempty"`
+
+	// ICMPType is the ICMP type matched by the rule, -1 for all types. Required for ICMP and ICMPv6.
+	// +optional
+	ICMPType *int64 `json:"icmpType,omitempty"`
+
+	// ICMPCode is the ICMP code matched by the rule, -1 for all codes. Required for ICMP and ICMPv6.
+	// +optional
+	ICMPCode *int64 `json:"icmpCode,omitempty"`
 }
 
 // String returns a string representation of the subnet.
@@ -348,6 +780,16 @@ func (s *SubnetSpec) String() string {
 	return fmt.Sprintf("id=%s/az=%s/public=%v", s.ID, s.AvailabilityZone, s.IsPublic)
 }
 
+// IsEdge returns true if the subnet is in a Local Zone or a Wavelength Zone.
+func (s *SubnetSpec) IsEdge() bool {
+	return s.ZoneType != nil && *s.ZoneType != ZoneTypeAvailabilityZone
+}
+
+// IsEdgeWavelength returns true if the subnet is in a Wavelength Zone.
+func (s *SubnetSpec) IsEdgeWavelength() bool {
+	return s.ZoneType != nil && *s.ZoneType == ZoneTypeWavelengthZone
+}
+
 // Subnets is a slice of Subnet.
 // +listType=map
 // +listMapKey=id
@@ -398,6 +840,10 @@ func (s Subnets) FindEqual(spec *SubnetSpec) *SubnetSpec {
 // FilterPrivate returns a slice containing all subnets marked as private.
 func (s Subnets) FilterPrivate() (res Subnets) {
 	for _, x := range s {
+		// Edge subnets are not used by the core infrastructure of the cluster.
+		if x.IsEdge() {
+			continue
+		}
 		if !x.IsPublic {
 			res = append(res, x)
 		}
@@ -408,6 +854,10 @@ func (s Subnets) FilterPrivate() (res Subnets) {
 // FilterPublic returns a slice containing all subnets marked as public.
 func (s Subnets) FilterPublic() (res Subnets) {
 	for _, x := range s {
+		// Edge subnets are not used by the core infrastructure of the cluster.
+		if x.IsEdge() {
+			continue
+		}
 		if x.IsPublic {
 			res = append(res, x)
 		}
@@ -415,6 +865,26 @@ func (s Subnets) FilterPublic() (res Subnets) {
 	return
 }
 
+// FilterEdge returns a slice containing all subnets in Local Zones or Wavelength Zones.
+func (s Subnets) FilterEdge() (res Subnets) {
+	for _, x := range s {
+		if x.IsEdge() {
+			res = append(res, x)
+		}
+	}
+	return
+}
+
+// FilterNonEdge returns a slice containing all subnets in standard availability zones.
+func (s Subnets) FilterNonEdge() (res Subnets) {
+	for _, x := range s {
+		if !x.IsEdge() {
+			res = append(res, x)
+		}
+	}
+	return
+}
+
 // FilterByZone returns a slice containing all subnets that live in the availability zone specified.
 func (s Subnets) FilterByZone(zone string) (res Subnets) {
 	for _, x := range s {
@@ -497,6 +967,10 @@ type SecurityGroup struct {
 	// +optional
 	IngressRules IngressRules `json:"ingressRule,omitempty"`
 
+	// EgressRules is the outbound rules associated with the security group.
+	// +optional
+	EgressRules EgressRules `json:"egressRule,omitempty"`
+
 	// Tags is a map of tags associated with the security group.
 	Tags Tags `json:"tags,omitempty"`
 }
@@ -557,6 +1031,11 @@ type IngressRule struct {
 	// The field will be combined with source security group IDs if specified.
 	// +optional
 	SourceSecurityGroupRoles []SecurityGroupRole `json:"sourceSecurityGroupRoles,omitempty"`
+
+	// The ids of the managed prefix lists to allow access from.
+	// Cannot be specified with CidrBlocks or source security groups.
+	// +optional
+	SourcePrefixListIDs []string `json:"sourcePrefixListIds,omitempty"`
 }
 
 // String returns a string representation of the ingress rule.
@@ -630,6 +1109,10 @@ func (i *IngressRule) Equals(o *IngressRule) bool {
 		}
 	}
 
+	if !stringSetsEqual(i.SourcePrefixListIDs, o.SourcePrefixListIDs) {
+		return false
+	}
+
 	if i.Description != o.Description || i.Protocol != o.Protocol {
 		return false
 	}
@@ -649,3 +1132,117 @@ func (i *IngressRule) Equals(o *IngressRule) bool {
 
 	return true
 }
+
+// EgressRule defines an AWS egress rule for security groups.
+type EgressRule struct {
+	// Description provides extended information about the egress rule.
+	// +optional
+	Description string `json:"description,omitempty"`
+	// Protocol is the protocol for the egress rule. Accepted values are "-1" (all), "4" (IP in IP),"tcp", "udp", "icmp", and "58" (ICMPv6).
+	// +kubebuilder:validation:Enum="-1";"4";tcp;udp;icmp;"58"
+	Protocol SecurityGroupProtocol `json:"protocol"`
+	// FromPort is the start of port range.
+	// +optional
+	FromPort int64 `json:"fromPort,omitempty"`
+	// ToPort is the end of port range.
+	// +optional
+	ToPort int64 `json:"toPort,omitempty"`
+
+	// List of CIDR blocks to allow access to.
+	// +optional
+	CidrBlocks []string `json:"cidrBlocks,omitempty"`
+
+	// List of IPv6 CIDR blocks to allow access to.
+	// +optional
+	IPv6CidrBlocks []string `json:"ipv6CidrBlocks,omitempty"`
+
+	// The security group ids to allow access to.
+	// +optional
+	DestinationSecurityGroupIDs []string `json:"destinationSecurityGroupIds,omitempty"`
+
+	// The security group roles to allow access to.
+	// The field will be combined with destination security group IDs if specified.
+	// +optional
+	DestinationSecurityGroupRoles []SecurityGroupRole `json:"destinationSecurityGroupRoles,omitempty"`
+
+	// The ids of the managed prefix lists to allow access to.
+	// Cannot be specified with CidrBlocks or destination security groups.
+	// +optional
+	DestinationPrefixListIDs []string `json:"destinationPrefixListIds,omitempty"`
+}
+
+// String returns a string representation of the egress rule.
+func (e EgressRule) String() string {
+	return fmt.Sprintf("protocol=%s/range=[%d-%d]/description=%s", e.Protocol, e.FromPort, e.ToPort, e.Description)
+}
+
+// EgressRules is a slice of AWS egress rules for security groups.
+type EgressRules []EgressRule
+
+// Difference returns the difference between this slice and the other slice.
+func (e EgressRules) Difference(o EgressRules) (out EgressRules) {
+	for index := range e {
+		x := e[index]
+		found := false
+		for oIndex := range o {
+			y := o[oIndex]
+			if x.Equals(&y) {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			out = append(out, x)
+		}
+	}
+
+	return
+}
+
+// Equals returns true if two EgressRule are equal.
+func (e *EgressRule) Equals(o *EgressRule) bool {
+	if !stringSetsEqual(e.CidrBlocks, o.CidrBlocks) ||
+		!stringSetsEqual(e.IPv6CidrBlocks, o.IPv6CidrBlocks) ||
+		!stringSetsEqual(e.DestinationSecurityGroupIDs, o.DestinationSecurityGroupIDs) ||
+		!stringSetsEqual(e.DestinationPrefixListIDs, o.DestinationPrefixListIDs) {
+		return false
+	}
+
+	if e.Description != o.Description || e.Protocol != o.Protocol {
+		return false
+	}
+
+	// From/To ports only apply to protocols that have ports or types, see IngressRule.Equals.
+	switch e.Protocol {
+	case SecurityGroupProtocolTCP,
+		SecurityGroupProtocolUDP,
+		SecurityGroupProtocolICMP,
+		SecurityGroupProtocolICMPv6:
+		return e.FromPort == o.FromPort && e.ToPort == o.ToPort
+	case SecurityGroupProtocolAll, SecurityGroupProtocolIPinIP:
+		// FromPort / ToPort are not applicable
+	}
+
+	return true
+}
+
+// stringSetsEqual returns true if both slices hold the same strings, regardless of their order.
+func stringSetsEqual(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	a = append([]string(nil), a...)
+	b = append([]string(nil), b...)
+	sort.Strings(a)
+	sort.Strings(b)
+
+	for i, v := range a {
+		if v != b[i] {
+			return false
+		}
+	}
+
+	return true
+}
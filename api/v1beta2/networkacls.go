@@ -0,0 +1,105 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta2
+
+import (
+	"net"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// Validate will validate the network ACLs.
+func (n *NetworkACLs) Validate() field.ErrorList {
+	var errs field.ErrorList
+
+	if n == nil {
+		return errs
+	}
+
+	aclsPath := field.NewPath("spec", "network", "networkAcls")
+	errs = append(errs, n.Public.validate(aclsPath.Child("public"))...)
+	errs = append(errs, n.Private.validate(aclsPath.Child("private"))...)
+
+	return errs
+}
+
+func (n *NetworkACL) validate(path *field.Path) field.ErrorList {
+	var errs field.ErrorList
+
+	if n == nil {
+		return errs
+	}
+
+	errs = append(errs, validateNetworkACLRules(path.Child("ingress"), n.Ingress)...)
+	errs = append(errs, validateNetworkACLRules(path.Child("egress"), n.Egress)...)
+
+	return errs
+}
+
+func validateNetworkACLRules(path *field.Path, rules []NetworkACLRule) field.ErrorList {
+	var errs field.ErrorList
+
+	ruleNumbers := make(map[int64]struct{}, len(rules))
+	for i := range rules {
+		rule := rules[i]
+		rulePath := path.Index(i)
+		errs = append(errs, rule.validate(rulePath)...)
+
+		if _, ok := ruleNumbers[rule.RuleNumber]; ok {
+			errs = append(errs, field.Duplicate(rulePath.Child("ruleNumber"), rule.RuleNumber))
+		}
+		ruleNumbers[rule.RuleNumber] = struct{}{}
+	}
+
+	return errs
+}
+
+func (r *NetworkACLRule) validate(path *field.Path) field.ErrorList {
+	var errs field.ErrorList
+
+	if r.RuleNumber < 1 || r.RuleNumber > 32766 {
+		errs = append(errs, field.Invalid(path.Child("ruleNumber"), r.RuleNumber, "must be between 1 and 32766"))
+	}
+
+	switch {
+	case r.CIDRBlock != "" && r.IPv6CIDRBlock != "", r.CIDRBlock == "" && r.IPv6CIDRBlock == "":
+		errs = append(errs, field.Invalid(path, r, "exactly one of cidrBlock or ipv6CidrBlock must be set"))
+	case r.CIDRBlock != "":
+		if ip, _, err := net.ParseCIDR(r.CIDRBlock); err != nil || ip.To4() == nil {
+			errs = append(errs, field.Invalid(path.Child("cidrBlock"), r.CIDRBlock, "must be a valid IPv4 CIDR block"))
+		}
+	default:
+		if ip, _, err := net.ParseCIDR(r.IPv6CIDRBlock); err != nil || ip.To4() != nil {
+			errs = append(errs, field.Invalid(path.Child("ipv6CidrBlock"), r.IPv6CIDRBlock, "must be a valid IPv6 CIDR block"))
+		}
+	}
+
+	switch r.Protocol {
+	case NetworkACLProtocolTCP, NetworkACLProtocolUDP:
+		if r.FromPort == nil || r.ToPort == nil {
+			errs = append(errs, field.Required(path.Child("fromPort"), "fromPort and toPort are required for TCP and UDP"))
+		} else if *r.FromPort < 0 || *r.ToPort > 65535 || *r.FromPort > *r.ToPort {
+			errs = append(errs, field.Invalid(path.Child("fromPort"), *r.FromPort, "must define a valid port range between 0 and 65535"))
+		}
+	case NetworkACLProtocolICMP, NetworkACLProtocolICMPv6:
+		if r.ICMPType == nil || r.ICMPCode == nil {
+			errs = append(errs, field.Required(path.Child("icmpType"), "icmpType and icmpCode are required for ICMP and ICMPv6"))
+		}
+	}
+
+	return errs
+}
@@ -0,0 +1,66 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta2
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// validateAdditionalNetworkInterfaces checks that every additional network interface has its own device index on
+// its network card, that it does not take the device index of one of the existing network interfaces attached to
+// the instance, and that its subnet and security groups are referenced by either ID or filters.
+// EC2 does not assign a public IPv4 address to instances launched with more than one network interface, so a
+// public IP cannot be requested together with additional network interfaces.
+func validateAdditionalNetworkInterfaces(fldPath *field.Path, interfaces []AdditionalNetworkInterface, existingInterfaces int, publicIP *bool) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if len(interfaces) == 0 {
+		return allErrs
+	}
+
+	if publicIP != nil && *publicIP {
+		allErrs = append(allErrs, field.Forbidden(fldPath, "cannot be set together with publicIP, public IPv4 addresses are not assigned to instances with several network interfaces"))
+	}
+
+	deviceIndexes := map[string]struct{}{}
+	for i, eni := range interfaces {
+		eniPath := fldPath.Index(i)
+
+		key := fmt.Sprintf("%d/%d", eni.NetworkCardIndex, eni.DeviceIndex)
+		if _, ok := deviceIndexes[key]; ok {
+			allErrs = append(allErrs, field.Duplicate(eniPath.Child("deviceIndex"), eni.DeviceIndex))
+		}
+		deviceIndexes[key] = struct{}{}
+
+		if eni.NetworkCardIndex == 0 && eni.DeviceIndex < int64(existingInterfaces) {
+			allErrs = append(allErrs, field.Invalid(eniPath.Child("deviceIndex"), eni.DeviceIndex, fmt.Sprintf("device indexes below %d are used by networkInterfaces", existingInterfaces)))
+		}
+
+		if eni.Subnet != nil && eni.Subnet.ID != nil && len(eni.Subnet.Filters) > 0 {
+			allErrs = append(allErrs, field.Forbidden(eniPath.Child("subnet"), "only one of ID or Filters may be specified, specifying both is forbidden"))
+		}
+		for j, sg := range eni.SecurityGroups {
+			if sg.ID != nil && len(sg.Filters) > 0 {
+				allErrs = append(allErrs, field.Forbidden(eniPath.Child("securityGroups").Index(j), "only one of ID or Filters may be specified, specifying both is forbidden"))
+			}
+		}
+	}
+
+	return allErrs
+}
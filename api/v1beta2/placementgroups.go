@@ -0,0 +1,67 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta2
+
+import (
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// validatePlacementGroups checks that placement group names are unique and that a partition count is only
+// set on groups using the partition strategy.
+func validatePlacementGroups(groups []PlacementGroup) field.ErrorList {
+	var allErrs field.ErrorList
+
+	groupsPath := field.NewPath("spec", "placementGroups")
+	names := map[string]struct{}{}
+	for i, group := range groups {
+		if _, ok := names[group.Name]; ok {
+			allErrs = append(allErrs, field.Duplicate(groupsPath.Index(i).Child("name"), group.Name))
+		}
+		names[group.Name] = struct{}{}
+
+		if group.PartitionCount != nil && group.Strategy != PlacementGroupStrategyPartition {
+			allErrs = append(allErrs, field.Forbidden(groupsPath.Index(i).Child("partitionCount"), "can only be set when strategy is partition"))
+		}
+	}
+
+	return allErrs
+}
+
+// validatePlacementGroupsUpdate rejects changes to placement groups that already exist, as AWS
+// does not allow modifying a placement group once it has been created.
+func validatePlacementGroupsUpdate(oldGroups, newGroups []PlacementGroup) field.ErrorList {
+	var allErrs field.ErrorList
+
+	existing := make(map[string]PlacementGroup, len(oldGroups))
+	for _, group := range oldGroups {
+		existing[group.Name] = group
+	}
+
+	groupsPath := field.NewPath("spec", "placementGroups")
+	for i, group := range newGroups {
+		old, ok := existing[group.Name]
+		if !ok {
+			continue
+		}
+		if !cmp.Equal(old, group) {
+			allErrs = append(allErrs, field.Invalid(groupsPath.Index(i), group, "placement groups are immutable, remove the entry and add a new one with a different name instead"))
+		}
+	}
+
+	return allErrs
+}
@@ -0,0 +1,130 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta2
+
+import (
+	"net"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// Destination returns the destination of the route, whatever its kind.
+func (r *Route) Destination() string {
+	switch {
+	case r.DestinationCIDRBlock != "":
+		return r.DestinationCIDRBlock
+	case r.DestinationIPv6CIDRBlock != "":
+		return r.DestinationIPv6CIDRBlock
+	default:
+		return r.DestinationPrefixListID
+	}
+}
+
+// ValidateAdditionalRoutes will validate the additional routes of the network and of its subnets.
+func (n *NetworkSpec) ValidateAdditionalRoutes() field.ErrorList {
+	var errs field.ErrorList
+
+	if n.AdditionalRoutes != nil {
+		routesPath := field.NewPath("spec", "network", "additionalRoutes")
+		errs = append(errs, validateRoutes(routesPath.Child("public"), n.AdditionalRoutes.Public)...)
+		errs = append(errs, validateRoutes(routesPath.Child("private"), n.AdditionalRoutes.Private)...)
+	}
+
+	subnetsPath := field.NewPath("spec", "network", "subnets")
+	for i := range n.Subnets {
+		errs = append(errs, validateRoutes(subnetsPath.Index(i).Child("additionalRoutes"), n.Subnets[i].AdditionalRoutes)...)
+	}
+
+	return errs
+}
+
+func validateRoutes(path *field.Path, routes []Route) field.ErrorList {
+	var errs field.ErrorList
+
+	destinations := make(map[string]struct{}, len(routes))
+	for i := range routes {
+		route := routes[i]
+		routePath := path.Index(i)
+		errs = append(errs, route.validate(routePath)...)
+
+		destination := route.Destination()
+		if _, ok := destinations[destination]; ok && destination != "" {
+			errs = append(errs, field.Duplicate(routePath, destination))
+		}
+		destinations[destination] = struct{}{}
+	}
+
+	return errs
+}
+
+func (r *Route) validate(path *field.Path) field.ErrorList {
+	var errs field.ErrorList
+
+	destinations := 0
+	if r.DestinationCIDRBlock != "" {
+		destinations++
+		ip, ipNet, err := net.ParseCIDR(r.DestinationCIDRBlock)
+		if err != nil || ip.To4() == nil {
+			errs = append(errs, field.Invalid(path.Child("destinationCidrBlock"), r.DestinationCIDRBlock, "must be a valid IPv4 CIDR block"))
+		} else if ones, _ := ipNet.Mask.Size(); ones == 0 {
+			errs = append(errs, field.Invalid(path.Child("destinationCidrBlock"), r.DestinationCIDRBlock, "the default route is managed by the provider"))
+		}
+	}
+	if r.DestinationIPv6CIDRBlock != "" {
+		destinations++
+		ip, ipNet, err := net.ParseCIDR(r.DestinationIPv6CIDRBlock)
+		if err != nil || ip.To4() != nil {
+			errs = append(errs, field.Invalid(path.Child("destinationIpv6CidrBlock"), r.DestinationIPv6CIDRBlock, "must be a valid IPv6 CIDR block"))
+		} else if ones, _ := ipNet.Mask.Size(); ones == 0 {
+			errs = append(errs, field.Invalid(path.Child("destinationIpv6CidrBlock"), r.DestinationIPv6CIDRBlock, "the default route is managed by the provider"))
+		}
+	}
+	if r.DestinationPrefixListID != "" {
+		destinations++
+		if !strings.HasPrefix(r.DestinationPrefixListID, "pl-") {
+			errs = append(errs, field.Invalid(path.Child("destinationPrefixListId"), r.DestinationPrefixListID, "must be a managed prefix list id"))
+		}
+	}
+	if destinations != 1 {
+		errs = append(errs, field.Invalid(path, r, "exactly one of destinationCidrBlock, destinationIpv6CidrBlock or destinationPrefixListId must be set"))
+	}
+
+	targets := 0
+	for _, target := range []struct {
+		name   string
+		value  string
+		prefix string
+	}{
+		{name: "vpcPeeringConnectionId", value: r.VPCPeeringConnectionID, prefix: "pcx-"},
+		{name: "virtualPrivateGatewayId", value: r.VirtualPrivateGatewayID, prefix: "vgw-"},
+		{name: "transitGatewayId", value: r.TransitGatewayID, prefix: "tgw-"},
+	} {
+		if target.value == "" {
+			continue
+		}
+		targets++
+		if !strings.HasPrefix(target.value, target.prefix) {
+			errs = append(errs, field.Invalid(path.Child(target.name), target.value, "must be an id starting with "+target.prefix))
+		}
+	}
+	if targets != 1 {
+		errs = append(errs, field.Invalid(path, r, "exactly one of vpcPeeringConnectionId, virtualPrivateGatewayId or transitGatewayId must be set"))
+	}
+
+	return errs
+}
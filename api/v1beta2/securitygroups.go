@@ -0,0 +1,257 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta2
+
+import (
+	"net"
+	"sort"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// managedSecurityGroupRoles are the roles of the security groups that can be created for a cluster.
+var managedSecurityGroupRoles = []SecurityGroupRole{
+	SecurityGroupBastion,
+	SecurityGroupNode,
+	SecurityGroupControlPlane,
+	SecurityGroupAPIServerLB,
+	SecurityGroupLB,
+	SecurityGroupEKSNodeAdditional,
+}
+
+// egressSecurityGroupRoles are the roles of the security groups whose rules are reconciled by the provider.
+// The rules of the lb security group are left to the in-cluster cloud provider.
+var egressSecurityGroupRoles = []SecurityGroupRole{
+	SecurityGroupBastion,
+	SecurityGroupNode,
+	SecurityGroupControlPlane,
+	SecurityGroupAPIServerLB,
+	SecurityGroupEKSNodeAdditional,
+}
+
+// ValidateSecurityGroupEgressRules will validate the egress rules of the security groups of the network.
+func (n *NetworkSpec) ValidateSecurityGroupEgressRules() field.ErrorList {
+	var errs field.ErrorList
+
+	egressPath := field.NewPath("spec", "network", "securityGroupEgressRules")
+
+	roles := make([]string, 0, len(n.SecurityGroupEgressRules))
+	for role := range n.SecurityGroupEgressRules {
+		roles = append(roles, string(role))
+	}
+	sort.Strings(roles)
+
+	for _, r := range roles {
+		role := SecurityGroupRole(r)
+		rolePath := egressPath.Key(r)
+
+		if !containsSecurityGroupRole(egressSecurityGroupRoles, role) {
+			errs = append(errs, field.NotSupported(rolePath, r, securityGroupRolesToStrings(egressSecurityGroupRoles)))
+			continue
+		}
+		if _, ok := n.SecurityGroupOverrides[role]; ok {
+			errs = append(errs, field.Forbidden(rolePath, "egress rules cannot be set on a security group override"))
+			continue
+		}
+
+		for i := range n.SecurityGroupEgressRules[role] {
+			errs = append(errs, n.SecurityGroupEgressRules[role][i].validate(rolePath.Index(i))...)
+		}
+	}
+
+	return errs
+}
+
+// ValidateAdditionalIngressRules will validate the additional ingress rules of the security groups of the network.
+func (n *NetworkSpec) ValidateAdditionalIngressRules() field.ErrorList {
+	var errs field.ErrorList
+
+	ingressPath := field.NewPath("spec", "network", "additionalIngressRules")
+
+	roles := make([]string, 0, len(n.AdditionalIngressRules))
+	for role := range n.AdditionalIngressRules {
+		roles = append(roles, string(role))
+	}
+	sort.Strings(roles)
+
+	for _, r := range roles {
+		role := SecurityGroupRole(r)
+		rolePath := ingressPath.Key(r)
+
+		if !containsSecurityGroupRole(managedSecurityGroupRoles, role) {
+			errs = append(errs, field.NotSupported(rolePath, r, securityGroupRolesToStrings(managedSecurityGroupRoles)))
+			continue
+		}
+		if _, ok := n.SecurityGroupOverrides[role]; ok {
+			errs = append(errs, field.Forbidden(rolePath, "additional ingress rules cannot be set on a security group override"))
+			continue
+		}
+
+		for i := range n.AdditionalIngressRules[role] {
+			errs = append(errs, n.AdditionalIngressRules[role][i].validate(rolePath.Index(i))...)
+		}
+	}
+
+	return errs
+}
+
+func (e *EgressRule) validate(path *field.Path) field.ErrorList {
+	var errs field.ErrorList
+
+	hasCIDRBlocks := len(e.CidrBlocks) > 0 || len(e.IPv6CidrBlocks) > 0
+	hasSecurityGroups := len(e.DestinationSecurityGroupIDs) > 0 || len(e.DestinationSecurityGroupRoles) > 0
+	hasPrefixLists := len(e.DestinationPrefixListIDs) > 0
+
+	if !hasCIDRBlocks && !hasSecurityGroups && !hasPrefixLists {
+		errs = append(errs, field.Required(path, "at least one of cidrBlocks, ipv6CidrBlocks, destinationSecurityGroupIds, destinationSecurityGroupRoles or destinationPrefixListIds must be set"))
+	}
+	if hasCIDRBlocks && hasSecurityGroups {
+		errs = append(errs, field.Invalid(path, e.String(), "CIDR blocks and security group IDs or security group roles cannot be used together"))
+	}
+	if hasPrefixLists && (hasCIDRBlocks || hasSecurityGroups) {
+		errs = append(errs, field.Invalid(path, e.String(), "prefix lists cannot be used together with CIDR blocks, security group IDs or security group roles"))
+	}
+	errs = append(errs, validateRulePrefixListIDs(path.Child("destinationPrefixListIds"), e.DestinationPrefixListIDs)...)
+
+	errs = append(errs, validateRuleCIDRBlocks(path, e.CidrBlocks, e.IPv6CidrBlocks)...)
+	errs = append(errs, validateRuleRoles(path.Child("destinationSecurityGroupRoles"), e.DestinationSecurityGroupRoles)...)
+	errs = append(errs, validateRulePorts(path, e.Protocol, e.FromPort, e.ToPort)...)
+
+	return errs
+}
+
+func (i *IngressRule) validate(path *field.Path) field.ErrorList {
+	var errs field.ErrorList
+
+	if len(i.CidrBlocks) == 0 && len(i.IPv6CidrBlocks) == 0 &&
+		len(i.SourceSecurityGroupIDs) == 0 && len(i.SourceSecurityGroupRoles) == 0 && len(i.SourcePrefixListIDs) == 0 {
+		errs = append(errs, field.Required(path, "at least one of cidrBlocks, ipv6CidrBlocks, sourceSecurityGroupIds, sourceSecurityGroupRoles or sourcePrefixListIds must be set"))
+	}
+	errs = append(errs, i.validateSources(path)...)
+	errs = append(errs, validateRuleCIDRBlocks(path, i.CidrBlocks, i.IPv6CidrBlocks)...)
+	errs = append(errs, validateRuleRoles(path.Child("sourceSecurityGroupRoles"), i.SourceSecurityGroupRoles)...)
+	errs = append(errs, validateRulePorts(path, i.Protocol, i.FromPort, i.ToPort)...)
+
+	return errs
+}
+
+// validateSources validates that the rule has a single kind of source, as EC2 reports each kind of source
+// in its own rule, and that the prefix list ids are well-formed.
+func (i *IngressRule) validateSources(path *field.Path) field.ErrorList {
+	var errs field.ErrorList
+
+	hasCIDRBlocks := len(i.CidrBlocks) > 0 || len(i.IPv6CidrBlocks) > 0
+	hasSecurityGroups := len(i.SourceSecurityGroupIDs) > 0 || len(i.SourceSecurityGroupRoles) > 0
+
+	if hasCIDRBlocks && hasSecurityGroups {
+		errs = append(errs, field.Invalid(path, i.String(), "CIDR blocks and security group IDs or security group roles cannot be used together"))
+	}
+
+	return append(errs, i.validatePrefixListSources(path)...)
+}
+
+// validatePrefixListSources validates that the prefix lists are the only source of the rule, and that their ids are well-formed.
+func (i *IngressRule) validatePrefixListSources(path *field.Path) field.ErrorList {
+	var errs field.ErrorList
+
+	hasCIDRBlocks := len(i.CidrBlocks) > 0 || len(i.IPv6CidrBlocks) > 0
+	hasSecurityGroups := len(i.SourceSecurityGroupIDs) > 0 || len(i.SourceSecurityGroupRoles) > 0
+	hasPrefixLists := len(i.SourcePrefixListIDs) > 0
+
+	if hasPrefixLists && (hasCIDRBlocks || hasSecurityGroups) {
+		errs = append(errs, field.Invalid(path, i.String(), "prefix lists cannot be used together with CIDR blocks, security group IDs or security group roles"))
+	}
+
+	return append(errs, validateRulePrefixListIDs(path.Child("sourcePrefixListIds"), i.SourcePrefixListIDs)...)
+}
+
+func validateRulePrefixListIDs(path *field.Path, ids []string) field.ErrorList {
+	var errs field.ErrorList
+
+	for i, id := range ids {
+		if !strings.HasPrefix(id, "pl-") {
+			errs = append(errs, field.Invalid(path.Index(i), id, "must be a managed prefix list id"))
+		}
+	}
+
+	return errs
+}
+
+func validateRuleCIDRBlocks(path *field.Path, cidrBlocks, ipv6CidrBlocks []string) field.ErrorList {
+	var errs field.ErrorList
+
+	for i, cidr := range cidrBlocks {
+		if ip, _, err := net.ParseCIDR(cidr); err != nil || ip.To4() == nil {
+			errs = append(errs, field.Invalid(path.Child("cidrBlocks").Index(i), cidr, "must be a valid IPv4 CIDR block"))
+		}
+	}
+	for i, cidr := range ipv6CidrBlocks {
+		if ip, _, err := net.ParseCIDR(cidr); err != nil || ip.To4() != nil {
+			errs = append(errs, field.Invalid(path.Child("ipv6CidrBlocks").Index(i), cidr, "must be a valid IPv6 CIDR block"))
+		}
+	}
+
+	return errs
+}
+
+func validateRuleRoles(path *field.Path, roles []SecurityGroupRole) field.ErrorList {
+	var errs field.ErrorList
+
+	for i, role := range roles {
+		if !containsSecurityGroupRole(managedSecurityGroupRoles, role) {
+			errs = append(errs, field.NotSupported(path.Index(i), role, securityGroupRolesToStrings(managedSecurityGroupRoles)))
+		}
+	}
+
+	return errs
+}
+
+func validateRulePorts(path *field.Path, protocol SecurityGroupProtocol, fromPort, toPort int64) field.ErrorList {
+	var errs field.ErrorList
+
+	switch protocol {
+	case SecurityGroupProtocolTCP, SecurityGroupProtocolUDP:
+		if fromPort < 0 || fromPort > 65535 {
+			errs = append(errs, field.Invalid(path.Child("fromPort"), fromPort, "must be a port between 0 and 65535"))
+		}
+		if toPort < fromPort || toPort > 65535 {
+			errs = append(errs, field.Invalid(path.Child("toPort"), toPort, "must be a port between fromPort and 65535"))
+		}
+	case SecurityGroupProtocolAll, SecurityGroupProtocolIPinIP, SecurityGroupProtocolICMP, SecurityGroupProtocolICMPv6:
+		// Ports are not applicable, or hold the ICMP type and code.
+	}
+
+	return errs
+}
+
+func containsSecurityGroupRole(roles []SecurityGroupRole, role SecurityGroupRole) bool {
+	for _, r := range roles {
+		if r == role {
+			return true
+		}
+	}
+	return false
+}
+
+func securityGroupRolesToStrings(roles []SecurityGroupRole) []string {
+	out := make([]string, 0, len(roles))
+	for _, role := range roles {
+		out = append(out, string(role))
+	}
+	return out
+}
@@ -76,6 +76,9 @@ func setup() {
 	if err := (&AWSClusterStaticIdentity{}).SetupWebhookWithManager(testEnv); err != nil {
 		panic(fmt.Sprintf("Unable to setup AWSClusterStaticIdentity webhook: %v", err))
 	}
+	if err := (&AWSClusterWebIdentity{}).SetupWebhookWithManager(testEnv); err != nil {
+		panic(fmt.Sprintf("Unable to setup AWSClusterWebIdentity webhook: %v", err))
+	}
 
 	go func() {
 		fmt.Println("Starting the manager")
@@ -170,12 +170,19 @@ const (
 	// dedicated to this cluster api provider implementation.
 	NameAWSSubnetAssociation = NameAWSProviderPrefix + "association"
 
+	// NameAWSNetworkInterfaceLifecycle is the tag name we use to mark the network interfaces created together
+	// with an instance, to tell them apart from the network interfaces that were attached to it by users.
+	NameAWSNetworkInterfaceLifecycle = NameAWSProviderPrefix + "network-interface-lifecycle"
+
 	// SecondarySubnetTagValue is the secondary subnet tag constant value.
 	SecondarySubnetTagValue = "secondary"
 
 	// APIServerRoleTagValue describes the value for the apiserver role.
 	APIServerRoleTagValue = "apiserver"
 
+	// LoadBalancerRoleTagValue describes the value for the load balancer role.
+	LoadBalancerRoleTagValue = "lb"
+
 	// BastionRoleTagValue describes the value for the bastion role.
 	BastionRoleTagValue = "bastion"
 
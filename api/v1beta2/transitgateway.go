@@ -0,0 +1,51 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta2
+
+import (
+	"net"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// Validate will validate the transit gateway fields.
+func (t *TransitGatewaySpec) Validate() field.ErrorList {
+	var errs field.ErrorList
+
+	if t == nil {
+		return errs
+	}
+
+	tgwPath := field.NewPath("spec", "network", "transitGateway")
+	if t.ID == "" {
+		errs = append(errs, field.Required(tgwPath.Child("id"), "transit gateway id is required"))
+	}
+
+	for i, cidr := range t.RouteCIDRs {
+		ip, ipNet, err := net.ParseCIDR(cidr)
+		if err != nil || ip.To4() == nil {
+			errs = append(errs, field.Invalid(tgwPath.Child("routeCidrs").Index(i), cidr, "must be a valid IPv4 CIDR block"))
+			continue
+		}
+		// The default route of the private subnets goes through the NAT gateways.
+		if ones, _ := ipNet.Mask.Size(); ones == 0 {
+			errs = append(errs, field.Invalid(tgwPath.Child("routeCidrs").Index(i), cidr, "the default route cannot be routed through the transit gateway"))
+		}
+	}
+
+	return errs
+}
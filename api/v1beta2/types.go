@@ -185,6 +185,14 @@ type Instance struct {
 	// Specifies ENIs attached to instance
 	NetworkInterfaces []string `json:"networkInterfaces,omitempty"`
 
+	// AdditionalNetworkInterfaces is the configuration of the network interfaces created together with the instance.
+	// +optional
+	AdditionalNetworkInterfaces []AdditionalNetworkInterface `json:"additionalNetworkInterfaces,omitempty"`
+
+	// NetworkInterfaceAttachments describes the secondary network interfaces attached to the instance.
+	// +optional
+	NetworkInterfaceAttachments []NetworkInterfaceAttachment `json:"networkInterfaceAttachments,omitempty"`
+
 	// The tags associated with the instance.
 	Tags map[string]string `json:"tags,omitempty"`
 
@@ -198,10 +206,22 @@ type Instance struct {
 	// +optional
 	PlacementGroupName string `json:"placementGroupName,omitempty"`
 
+	// PlacementGroupPartition is the partition number within the placement group in which to launch the instance.
+	// +optional
+	PlacementGroupPartition int64 `json:"placementGroupPartition,omitempty"`
+
 	// Tenancy indicates if instance should run on shared or single-tenant hardware.
 	// +optional
 	Tenancy string `json:"tenancy,omitempty"`
 
+	// CapacityReservation describes how the instance targets On-Demand Capacity Reservations.
+	// +optional
+	CapacityReservation *CapacityReservationSpecification `json:"capacityReservation,omitempty"`
+
+	// PrivateDNSName is the options for the hostname of the instance.
+	// +optional
+	PrivateDNSName *PrivateDNSName `json:"privateDnsName,omitempty"`
+
 	// IDs of the instance's volumes
 	// +optional
 	VolumeIDs []string `json:"volumeIDs,omitempty"`
@@ -209,6 +229,11 @@ type Instance struct {
 	// InstanceMetadataOptions is the metadata options for the EC2 instance.
 	// +optional
 	InstanceMetadataOptions *InstanceMetadataOptions `json:"instanceMetadataOptions,omitempty"`
+
+	// MaintenanceOptions is the recovery, protection and shutdown options of the instance.
+	// Only the automatic recovery is reported for existing instances.
+	// +optional
+	MaintenanceOptions *InstanceMaintenanceOptions `json:"maintenanceOptions,omitempty"`
 }
 
 // InstanceMetadataState describes the state of InstanceMetadataOptions.HttpEndpoint and InstanceMetadataOptions.InstanceMetadataTags
@@ -375,6 +400,221 @@ type SpotMarketOptions struct {
 	MaxPrice *string `json:"maxPrice,omitempty"`
 }
 
+// NetworkInterfaceType is the type of a network interface.
+type NetworkInterfaceType string
+
+const (
+	// NetworkInterfaceTypeInterface is a regular elastic network interface.
+	NetworkInterfaceTypeInterface = NetworkInterfaceType("interface")
+
+	// NetworkInterfaceTypeEFA is an Elastic Fabric Adapter, for high performance computing and machine learning
+	// workloads. It is only supported by some instance types.
+	NetworkInterfaceTypeEFA = NetworkInterfaceType("efa")
+)
+
+// AdditionalNetworkInterface describes a secondary network interface created together with an instance.
+// The network interface is deleted when the instance is terminated.
+type AdditionalNetworkInterface struct {
+	// DeviceIndex is the position of the network interface in the attachment order.
+	// Index 0 is used by the primary network interface of the instance.
+	// +kubebuilder:validation:Minimum:=1
+	DeviceIndex int64 `json:"deviceIndex"`
+
+	// NetworkCardIndex is the index of the network card the network interface is attached to.
+	// Only instance types that support multiple network cards accept a value other than 0.
+	// +optional
+	// +kubebuilder:validation:Minimum:=0
+	NetworkCardIndex int64 `json:"networkCardIndex,omitempty"`
+
+	// Subnet is a reference to the subnet the network interface is created in.
+	// Defaults to the subnet of the primary network interface.
+	// +optional
+	Subnet *AWSResourceReference `json:"subnet,omitempty"`
+
+	// SecurityGroups is a list of references to the security groups of the network interface.
+	// Defaults to the security groups of the primary network interface.
+	// +optional
+	SecurityGroups []AWSResourceReference `json:"securityGroups,omitempty"`
+
+	// InterfaceType is the type of the network interface.
+	// Defaults to interface.
+	// +optional
+	// +kubebuilder:validation:Enum:=interface;efa
+	InterfaceType NetworkInterfaceType `json:"interfaceType,omitempty"`
+
+	// Description is the description of the network interface.
+	// +optional
+	Description string `json:"description,omitempty"`
+}
+
+// NetworkInterfaceAttachment describes a secondary network interface attached to an instance.
+type NetworkInterfaceAttachment struct {
+	// ID is the ID of the network interface.
+	ID string `json:"id"`
+
+	// DeviceIndex is the position of the network interface in the attachment order.
+	DeviceIndex int64 `json:"deviceIndex"`
+
+	// NetworkCardIndex is the index of the network card the network interface is attached to.
+	// +optional
+	NetworkCardIndex int64 `json:"networkCardIndex,omitempty"`
+
+	// SubnetID is the ID of the subnet of the network interface.
+	// +optional
+	SubnetID string `json:"subnetId,omitempty"`
+
+	// InterfaceType is the type of the network interface.
+	// +optional
+	InterfaceType NetworkInterfaceType `json:"interfaceType,omitempty"`
+
+	// PrivateIPAddress is the primary private IPv4 address of the network interface.
+	// +optional
+	PrivateIPAddress string `json:"privateIpAddress,omitempty"`
+
+	// DeleteOnTermination indicates whether the network interface is deleted when the instance is terminated.
+	// +optional
+	DeleteOnTermination bool `json:"deleteOnTermination,omitempty"`
+}
+
+// HostnameType is the type of hostname assigned to an instance.
+type HostnameType string
+
+const (
+	// HostnameTypeIPName is the hostname based on the private IPv4 address of the instance, e.g. ip-10-0-0-1.ec2.internal.
+	HostnameTypeIPName = HostnameType("ip-name")
+
+	// HostnameTypeResourceName is the hostname based on the ID of the instance, e.g. i-0123456789abcdef0.ec2.internal.
+	// It is required for instances in IPv6-only subnets.
+	HostnameTypeResourceName = HostnameType("resource-name")
+)
+
+// PrivateDNSName describes the hostname of an instance and the DNS records that resolve it.
+type PrivateDNSName struct {
+	// HostnameType is the type of hostname assigned to the instance.
+	// +optional
+	// +kubebuilder:validation:Enum:=ip-name;resource-name
+	HostnameType HostnameType `json:"hostnameType,omitempty"`
+
+	// EnableResourceNameDNSARecord indicates whether to respond to DNS queries for the resource name hostname
+	// of the instance with DNS A records.
+	// +optional
+	EnableResourceNameDNSARecord *bool `json:"enableResourceNameDnsARecord,omitempty"`
+
+	// EnableResourceNameDNSAAAARecord indicates whether to respond to DNS queries for the resource name hostname
+	// of the instance with DNS AAAA records.
+	// +optional
+	EnableResourceNameDNSAAAARecord *bool `json:"enableResourceNameDnsAAAARecord,omitempty"`
+}
+
+// AutoRecoveryState describes whether EC2 automatically recovers an instance after a system status check failure.
+type AutoRecoveryState string
+
+const (
+	// AutoRecoveryStateDefault recovers the instance automatically, if the instance type supports it.
+	AutoRecoveryStateDefault = AutoRecoveryState("default")
+
+	// AutoRecoveryStateDisabled disables the automatic recovery of the instance.
+	AutoRecoveryStateDisabled = AutoRecoveryState("disabled")
+)
+
+// ShutdownBehavior describes what happens to an instance when it is shut down from the operating system.
+type ShutdownBehavior string
+
+const (
+	// ShutdownBehaviorStop stops the instance.
+	ShutdownBehaviorStop = ShutdownBehavior("stop")
+
+	// ShutdownBehaviorTerminate terminates the instance.
+	ShutdownBehaviorTerminate = ShutdownBehavior("terminate")
+)
+
+// InstanceMaintenanceOptions describes the automatic recovery, the termination and stop protection,
+// and the behavior on instance-initiated shutdown of an instance.
+type InstanceMaintenanceOptions struct {
+	// AutoRecovery sets whether the instance is recovered automatically after a system status check failure.
+	// When unset, the default of EC2 applies.
+	// +optional
+	// +kubebuilder:validation:Enum:=default;disabled
+	AutoRecovery AutoRecoveryState `json:"autoRecovery,omitempty"`
+
+	// DisableAPITermination enables termination protection, preventing the instance from being terminated
+	// through the EC2 API. Termination protection is lifted by the controller when the machine is deleted.
+	// +optional
+	DisableAPITermination *bool `json:"disableApiTermination,omitempty"`
+
+	// DisableAPIStop enables stop protection, preventing the instance from being stopped through the EC2 API.
+	// Stop protection is not supported for Spot instances.
+	// +optional
+	DisableAPIStop *bool `json:"disableApiStop,omitempty"`
+
+	// InstanceInitiatedShutdownBehavior sets whether the instance is stopped or terminated when it is shut down
+	// from the operating system. When unset, the instance is stopped.
+	// +optional
+	// +kubebuilder:validation:Enum:=stop;terminate
+	InstanceInitiatedShutdownBehavior ShutdownBehavior `json:"instanceInitiatedShutdownBehavior,omitempty"`
+}
+
+// CapacityReservationPreference describes the preference of an instance for running in an On-Demand Capacity Reservation.
+type CapacityReservationPreference string
+
+const (
+	// CapacityReservationPreferenceOpen runs the instance in any open Capacity Reservation that has matching
+	// attributes, or On-Demand capacity if none is available.
+	CapacityReservationPreferenceOpen = CapacityReservationPreference("open")
+
+	// CapacityReservationPreferenceNone avoids running the instance in a Capacity Reservation
+	// even if one is available, and runs it in On-Demand capacity.
+	CapacityReservationPreferenceNone = CapacityReservationPreference("none")
+)
+
+// ElasticIPSpec defines the Elastic IP address associated with an instance.
+// Existing unassociated addresses are reused first, from AllocationIDs and then from Filters. A new address is
+// allocated only when neither is set, or when PublicIpv4Pool is set and no existing address is available.
+type ElasticIPSpec struct {
+	// AllocationIDs is a list of pre-allocated Elastic IP allocation IDs.
+	// The first unassociated address of the list is associated with the instance.
+	// These addresses are never released by the controller.
+	// +optional
+	AllocationIDs []string `json:"allocationIds,omitempty"`
+
+	// Filters selects existing Elastic IP addresses, for example by tag.
+	// An unassociated address matching the filters is associated with the instance.
+	// These addresses are never released by the controller.
+	// +optional
+	Filters []Filter `json:"filters,omitempty"`
+
+	// PublicIpv4Pool is the ID of a public IPv4 pool (BYOIP) to allocate the address from.
+	// Addresses allocated for the machine are released when the machine is deleted.
+	// +optional
+	PublicIpv4Pool *string `json:"publicIpv4Pool,omitempty"`
+}
+
+// CapacityReservationSpecification describes how instances target On-Demand Capacity Reservations.
+// Either a preference or a target can be set.
+type CapacityReservationSpecification struct {
+	// Preference indicates whether the instance runs in any open Capacity Reservation that has matching attributes
+	// or never runs in a Capacity Reservation.
+	// +optional
+	// +kubebuilder:validation:Enum:=open;none
+	Preference CapacityReservationPreference `json:"preference,omitempty"`
+
+	// Target is the Capacity Reservation or the Capacity Reservation resource group in which to run the instance.
+	// +optional
+	Target *CapacityReservationTarget `json:"target,omitempty"`
+}
+
+// CapacityReservationTarget describes a target Capacity Reservation or Capacity Reservation resource group.
+// Either an ID or a resource group ARN can be set.
+type CapacityReservationTarget struct {
+	// ID is the ID of the Capacity Reservation in which to run the instance.
+	// +optional
+	ID *string `json:"id,omitempty"`
+
+	// ResourceGroupARN is the ARN of the Capacity Reservation resource group in which to run the instance.
+	// +optional
+	ResourceGroupARN *string `json:"resourceGroupArn,omitempty"`
+}
+
 // EKSAMILookupType specifies which AWS AMI to use for a AWSMachine and AWSMachinePool.
 type EKSAMILookupType string
 
@@ -308,6 +308,16 @@ func (in *AWSClusterSpec) DeepCopyInto(out *AWSClusterSpec) {
 		*out = new(AWSLoadBalancerSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.SecondaryControlPlaneLoadBalancer != nil {
+		in, out := &in.SecondaryControlPlaneLoadBalancer, &out.SecondaryControlPlaneLoadBalancer
+		*out = new(AWSLoadBalancerSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ControlPlaneDNS != nil {
+		in, out := &in.ControlPlaneDNS, &out.ControlPlaneDNS
+		*out = new(ControlPlaneDNS)
+		**out = **in
+	}
 	in.Bastion.DeepCopyInto(&out.Bastion)
 	if in.IdentityRef != nil {
 		in, out := &in.IdentityRef, &out.IdentityRef
@@ -319,6 +329,13 @@ func (in *AWSClusterSpec) DeepCopyInto(out *AWSClusterSpec) {
 		*out = new(S3Bucket)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.PlacementGroups != nil {
+		in, out := &in.PlacementGroups, &out.PlacementGroups
+		*out = make([]PlacementGroup, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSClusterSpec.
@@ -428,6 +445,11 @@ func (in *AWSClusterStatus) DeepCopyInto(out *AWSClusterStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.PlacementGroups != nil {
+		in, out := &in.PlacementGroups, &out.PlacementGroups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSClusterStatus.
@@ -531,6 +553,81 @@ func (in *AWSClusterTemplateSpec) DeepCopy() *AWSClusterTemplateSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSClusterWebIdentity) DeepCopyInto(out *AWSClusterWebIdentity) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSClusterWebIdentity.
+func (in *AWSClusterWebIdentity) DeepCopy() *AWSClusterWebIdentity {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSClusterWebIdentity)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AWSClusterWebIdentity) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSClusterWebIdentityList) DeepCopyInto(out *AWSClusterWebIdentityList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AWSClusterWebIdentity, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSClusterWebIdentityList.
+func (in *AWSClusterWebIdentityList) DeepCopy() *AWSClusterWebIdentityList {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSClusterWebIdentityList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AWSClusterWebIdentityList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSClusterWebIdentitySpec) DeepCopyInto(out *AWSClusterWebIdentitySpec) {
+	*out = *in
+	in.AWSClusterIdentitySpec.DeepCopyInto(&out.AWSClusterIdentitySpec)
+	in.AWSRoleSpec.DeepCopyInto(&out.AWSRoleSpec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSClusterWebIdentitySpec.
+func (in *AWSClusterWebIdentitySpec) DeepCopy() *AWSClusterWebIdentitySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSClusterWebIdentitySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AWSIdentityReference) DeepCopyInto(out *AWSIdentityReference) {
 	*out = *in
@@ -670,6 +767,11 @@ func (in *AWSMachineSpec) DeepCopyInto(out *AWSMachineSpec) {
 		*out = new(InstanceMetadataOptions)
 		**out = **in
 	}
+	if in.MaintenanceOptions != nil {
+		in, out := &in.MaintenanceOptions, &out.MaintenanceOptions
+		*out = new(InstanceMaintenanceOptions)
+		(*in).DeepCopyInto(*out)
+	}
 	in.AMI.DeepCopyInto(&out.AMI)
 	if in.AdditionalTags != nil {
 		in, out := &in.AdditionalTags, &out.AdditionalTags
@@ -683,6 +785,11 @@ func (in *AWSMachineSpec) DeepCopyInto(out *AWSMachineSpec) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.ElasticIP != nil {
+		in, out := &in.ElasticIP, &out.ElasticIP
+		*out = new(ElasticIPSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.AdditionalSecurityGroups != nil {
 		in, out := &in.AdditionalSecurityGroups, &out.AdditionalSecurityGroups
 		*out = make([]AWSResourceReference, len(*in))
@@ -717,6 +824,13 @@ func (in *AWSMachineSpec) DeepCopyInto(out *AWSMachineSpec) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.AdditionalNetworkInterfaces != nil {
+		in, out := &in.AdditionalNetworkInterfaces, &out.AdditionalNetworkInterfaces
+		*out = make([]AdditionalNetworkInterface, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.UncompressedUserData != nil {
 		in, out := &in.UncompressedUserData, &out.UncompressedUserData
 		*out = new(bool)
@@ -733,6 +847,16 @@ func (in *AWSMachineSpec) DeepCopyInto(out *AWSMachineSpec) {
 		*out = new(SpotMarketOptions)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.CapacityReservation != nil {
+		in, out := &in.CapacityReservation, &out.CapacityReservation
+		*out = new(CapacityReservationSpecification)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PrivateDNSName != nil {
+		in, out := &in.PrivateDNSName, &out.PrivateDNSName
+		*out = new(PrivateDNSName)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSMachineSpec.
@@ -758,6 +882,11 @@ func (in *AWSMachineStatus) DeepCopyInto(out *AWSMachineStatus) {
 		*out = new(InstanceState)
 		**out = **in
 	}
+	if in.MaintenanceOptions != nil {
+		in, out := &in.MaintenanceOptions, &out.MaintenanceOptions
+		*out = new(InstanceMaintenanceOptions)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.FailureReason != nil {
 		in, out := &in.FailureReason, &out.FailureReason
 		*out = new(errors.MachineStatusError)
@@ -1045,6 +1174,58 @@ func (in *AWSRoleSpec) DeepCopy() *AWSRoleSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AdditionalNetworkInterface) DeepCopyInto(out *AdditionalNetworkInterface) {
+	*out = *in
+	if in.Subnet != nil {
+		in, out := &in.Subnet, &out.Subnet
+		*out = new(AWSResourceReference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SecurityGroups != nil {
+		in, out := &in.SecurityGroups, &out.SecurityGroups
+		*out = make([]AWSResourceReference, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AdditionalNetworkInterface.
+func (in *AdditionalNetworkInterface) DeepCopy() *AdditionalNetworkInterface {
+	if in == nil {
+		return nil
+	}
+	out := new(AdditionalNetworkInterface)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AdditionalRoutes) DeepCopyInto(out *AdditionalRoutes) {
+	*out = *in
+	if in.Public != nil {
+		in, out := &in.Public, &out.Public
+		*out = make([]Route, len(*in))
+		copy(*out, *in)
+	}
+	if in.Private != nil {
+		in, out := &in.Private, &out.Private
+		*out = make([]Route, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AdditionalRoutes.
+func (in *AdditionalRoutes) DeepCopy() *AdditionalRoutes {
+	if in == nil {
+		return nil
+	}
+	out := new(AdditionalRoutes)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AllowedNamespaces) DeepCopyInto(out *AllowedNamespaces) {
 	*out = *in
@@ -1173,122 +1354,127 @@ func (in *CNISpec) DeepCopy() *CNISpec {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClassicELBAttributes) DeepCopyInto(out *ClassicELBAttributes) {
+func (in *CapacityReservationSpecification) DeepCopyInto(out *CapacityReservationSpecification) {
 	*out = *in
+	if in.Target != nil {
+		in, out := &in.Target, &out.Target
+		*out = new(CapacityReservationTarget)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClassicELBAttributes.
-func (in *ClassicELBAttributes) DeepCopy() *ClassicELBAttributes {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CapacityReservationSpecification.
+func (in *CapacityReservationSpecification) DeepCopy() *CapacityReservationSpecification {
 	if in == nil {
 		return nil
 	}
-	out := new(ClassicELBAttributes)
+	out := new(CapacityReservationSpecification)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClassicELBHealthCheck) DeepCopyInto(out *ClassicELBHealthCheck) {
+func (in *CapacityReservationTarget) DeepCopyInto(out *CapacityReservationTarget) {
 	*out = *in
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+	if in.ResourceGroupARN != nil {
+		in, out := &in.ResourceGroupARN, &out.ResourceGroupARN
+		*out = new(string)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClassicELBHealthCheck.
-func (in *ClassicELBHealthCheck) DeepCopy() *ClassicELBHealthCheck {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CapacityReservationTarget.
+func (in *CapacityReservationTarget) DeepCopy() *CapacityReservationTarget {
 	if in == nil {
 		return nil
 	}
-	out := new(ClassicELBHealthCheck)
+	out := new(CapacityReservationTarget)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClassicELBListener) DeepCopyInto(out *ClassicELBListener) {
+func (in *ClassicELBAttributes) DeepCopyInto(out *ClassicELBAttributes) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClassicELBListener.
-func (in *ClassicELBListener) DeepCopy() *ClassicELBListener {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClassicELBAttributes.
+func (in *ClassicELBAttributes) DeepCopy() *ClassicELBAttributes {
 	if in == nil {
 		return nil
 	}
-	out := new(ClassicELBListener)
+	out := new(ClassicELBAttributes)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CloudInit) DeepCopyInto(out *CloudInit) {
+func (in *ClassicELBHealthCheck) DeepCopyInto(out *ClassicELBHealthCheck) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudInit.
-func (in *CloudInit) DeepCopy() *CloudInit {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClassicELBHealthCheck.
+func (in *ClassicELBHealthCheck) DeepCopy() *ClassicELBHealthCheck {
 	if in == nil {
 		return nil
 	}
-	out := new(CloudInit)
+	out := new(ClassicELBHealthCheck)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Filter) DeepCopyInto(out *Filter) {
+func (in *ClassicELBListener) DeepCopyInto(out *ClassicELBListener) {
 	*out = *in
-	if in.Values != nil {
-		in, out := &in.Values, &out.Values
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Filter.
-func (in *Filter) DeepCopy() *Filter {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClassicELBListener.
+func (in *ClassicELBListener) DeepCopy() *ClassicELBListener {
 	if in == nil {
 		return nil
 	}
-	out := new(Filter)
+	out := new(ClassicELBListener)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IPv6) DeepCopyInto(out *IPv6) {
+func (in *CloudInit) DeepCopyInto(out *CloudInit) {
 	*out = *in
-	if in.EgressOnlyInternetGatewayID != nil {
-		in, out := &in.EgressOnlyInternetGatewayID, &out.EgressOnlyInternetGatewayID
-		*out = new(string)
-		**out = **in
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPv6.
-func (in *IPv6) DeepCopy() *IPv6 {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudInit.
+func (in *CloudInit) DeepCopy() *CloudInit {
 	if in == nil {
 		return nil
 	}
-	out := new(IPv6)
+	out := new(CloudInit)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Ignition) DeepCopyInto(out *Ignition) {
+func (in *ControlPlaneDNS) DeepCopyInto(out *ControlPlaneDNS) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Ignition.
-func (in *Ignition) DeepCopy() *Ignition {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ControlPlaneDNS.
+func (in *ControlPlaneDNS) DeepCopy() *ControlPlaneDNS {
 	if in == nil {
 		return nil
 	}
-	out := new(Ignition)
+	out := new(ControlPlaneDNS)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IngressRule) DeepCopyInto(out *IngressRule) {
+func (in *EgressRule) DeepCopyInto(out *EgressRule) {
 	*out = *in
 	if in.CidrBlocks != nil {
 		in, out := &in.CidrBlocks, &out.CidrBlocks
@@ -1300,96 +1486,289 @@ func (in *IngressRule) DeepCopyInto(out *IngressRule) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
-	if in.SourceSecurityGroupIDs != nil {
-		in, out := &in.SourceSecurityGroupIDs, &out.SourceSecurityGroupIDs
+	if in.DestinationSecurityGroupIDs != nil {
+		in, out := &in.DestinationSecurityGroupIDs, &out.DestinationSecurityGroupIDs
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
-	if in.SourceSecurityGroupRoles != nil {
-		in, out := &in.SourceSecurityGroupRoles, &out.SourceSecurityGroupRoles
+	if in.DestinationSecurityGroupRoles != nil {
+		in, out := &in.DestinationSecurityGroupRoles, &out.DestinationSecurityGroupRoles
 		*out = make([]SecurityGroupRole, len(*in))
 		copy(*out, *in)
 	}
+	if in.DestinationPrefixListIDs != nil {
+		in, out := &in.DestinationPrefixListIDs, &out.DestinationPrefixListIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressRule.
-func (in *IngressRule) DeepCopy() *IngressRule {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EgressRule.
+func (in *EgressRule) DeepCopy() *EgressRule {
 	if in == nil {
 		return nil
 	}
-	out := new(IngressRule)
+	out := new(EgressRule)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in IngressRules) DeepCopyInto(out *IngressRules) {
+func (in EgressRules) DeepCopyInto(out *EgressRules) {
 	{
 		in := &in
-		*out = make(IngressRules, len(*in))
+		*out = make(EgressRules, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressRules.
-func (in IngressRules) DeepCopy() IngressRules {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EgressRules.
+func (in EgressRules) DeepCopy() EgressRules {
 	if in == nil {
 		return nil
 	}
-	out := new(IngressRules)
+	out := new(EgressRules)
 	in.DeepCopyInto(out)
 	return *out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Instance) DeepCopyInto(out *Instance) {
+func (in *ElasticIPPool) DeepCopyInto(out *ElasticIPPool) {
 	*out = *in
-	if in.SSHKeyName != nil {
-		in, out := &in.SSHKeyName, &out.SSHKeyName
+	if in.PublicIpv4Pool != nil {
+		in, out := &in.PublicIpv4Pool, &out.PublicIpv4Pool
 		*out = new(string)
 		**out = **in
 	}
-	if in.SecurityGroupIDs != nil {
-		in, out := &in.SecurityGroupIDs, &out.SecurityGroupIDs
+	if in.AllocationIDs != nil {
+		in, out := &in.AllocationIDs, &out.AllocationIDs
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
-	if in.UserData != nil {
-		in, out := &in.UserData, &out.UserData
-		*out = new(string)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ElasticIPPool.
+func (in *ElasticIPPool) DeepCopy() *ElasticIPPool {
+	if in == nil {
+		return nil
 	}
-	if in.Addresses != nil {
-		in, out := &in.Addresses, &out.Addresses
-		*out = make([]v1beta1.MachineAddress, len(*in))
+	out := new(ElasticIPPool)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ElasticIPSpec) DeepCopyInto(out *ElasticIPSpec) {
+	*out = *in
+	if in.AllocationIDs != nil {
+		in, out := &in.AllocationIDs, &out.AllocationIDs
+		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
-	if in.PrivateIP != nil {
-		in, out := &in.PrivateIP, &out.PrivateIP
-		*out = new(string)
-		**out = **in
+	if in.Filters != nil {
+		in, out := &in.Filters, &out.Filters
+		*out = make([]Filter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
-	if in.PublicIP != nil {
-		in, out := &in.PublicIP, &out.PublicIP
+	if in.PublicIpv4Pool != nil {
+		in, out := &in.PublicIpv4Pool, &out.PublicIpv4Pool
 		*out = new(string)
 		**out = **in
 	}
-	if in.ENASupport != nil {
-		in, out := &in.ENASupport, &out.ENASupport
-		*out = new(bool)
-		**out = **in
-	}
-	if in.EBSOptimized != nil {
-		in, out := &in.EBSOptimized, &out.EBSOptimized
-		*out = new(bool)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ElasticIPSpec.
+func (in *ElasticIPSpec) DeepCopy() *ElasticIPSpec {
+	if in == nil {
+		return nil
 	}
-	if in.RootVolume != nil {
-		in, out := &in.RootVolume, &out.RootVolume
-		*out = new(Volume)
-		(*in).DeepCopyInto(*out)
+	out := new(ElasticIPSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Filter) DeepCopyInto(out *Filter) {
+	*out = *in
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Filter.
+func (in *Filter) DeepCopy() *Filter {
+	if in == nil {
+		return nil
+	}
+	out := new(Filter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPAMPool) DeepCopyInto(out *IPAMPool) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPAMPool.
+func (in *IPAMPool) DeepCopy() *IPAMPool {
+	if in == nil {
+		return nil
+	}
+	out := new(IPAMPool)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPv6) DeepCopyInto(out *IPv6) {
+	*out = *in
+	if in.EgressOnlyInternetGatewayID != nil {
+		in, out := &in.EgressOnlyInternetGatewayID, &out.EgressOnlyInternetGatewayID
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPv6.
+func (in *IPv6) DeepCopy() *IPv6 {
+	if in == nil {
+		return nil
+	}
+	out := new(IPv6)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Ignition) DeepCopyInto(out *Ignition) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Ignition.
+func (in *Ignition) DeepCopy() *Ignition {
+	if in == nil {
+		return nil
+	}
+	out := new(Ignition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IngressRule) DeepCopyInto(out *IngressRule) {
+	*out = *in
+	if in.CidrBlocks != nil {
+		in, out := &in.CidrBlocks, &out.CidrBlocks
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.IPv6CidrBlocks != nil {
+		in, out := &in.IPv6CidrBlocks, &out.IPv6CidrBlocks
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SourceSecurityGroupIDs != nil {
+		in, out := &in.SourceSecurityGroupIDs, &out.SourceSecurityGroupIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SourceSecurityGroupRoles != nil {
+		in, out := &in.SourceSecurityGroupRoles, &out.SourceSecurityGroupRoles
+		*out = make([]SecurityGroupRole, len(*in))
+		copy(*out, *in)
+	}
+	if in.SourcePrefixListIDs != nil {
+		in, out := &in.SourcePrefixListIDs, &out.SourcePrefixListIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressRule.
+func (in *IngressRule) DeepCopy() *IngressRule {
+	if in == nil {
+		return nil
+	}
+	out := new(IngressRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in IngressRules) DeepCopyInto(out *IngressRules) {
+	{
+		in := &in
+		*out = make(IngressRules, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressRules.
+func (in IngressRules) DeepCopy() IngressRules {
+	if in == nil {
+		return nil
+	}
+	out := new(IngressRules)
+	in.DeepCopyInto(out)
+	return *out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Instance) DeepCopyInto(out *Instance) {
+	*out = *in
+	if in.SSHKeyName != nil {
+		in, out := &in.SSHKeyName, &out.SSHKeyName
+		*out = new(string)
+		**out = **in
+	}
+	if in.SecurityGroupIDs != nil {
+		in, out := &in.SecurityGroupIDs, &out.SecurityGroupIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.UserData != nil {
+		in, out := &in.UserData, &out.UserData
+		*out = new(string)
+		**out = **in
+	}
+	if in.Addresses != nil {
+		in, out := &in.Addresses, &out.Addresses
+		*out = make([]v1beta1.MachineAddress, len(*in))
+		copy(*out, *in)
+	}
+	if in.PrivateIP != nil {
+		in, out := &in.PrivateIP, &out.PrivateIP
+		*out = new(string)
+		**out = **in
+	}
+	if in.PublicIP != nil {
+		in, out := &in.PublicIP, &out.PublicIP
+		*out = new(string)
+		**out = **in
+	}
+	if in.ENASupport != nil {
+		in, out := &in.ENASupport, &out.ENASupport
+		*out = new(bool)
+		**out = **in
+	}
+	if in.EBSOptimized != nil {
+		in, out := &in.EBSOptimized, &out.EBSOptimized
+		*out = new(bool)
+		**out = **in
+	}
+	if in.RootVolume != nil {
+		in, out := &in.RootVolume, &out.RootVolume
+		*out = new(Volume)
+		(*in).DeepCopyInto(*out)
 	}
 	if in.NonRootVolumes != nil {
 		in, out := &in.NonRootVolumes, &out.NonRootVolumes
@@ -1403,6 +1782,18 @@ func (in *Instance) DeepCopyInto(out *Instance) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.AdditionalNetworkInterfaces != nil {
+		in, out := &in.AdditionalNetworkInterfaces, &out.AdditionalNetworkInterfaces
+		*out = make([]AdditionalNetworkInterface, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.NetworkInterfaceAttachments != nil {
+		in, out := &in.NetworkInterfaceAttachments, &out.NetworkInterfaceAttachments
+		*out = make([]NetworkInterfaceAttachment, len(*in))
+		copy(*out, *in)
+	}
 	if in.Tags != nil {
 		in, out := &in.Tags, &out.Tags
 		*out = make(map[string]string, len(*in))
@@ -1415,6 +1806,16 @@ func (in *Instance) DeepCopyInto(out *Instance) {
 		*out = new(SpotMarketOptions)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.CapacityReservation != nil {
+		in, out := &in.CapacityReservation, &out.CapacityReservation
+		*out = new(CapacityReservationSpecification)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PrivateDNSName != nil {
+		in, out := &in.PrivateDNSName, &out.PrivateDNSName
+		*out = new(PrivateDNSName)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.VolumeIDs != nil {
 		in, out := &in.VolumeIDs, &out.VolumeIDs
 		*out = make([]string, len(*in))
@@ -1425,6 +1826,11 @@ func (in *Instance) DeepCopyInto(out *Instance) {
 		*out = new(InstanceMetadataOptions)
 		**out = **in
 	}
+	if in.MaintenanceOptions != nil {
+		in, out := &in.MaintenanceOptions, &out.MaintenanceOptions
+		*out = new(InstanceMaintenanceOptions)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Instance.
@@ -1437,6 +1843,31 @@ func (in *Instance) DeepCopy() *Instance {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceMaintenanceOptions) DeepCopyInto(out *InstanceMaintenanceOptions) {
+	*out = *in
+	if in.DisableAPITermination != nil {
+		in, out := &in.DisableAPITermination, &out.DisableAPITermination
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DisableAPIStop != nil {
+		in, out := &in.DisableAPIStop, &out.DisableAPIStop
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceMaintenanceOptions.
+func (in *InstanceMaintenanceOptions) DeepCopy() *InstanceMaintenanceOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceMaintenanceOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *InstanceMetadataOptions) DeepCopyInto(out *InstanceMetadataOptions) {
 	*out = *in
@@ -1538,6 +1969,110 @@ func (in *LoadBalancer) DeepCopy() *LoadBalancer {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkACL) DeepCopyInto(out *NetworkACL) {
+	*out = *in
+	if in.Ingress != nil {
+		in, out := &in.Ingress, &out.Ingress
+		*out = make([]NetworkACLRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Egress != nil {
+		in, out := &in.Egress, &out.Egress
+		*out = make([]NetworkACLRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkACL.
+func (in *NetworkACL) DeepCopy() *NetworkACL {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkACL)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkACLRule) DeepCopyInto(out *NetworkACLRule) {
+	*out = *in
+	if in.FromPort != nil {
+		in, out := &in.FromPort, &out.FromPort
+		*out = new(int64)
+		**out = **in
+	}
+	if in.ToPort != nil {
+		in, out := &in.ToPort, &out.ToPort
+		*out = new(int64)
+		**out = **in
+	}
+	if in.ICMPType != nil {
+		in, out := &in.ICMPType, &out.ICMPType
+		*out = new(int64)
+		**out = **in
+	}
+	if in.ICMPCode != nil {
+		in, out := &in.ICMPCode, &out.ICMPCode
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkACLRule.
+func (in *NetworkACLRule) DeepCopy() *NetworkACLRule {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkACLRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkACLs) DeepCopyInto(out *NetworkACLs) {
+	*out = *in
+	if in.Public != nil {
+		in, out := &in.Public, &out.Public
+		*out = new(NetworkACL)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Private != nil {
+		in, out := &in.Private, &out.Private
+		*out = new(NetworkACL)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkACLs.
+func (in *NetworkACLs) DeepCopy() *NetworkACLs {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkACLs)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkInterfaceAttachment) DeepCopyInto(out *NetworkInterfaceAttachment) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkInterfaceAttachment.
+func (in *NetworkInterfaceAttachment) DeepCopy() *NetworkInterfaceAttachment {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkInterfaceAttachment)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NetworkSpec) DeepCopyInto(out *NetworkSpec) {
 	*out = *in
@@ -1561,6 +2096,60 @@ func (in *NetworkSpec) DeepCopyInto(out *NetworkSpec) {
 			(*out)[key] = val
 		}
 	}
+	if in.TransitGateway != nil {
+		in, out := &in.TransitGateway, &out.TransitGateway
+		*out = new(TransitGatewaySpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ElasticIPPool != nil {
+		in, out := &in.ElasticIPPool, &out.ElasticIPPool
+		*out = new(ElasticIPPool)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AdditionalRoutes != nil {
+		in, out := &in.AdditionalRoutes, &out.AdditionalRoutes
+		*out = new(AdditionalRoutes)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NetworkACLs != nil {
+		in, out := &in.NetworkACLs, &out.NetworkACLs
+		*out = new(NetworkACLs)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SecurityGroupEgressRules != nil {
+		in, out := &in.SecurityGroupEgressRules, &out.SecurityGroupEgressRules
+		*out = make(map[SecurityGroupRole]EgressRules, len(*in))
+		for key, val := range *in {
+			var outVal []EgressRule
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				in, out := &val, &outVal
+				*out = make(EgressRules, len(*in))
+				for i := range *in {
+					(*in)[i].DeepCopyInto(&(*out)[i])
+				}
+			}
+			(*out)[key] = outVal
+		}
+	}
+	if in.AdditionalIngressRules != nil {
+		in, out := &in.AdditionalIngressRules, &out.AdditionalIngressRules
+		*out = make(map[SecurityGroupRole]IngressRules, len(*in))
+		for key, val := range *in {
+			var outVal []IngressRule
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				in, out := &val, &outVal
+				*out = make(IngressRules, len(*in))
+				for i := range *in {
+					(*in)[i].DeepCopyInto(&(*out)[i])
+				}
+			}
+			(*out)[key] = outVal
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkSpec.
@@ -1584,6 +2173,29 @@ func (in *NetworkStatus) DeepCopyInto(out *NetworkStatus) {
 		}
 	}
 	in.APIServerELB.DeepCopyInto(&out.APIServerELB)
+	in.SecondaryAPIServerELB.DeepCopyInto(&out.SecondaryAPIServerELB)
+	if in.VPCEndpoints != nil {
+		in, out := &in.VPCEndpoints, &out.VPCEndpoints
+		*out = make([]VPCEndpoint, len(*in))
+		copy(*out, *in)
+	}
+	if in.FlowLogIDs != nil {
+		in, out := &in.FlowLogIDs, &out.FlowLogIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AssociatedHostedZoneIDs != nil {
+		in, out := &in.AssociatedHostedZoneIDs, &out.AssociatedHostedZoneIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AdditionalRoutes != nil {
+		in, out := &in.AdditionalRoutes, &out.AdditionalRoutes
+		*out = make([]RouteTableRoutes, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkStatus.
@@ -1596,6 +2208,66 @@ func (in *NetworkStatus) DeepCopy() *NetworkStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PlacementGroup) DeepCopyInto(out *PlacementGroup) {
+	*out = *in
+	if in.PartitionCount != nil {
+		in, out := &in.PartitionCount, &out.PartitionCount
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlacementGroup.
+func (in *PlacementGroup) DeepCopy() *PlacementGroup {
+	if in == nil {
+		return nil
+	}
+	out := new(PlacementGroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrivateDNSName) DeepCopyInto(out *PrivateDNSName) {
+	*out = *in
+	if in.EnableResourceNameDNSARecord != nil {
+		in, out := &in.EnableResourceNameDNSARecord, &out.EnableResourceNameDNSARecord
+		*out = new(bool)
+		**out = **in
+	}
+	if in.EnableResourceNameDNSAAAARecord != nil {
+		in, out := &in.EnableResourceNameDNSAAAARecord, &out.EnableResourceNameDNSAAAARecord
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrivateDNSName.
+func (in *PrivateDNSName) DeepCopy() *PrivateDNSName {
+	if in == nil {
+		return nil
+	}
+	out := new(PrivateDNSName)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Route) DeepCopyInto(out *Route) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Route.
+func (in *Route) DeepCopy() *Route {
+	if in == nil {
+		return nil
+	}
+	out := new(Route)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RouteTable) DeepCopyInto(out *RouteTable) {
 	*out = *in
@@ -1611,6 +2283,26 @@ func (in *RouteTable) DeepCopy() *RouteTable {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouteTableRoutes) DeepCopyInto(out *RouteTableRoutes) {
+	*out = *in
+	if in.Destinations != nil {
+		in, out := &in.Destinations, &out.Destinations
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteTableRoutes.
+func (in *RouteTableRoutes) DeepCopy() *RouteTableRoutes {
+	if in == nil {
+		return nil
+	}
+	out := new(RouteTableRoutes)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *S3Bucket) DeepCopyInto(out *S3Bucket) {
 	*out = *in
@@ -1641,6 +2333,13 @@ func (in *SecurityGroup) DeepCopyInto(out *SecurityGroup) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.EgressRules != nil {
+		in, out := &in.EgressRules, &out.EgressRules
+		*out = make(EgressRules, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.Tags != nil {
 		in, out := &in.Tags, &out.Tags
 		*out = make(Tags, len(*in))
@@ -1700,6 +2399,21 @@ func (in *SubnetSpec) DeepCopyInto(out *SubnetSpec) {
 			(*out)[key] = val
 		}
 	}
+	if in.ZoneType != nil {
+		in, out := &in.ZoneType, &out.ZoneType
+		*out = new(ZoneType)
+		**out = **in
+	}
+	if in.ParentZoneName != nil {
+		in, out := &in.ParentZoneName, &out.ParentZoneName
+		*out = new(string)
+		**out = **in
+	}
+	if in.AdditionalRoutes != nil {
+		in, out := &in.AdditionalRoutes, &out.AdditionalRoutes
+		*out = make([]Route, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubnetSpec.
@@ -1819,9 +2533,99 @@ func (in *TargetGroupSpec) DeepCopy() *TargetGroupSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TransitGatewaySpec) DeepCopyInto(out *TransitGatewaySpec) {
+	*out = *in
+	if in.SubnetIDs != nil {
+		in, out := &in.SubnetIDs, &out.SubnetIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.RouteCIDRs != nil {
+		in, out := &in.RouteCIDRs, &out.RouteCIDRs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitGatewaySpec.
+func (in *TransitGatewaySpec) DeepCopy() *TransitGatewaySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TransitGatewaySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCEndpoint) DeepCopyInto(out *VPCEndpoint) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCEndpoint.
+func (in *VPCEndpoint) DeepCopy() *VPCEndpoint {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCEndpoint)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCEndpointSpec) DeepCopyInto(out *VPCEndpointSpec) {
+	*out = *in
+	if in.PrivateDNSEnabled != nil {
+		in, out := &in.PrivateDNSEnabled, &out.PrivateDNSEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SubnetIDs != nil {
+		in, out := &in.SubnetIDs, &out.SubnetIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SecurityGroupIDs != nil {
+		in, out := &in.SecurityGroupIDs, &out.SecurityGroupIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCEndpointSpec.
+func (in *VPCEndpointSpec) DeepCopy() *VPCEndpointSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCEndpointSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCFlowLog) DeepCopyInto(out *VPCFlowLog) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCFlowLog.
+func (in *VPCFlowLog) DeepCopy() *VPCFlowLog {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCFlowLog)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VPCSpec) DeepCopyInto(out *VPCSpec) {
 	*out = *in
+	if in.IPAMPool != nil {
+		in, out := &in.IPAMPool, &out.IPAMPool
+		*out = new(IPAMPool)
+		**out = **in
+	}
 	if in.IPv6 != nil {
 		in, out := &in.IPv6, &out.IPv6
 		*out = new(IPv6)
@@ -1832,6 +2636,11 @@ func (in *VPCSpec) DeepCopyInto(out *VPCSpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.CarrierGatewayID != nil {
+		in, out := &in.CarrierGatewayID, &out.CarrierGatewayID
+		*out = new(string)
+		**out = **in
+	}
 	if in.Tags != nil {
 		in, out := &in.Tags, &out.Tags
 		*out = make(Tags, len(*in))
@@ -1849,6 +2658,18 @@ func (in *VPCSpec) DeepCopyInto(out *VPCSpec) {
 		*out = new(AZSelectionScheme)
 		**out = **in
 	}
+	if in.Endpoints != nil {
+		in, out := &in.Endpoints, &out.Endpoints
+		*out = make([]VPCEndpointSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.FlowLogs != nil {
+		in, out := &in.FlowLogs, &out.FlowLogs
+		*out = make([]VPCFlowLog, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCSpec.
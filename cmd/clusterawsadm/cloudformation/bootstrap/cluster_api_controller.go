@@ -90,6 +90,7 @@ func (t Template) ControllersPolicy() *iamv1.PolicyDocument {
 				"ec2:AssociateRouteTable",
 				"ec2:AttachInternetGateway",
 				"ec2:AuthorizeSecurityGroupIngress",
+				"ec2:AuthorizeSecurityGroupEgress",
 				"ec2:CreateInternetGateway",
 				"ec2:CreateEgressOnlyInternetGateway",
 				"ec2:CreateNatGateway",
@@ -124,9 +125,41 @@ func (t Template) ControllersPolicy() *iamv1.PolicyDocument {
 				"ec2:DescribeNetworkInterfaceAttribute",
 				"ec2:DescribeRouteTables",
 				"ec2:DescribeSecurityGroups",
+				"ec2:DescribeSecurityGroupRules",
+				"ec2:ModifySecurityGroupRules",
 				"ec2:DescribeSubnets",
 				"ec2:DescribeVpcs",
 				"ec2:DescribeVpcAttribute",
+				"ec2:DescribeVpcEndpoints",
+				"ec2:CreateVpcEndpoint",
+				"ec2:ModifyVpcEndpoint",
+				"ec2:DeleteVpcEndpoints",
+				"ec2:DeleteRoute",
+				"ec2:DescribeTransitGatewayVpcAttachments",
+				"ec2:CreateTransitGatewayVpcAttachment",
+				"ec2:ModifyTransitGatewayVpcAttachment",
+				"ec2:DeleteTransitGatewayVpcAttachment",
+				"ec2:DescribeIpamPools",
+				"ec2:AllocateIpamPoolCidr",
+				"ec2:DescribeCarrierGateways",
+				"ec2:CreateCarrierGateway",
+				"ec2:DeleteCarrierGateway",
+				"ec2:DescribeNetworkAcls",
+				"ec2:CreateNetworkAcl",
+				"ec2:DeleteNetworkAcl",
+				"ec2:CreateNetworkAclEntry",
+				"ec2:ReplaceNetworkAclEntry",
+				"ec2:DeleteNetworkAclEntry",
+				"ec2:ReplaceNetworkAclAssociation",
+				"ec2:DescribeFlowLogs",
+				"ec2:CreateFlowLogs",
+				"ec2:DeleteFlowLogs",
+				"ec2:DescribeInstanceAttribute",
+				"ec2:ModifyInstanceMaintenanceOptions",
+				"ec2:AssociateAddress",
+				"ec2:DescribePlacementGroups",
+				"ec2:CreatePlacementGroup",
+				"ec2:DeletePlacementGroup",
 				"ec2:DescribeVolumes",
 				"ec2:DescribeTags",
 				"ec2:DetachInternetGateway",
@@ -137,6 +170,7 @@ func (t Template) ControllersPolicy() *iamv1.PolicyDocument {
 				"ec2:ModifySubnetAttribute",
 				"ec2:ReleaseAddress",
 				"ec2:RevokeSecurityGroupIngress",
+				"ec2:RevokeSecurityGroupEgress",
 				"ec2:RunInstances",
 				"ec2:TerminateInstances",
 				"tag:GetResources",
@@ -155,6 +189,11 @@ func (t Template) ControllersPolicy() *iamv1.PolicyDocument {
 				"elasticloadbalancing:DeregisterInstancesFromLoadBalancer",
 				"elasticloadbalancing:RemoveTags",
 				"elasticloadbalancing:SetSubnets",
+				"route53:GetHostedZone",
+				"route53:ListResourceRecordSets",
+				"route53:ChangeResourceRecordSets",
+				"route53:AssociateVPCWithHostedZone",
+				"route53:DisassociateVPCFromHostedZone",
 				"autoscaling:DescribeAutoScalingGroups",
 				"autoscaling:DescribeInstanceRefreshes",
 				"ec2:CreateLaunchTemplate",
@@ -224,6 +263,28 @@ func (t Template) ControllersPolicy() *iamv1.PolicyDocument {
 				"iam:PassRole",
 			},
 		},
+		{
+			Effect: iamv1.EffectAllow,
+			Resource: iamv1.Resources{
+				iamv1.Any,
+			},
+			Action: iamv1.Actions{
+				"iam:PassRole",
+			},
+			Condition: iamv1.Conditions{
+				iamv1.StringEquals: map[string]string{"iam:PassedToService": "vpc-flow-logs.amazonaws.com"},
+			},
+		},
+		{
+			Effect: iamv1.EffectAllow,
+			Resource: iamv1.Resources{
+				iamv1.Any,
+			},
+			Action: iamv1.Actions{
+				"logs:CreateLogDelivery",
+				"logs:DeleteLogDelivery",
+			},
+		},
 	}
 	for _, secureSecretBackend := range t.Spec.SecureSecretsBackends {
 		switch secureSecretBackend {
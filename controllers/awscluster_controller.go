@@ -47,6 +47,7 @@ import (
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/gc"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/instancestate"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/network"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/placementgroup"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/s3"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/securitygroup"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/logger"
@@ -73,6 +74,7 @@ type AWSClusterReconciler struct {
 	networkServiceFactory        func(scope.ClusterScope) services.NetworkInterface
 	elbServiceFactory            func(scope.ELBScope) services.ELBInterface
 	securityGroupFactory         func(scope.ClusterScope) services.SecurityGroupInterface
+	placementGroupServiceFactory func(scope.PlacementGroupScope) services.PlacementGroupInterface
 	Endpoints                    []scope.ServiceEndpoint
 	WatchFilterValue             string
 	ExternalResourceGC           bool
@@ -104,6 +106,14 @@ func (r *AWSClusterReconciler) getNetworkService(scope scope.ClusterScope) servi
 	return network.NewService(&scope)
 }
 
+// getPlacementGroupService factory func is added for testing purpose so that we can inject mocked PlacementGroupService to the AWSClusterReconciler.
+func (r *AWSClusterReconciler) getPlacementGroupService(scope scope.PlacementGroupScope) services.PlacementGroupInterface {
+	if r.placementGroupServiceFactory != nil {
+		return r.placementGroupServiceFactory(scope)
+	}
+	return placementgroup.NewService(scope)
+}
+
 // securityGroupRolesForCluster returns the security group roles determined by the cluster configuration.
 func securityGroupRolesForCluster(scope scope.ClusterScope) []infrav1.SecurityGroupRole {
 	// Copy to ensure we do not modify the package-level variable.
@@ -127,7 +137,7 @@ func (r *AWSClusterReconciler) getSecurityGroupService(scope scope.ClusterScope)
 // +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=awsclusters,verbs=get;list;watch;update;patch;delete
 // +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=awsclusters/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=cluster.x-k8s.io,resources=clusters;clusters/status,verbs=get;list;watch
-// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=awsclusterroleidentities;awsclusterstaticidentities,verbs=get;list;watch
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=awsclusterroleidentities;awsclusterstaticidentities;awsclusterwebidentities,verbs=get;list;watch
 // +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=awsclustercontrolleridentities,verbs=get;list;watch;create
 
 func (r *AWSClusterReconciler) Reconcile(ctx context.Context, req ctrl.Request) (_ ctrl.Result, reterr error) {
@@ -206,6 +216,7 @@ func (r *AWSClusterReconciler) reconcileDelete(ctx context.Context, clusterScope
 	networkSvc := r.getNetworkService(*clusterScope)
 	sgService := r.getSecurityGroupService(*clusterScope)
 	s3Service := s3.NewService(clusterScope)
+	placementGroupSvc := r.getPlacementGroupService(clusterScope)
 
 	if feature.Gates.Enabled(feature.EventBridgeInstanceState) {
 		instancestateSvc := instancestate.NewService(clusterScope)
@@ -225,6 +236,11 @@ func (r *AWSClusterReconciler) reconcileDelete(ctx context.Context, clusterScope
 		return reconcile.Result{}, err
 	}
 
+	if err := placementGroupSvc.DeletePlacementGroups(); err != nil {
+		clusterScope.Error(err, "error deleting placement groups")
+		return reconcile.Result{}, err
+	}
+
 	if err := sgService.DeleteSecurityGroups(); err != nil {
 		clusterScope.Error(err, "error deleting security groups")
 		return reconcile.Result{}, err
@@ -248,6 +264,7 @@ func (r *AWSClusterReconciler) reconcileDelete(ctx context.Context, clusterScope
 
 	// Cluster is deleted so remove the finalizer.
 	controllerutil.RemoveFinalizer(clusterScope.AWSCluster, infrav1.ClusterFinalizer)
+	scope.DeleteClusterMetrics(clusterScope)
 
 	return reconcile.Result{}, nil
 }
@@ -270,6 +287,7 @@ func (r *AWSClusterReconciler) reconcileNormal(clusterScope *scope.ClusterScope)
 	networkSvc := r.getNetworkService(*clusterScope)
 	sgService := r.getSecurityGroupService(*clusterScope)
 	s3Service := s3.NewService(clusterScope)
+	placementGroupSvc := r.getPlacementGroupService(clusterScope)
 
 	if err := networkSvc.ReconcileNetwork(); err != nil {
 		clusterScope.Error(err, "failed to reconcile network")
@@ -282,6 +300,12 @@ func (r *AWSClusterReconciler) reconcileNormal(clusterScope *scope.ClusterScope)
 		return reconcile.Result{}, err
 	}
 
+	if err := placementGroupSvc.ReconcilePlacementGroups(); err != nil {
+		clusterScope.Error(err, "failed to reconcile placement groups")
+		conditions.MarkFalse(awsCluster, infrav1.PlacementGroupsReadyCondition, infrav1.PlacementGroupsReconciliationFailedReason, infrautilconditions.ErrorConditionAfterInit(clusterScope.ClusterObj()), err.Error())
+		return reconcile.Result{}, err
+	}
+
 	if err := ec2Service.ReconcileBastion(); err != nil {
 		conditions.MarkFalse(awsCluster, infrav1.BastionHostReadyCondition, infrav1.BastionHostFailedReason, infrautilconditions.ErrorConditionAfterInit(clusterScope.ClusterObj()), err.Error())
 		clusterScope.Error(err, "failed to reconcile bastion host")
@@ -326,6 +350,10 @@ func (r *AWSClusterReconciler) reconcileNormal(clusterScope *scope.ClusterScope)
 		Host: awsCluster.Status.Network.APIServerELB.DNSName,
 		Port: clusterScope.APIServerPort(),
 	}
+	// The Route53 alias record, if any, is used instead so that the endpoint survives load balancer recreation.
+	if dns := awsCluster.Spec.ControlPlaneDNS; dns != nil {
+		awsCluster.Spec.ControlPlaneEndpoint.Host = dns.FQDN()
+	}
 
 	for _, subnet := range clusterScope.Subnets().FilterPrivate() {
 		found := false
@@ -342,6 +370,12 @@ func (r *AWSClusterReconciler) reconcileNormal(clusterScope *scope.ClusterScope)
 	}
 
 	awsCluster.Status.Ready = true
+
+	// The routes to the transit gateway are only created once its attachment is available.
+	if conditions.GetReason(awsCluster, infrav1.TransitGatewayAttachmentReadyCondition) == infrav1.WaitForTransitGatewayAttachmentReason {
+		clusterScope.Info("Waiting on transit gateway attachment to become available")
+		return reconcile.Result{RequeueAfter: 15 * time.Second}, nil
+	}
 	return reconcile.Result{}, nil
 }
 
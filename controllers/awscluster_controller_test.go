@@ -37,6 +37,7 @@ import (
 	ec2Service "sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/ec2"
 	elbService "sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/elb"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/network"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/placementgroup"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/securitygroup"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/test/mocks"
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
@@ -276,6 +277,7 @@ func TestAWSClusterReconcilerIntegrationTests(t *testing.T) {
 			mockedDeleteLBCalls(true, ev2, e)
 			mockedDescribeInstanceCall(m)
 			mockedDeleteInstanceAndAwaitTerminationCalls(m)
+			mockedDeletePlacementGroupCalls(m)
 		}
 		expect(ec2Mock.EXPECT(), elbv2Mock.EXPECT(), elbMock.EXPECT())
 
@@ -330,6 +332,12 @@ func TestAWSClusterReconcilerIntegrationTests(t *testing.T) {
 			return ec2Svc
 		}
 
+		placementGroupSvc := placementgroup.NewService(cs)
+		placementGroupSvc.EC2Client = ec2Mock
+		reconciler.placementGroupServiceFactory = func(placementGroupScope scope.PlacementGroupScope) services.PlacementGroupInterface {
+			return placementGroupSvc
+		}
+
 		_, err = reconciler.reconcileNormal(cs)
 		g.Expect(err.Error()).To(ContainSubstring("The maximum number of VPCs has been reached"))
 
@@ -348,6 +356,7 @@ func TestAWSClusterReconcilerIntegrationTests(t *testing.T) {
 			mockedDescribeInstanceCall(m)
 			mockedDeleteLBCalls(true, ev2, e)
 			mockedDeleteInstanceAndAwaitTerminationCalls(m)
+			mockedDeletePlacementGroupCalls(m)
 			mockedDeleteSGCalls(m)
 		}
 		expect(ec2Mock.EXPECT(), elbv2Mock.EXPECT(), elbMock.EXPECT())
@@ -410,6 +419,12 @@ func TestAWSClusterReconcilerIntegrationTests(t *testing.T) {
 			return sgSvc
 		}
 
+		placementGroupSvc := placementgroup.NewService(cs)
+		placementGroupSvc.EC2Client = ec2Mock
+		reconciler.placementGroupServiceFactory = func(placementGroupScope scope.PlacementGroupScope) services.PlacementGroupInterface {
+			return placementGroupSvc
+		}
+
 		_, err = reconciler.reconcileDelete(ctx, cs)
 		g.Expect(err).To(BeNil())
 		expectAWSClusterConditions(g, cs.AWSCluster, []conditionAssertion{{infrav1.LoadBalancerReadyCondition, corev1.ConditionFalse, clusterv1.ConditionSeverityInfo, clusterv1.DeletedReason},
@@ -428,6 +443,10 @@ func mockedDeleteSGCalls(m *mocks.MockEC2APIMockRecorder) {
 	m.DescribeSecurityGroupsPages(gomock.Any(), gomock.Any()).Return(nil)
 }
 
+func mockedDeletePlacementGroupCalls(m *mocks.MockEC2APIMockRecorder) {
+	m.DescribePlacementGroups(gomock.Any()).Return(&ec2.DescribePlacementGroupsOutput{}, nil)
+}
+
 func createControllerIdentity(g *WithT) *infrav1.AWSClusterControllerIdentity {
 	controllerIdentity := &infrav1.AWSClusterControllerIdentity{
 		TypeMeta: metav1.TypeMeta{
@@ -510,14 +529,6 @@ func mockedDeleteInstanceAndAwaitTerminationCalls(m *mocks.MockEC2APIMockRecorde
 	).Return(nil)
 }
 
-func mockedDeleteInstanceCalls(m *mocks.MockEC2APIMockRecorder) {
-	m.TerminateInstances(
-		gomock.Eq(&ec2.TerminateInstancesInput{
-			InstanceIds: aws.StringSlice([]string{"id-1"}),
-		}),
-	).Return(nil, nil)
-}
-
 func mockedCreateVPCCalls(m *mocks.MockEC2APIMockRecorder) {
 	m.CreateTags(gomock.Eq(&ec2.CreateTagsInput{
 		Resources: aws.StringSlice([]string{"subnet-1"}),
@@ -139,6 +139,7 @@ func TestAWSClusterReconcileOperations(t *testing.T) {
 		elbSvc     *mock_services.MockELBInterface
 		networkSvc *mock_services.MockNetworkInterface
 		sgSvc      *mock_services.MockSecurityGroupInterface
+		pgSvc      *mock_services.MockPlacementGroupInterface
 		recorder   *record.FakeRecorder
 		ctx        context.Context
 	)
@@ -164,6 +165,7 @@ func TestAWSClusterReconcileOperations(t *testing.T) {
 		elbSvc = mock_services.NewMockELBInterface(mockCtrl)
 		networkSvc = mock_services.NewMockNetworkInterface(mockCtrl)
 		sgSvc = mock_services.NewMockSecurityGroupInterface(mockCtrl)
+		pgSvc = mock_services.NewMockPlacementGroupInterface(mockCtrl)
 
 		recorder = record.NewFakeRecorder(2)
 
@@ -181,6 +183,9 @@ func TestAWSClusterReconcileOperations(t *testing.T) {
 			securityGroupFactory: func(clusterScope scope.ClusterScope) services.SecurityGroupInterface {
 				return sgSvc
 			},
+			placementGroupServiceFactory: func(placementGroupScope scope.PlacementGroupScope) services.PlacementGroupInterface {
+				return pgSvc
+			},
 			Recorder: recorder,
 		}
 		return csClient
@@ -199,6 +204,7 @@ func TestAWSClusterReconcileOperations(t *testing.T) {
 					elbSvc.EXPECT().ReconcileLoadbalancers().Return(nil)
 					networkSvc.EXPECT().ReconcileNetwork().Return(nil)
 					sgSvc.EXPECT().ReconcileSecurityGroups().Return(nil)
+					pgSvc.EXPECT().ReconcilePlacementGroups().Return(nil)
 				}
 
 				awsCluster := getAWSCluster("test", "test")
@@ -298,6 +304,7 @@ func TestAWSClusterReconcileOperations(t *testing.T) {
 				runningCluster := func() {
 					networkSvc.EXPECT().ReconcileNetwork().Return(nil)
 					sgSvc.EXPECT().ReconcileSecurityGroups().Return(nil)
+					pgSvc.EXPECT().ReconcilePlacementGroups().Return(nil)
 					ec2Svc.EXPECT().ReconcileBastion().Return(expectedErr)
 				}
 				csClient := setup(t, &awsCluster)
@@ -321,6 +328,7 @@ func TestAWSClusterReconcileOperations(t *testing.T) {
 				runningCluster := func() {
 					networkSvc.EXPECT().ReconcileNetwork().Return(nil)
 					sgSvc.EXPECT().ReconcileSecurityGroups().Return(nil)
+					pgSvc.EXPECT().ReconcilePlacementGroups().Return(nil)
 					ec2Svc.EXPECT().ReconcileBastion().Return(nil)
 					elbSvc.EXPECT().ReconcileLoadbalancers().Return(expectedErr)
 				}
@@ -345,6 +353,7 @@ func TestAWSClusterReconcileOperations(t *testing.T) {
 				runningCluster := func() {
 					networkSvc.EXPECT().ReconcileNetwork().Return(nil)
 					sgSvc.EXPECT().ReconcileSecurityGroups().Return(nil)
+					pgSvc.EXPECT().ReconcilePlacementGroups().Return(nil)
 					ec2Svc.EXPECT().ReconcileBastion().Return(nil)
 					elbSvc.EXPECT().ReconcileLoadbalancers().Return(nil)
 				}
@@ -369,6 +378,7 @@ func TestAWSClusterReconcileOperations(t *testing.T) {
 				runningCluster := func() {
 					networkSvc.EXPECT().ReconcileNetwork().Return(nil)
 					sgSvc.EXPECT().ReconcileSecurityGroups().Return(nil)
+					pgSvc.EXPECT().ReconcilePlacementGroups().Return(nil)
 					ec2Svc.EXPECT().ReconcileBastion().Return(nil)
 					elbSvc.EXPECT().ReconcileLoadbalancers().Return(nil)
 				}
@@ -394,6 +404,7 @@ func TestAWSClusterReconcileOperations(t *testing.T) {
 		t.Run("Reconcile success", func(t *testing.T) {
 			deleteCluster := func() {
 				ec2Svc.EXPECT().DeleteBastion().Return(nil)
+				pgSvc.EXPECT().DeletePlacementGroups().Return(nil)
 				elbSvc.EXPECT().DeleteLoadbalancers().Return(nil)
 				networkSvc.EXPECT().DeleteNetwork().Return(nil)
 				sgSvc.EXPECT().DeleteSecurityGroups().Return(nil)
@@ -469,6 +480,7 @@ func TestAWSClusterReconcileOperations(t *testing.T) {
 				g := NewWithT(t)
 				deleteCluster := func() {
 					ec2Svc.EXPECT().DeleteBastion().Return(nil)
+					pgSvc.EXPECT().DeletePlacementGroups().Return(nil)
 					elbSvc.EXPECT().DeleteLoadbalancers().Return(nil)
 					sgSvc.EXPECT().DeleteSecurityGroups().Return(expectedErr)
 				}
@@ -493,6 +505,7 @@ func TestAWSClusterReconcileOperations(t *testing.T) {
 				g := NewWithT(t)
 				deleteCluster := func() {
 					ec2Svc.EXPECT().DeleteBastion().Return(nil)
+					pgSvc.EXPECT().DeletePlacementGroups().Return(nil)
 					elbSvc.EXPECT().DeleteLoadbalancers().Return(nil)
 					sgSvc.EXPECT().DeleteSecurityGroups().Return(nil)
 					networkSvc.EXPECT().DeleteNetwork().Return(expectedErr)
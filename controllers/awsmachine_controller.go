@@ -320,6 +320,9 @@ func (r *AWSMachineReconciler) reconcileDelete(machineScope *scope.MachineScope,
 		// 4. Scale controller deployment to 1
 		machineScope.Debug("Unable to locate EC2 instance by ID or tags")
 		r.Recorder.Eventf(machineScope.AWSMachine, corev1.EventTypeWarning, "NoInstanceFound", "Unable to find matching EC2 instance")
+		if err := r.releaseElasticIP(machineScope, ec2Service); err != nil {
+			return ctrl.Result{}, err
+		}
 		controllerutil.RemoveFinalizer(machineScope.AWSMachine, infrav1.MachineFinalizer)
 		return ctrl.Result{}, nil
 	}
@@ -355,6 +358,9 @@ func (r *AWSMachineReconciler) reconcileDelete(machineScope *scope.MachineScope,
 		return ctrl.Result{RequeueAfter: time.Minute}, nil
 	case infrav1.InstanceStateTerminated:
 		machineScope.Info("EC2 instance terminated successfully", "instance-id", instance.ID)
+		if err := r.releaseElasticIP(machineScope, ec2Service); err != nil {
+			return ctrl.Result{}, err
+		}
 		controllerutil.RemoveFinalizer(machineScope.AWSMachine, infrav1.MachineFinalizer)
 		return ctrl.Result{}, nil
 	default:
@@ -367,7 +373,16 @@ func (r *AWSMachineReconciler) reconcileDelete(machineScope *scope.MachineScope,
 			return ctrl.Result{}, err
 		}
 
-		if err := ec2Service.TerminateInstance(instance.ID); err != nil {
+		// Termination protection enabled from the maintenance options would prevent the instance from being terminated.
+		if terminationProtected(machineScope.AWSMachine) {
+			if err := ec2Service.ModifyInstanceMaintenanceOptions(instance, &infrav1.InstanceMaintenanceOptions{DisableAPITermination: aws.Bool(false)}); err != nil {
+				machineScope.Error(err, "failed to disable termination protection")
+				conditions.MarkFalse(machineScope.AWSMachine, infrav1.InstanceReadyCondition, "DeletingFailed", clusterv1.ConditionSeverityWarning, err.Error())
+				return ctrl.Result{}, err
+			}
+		}
+
+		if err := ec2Service.TerminateInstanceAndWait(instance.ID); err != nil {
 			machineScope.Error(err, "failed to terminate instance")
 			conditions.MarkFalse(machineScope.AWSMachine, infrav1.InstanceReadyCondition, "DeletingFailed", clusterv1.ConditionSeverityWarning, err.Error())
 			r.Recorder.Eventf(machineScope.AWSMachine, corev1.EventTypeWarning, "FailedTerminate", "Failed to terminate instance %q: %v", instance.ID, err)
@@ -375,6 +390,11 @@ func (r *AWSMachineReconciler) reconcileDelete(machineScope *scope.MachineScope,
 		}
 		conditions.MarkFalse(machineScope.AWSMachine, infrav1.InstanceReadyCondition, clusterv1.DeletedReason, clusterv1.ConditionSeverityInfo, "")
 
+		// Elastic IPs are only released once the instance is terminated, so they are not lost if termination fails.
+		if err := r.releaseElasticIP(machineScope, ec2Service); err != nil {
+			return ctrl.Result{}, err
+		}
+
 		// If the AWSMachine specifies NetworkStatus Interfaces, detach the cluster's core Security Groups from them as part of deletion.
 		if len(machineScope.AWSMachine.Spec.NetworkInterfaces) > 0 {
 			core, err := ec2Service.GetCoreSecurityGroups(machineScope)
@@ -412,6 +432,19 @@ func (r *AWSMachineReconciler) reconcileDelete(machineScope *scope.MachineScope,
 	}
 }
 
+// releaseElasticIP releases the Elastic IP addresses allocated for the machine, if any.
+func (r *AWSMachineReconciler) releaseElasticIP(machineScope *scope.MachineScope, ec2svc services.EC2Interface) error {
+	if machineScope.AWSMachine.Spec.ElasticIP == nil {
+		return nil
+	}
+
+	if err := ec2svc.ReleaseElasticIP(machineScope); err != nil {
+		machineScope.Error(err, "failed to release Elastic IP")
+		return err
+	}
+	return nil
+}
+
 // findInstance queries the EC2 apis and retrieves the instance if it exists.
 // If providerID is empty, finds instance by tags and if it cannot be found, returns empty instance with nil error.
 // If providerID is set, either finds the instance by ID or returns error.
@@ -617,6 +650,11 @@ func (r *AWSMachineReconciler) reconcileNormal(_ context.Context, machineScope *
 func (r *AWSMachineReconciler) reconcileOperationalState(ec2svc services.EC2Interface, machineScope *scope.MachineScope, instance *infrav1.Instance) error {
 	machineScope.SetAddresses(instance.Addresses)
 
+	if err := r.ensureElasticIP(ec2svc, machineScope, instance); err != nil {
+		machineScope.Error(err, "failed to ensure Elastic IP")
+		return err
+	}
+
 	existingSecurityGroups, err := ec2svc.GetInstanceSecurityGroups(*machineScope.GetInstanceID())
 	if err != nil {
 		machineScope.Error(err, "unable to get instance security groups")
@@ -638,6 +676,12 @@ func (r *AWSMachineReconciler) reconcileOperationalState(ec2svc services.EC2Inte
 		return err
 	}
 
+	err = r.ensureInstanceMaintenanceOptions(ec2svc, instance, machineScope.AWSMachine)
+	if err != nil {
+		machineScope.Error(err, "failed to ensure instance maintenance options")
+		return err
+	}
+
 	return nil
 }
 
@@ -831,31 +875,48 @@ func (r *AWSMachineReconciler) reconcileLBAttachment(machineScope *scope.Machine
 	// In order to prevent sending request to a "not-ready" control plane machines, it is required to remove the machine
 	// from the ELB as soon as the machine gets deleted or when the machine is in a not running state.
 	if !machineScope.AWSMachine.DeletionTimestamp.IsZero() || !machineScope.InstanceIsRunning() {
-		if elbScope.ControlPlaneLoadBalancer().LoadBalancerType == infrav1.LoadBalancerTypeClassic {
-			machineScope.Debug("deregistering from classic load balancer")
-			return r.deregisterInstanceFromClassicLB(machineScope, elbsvc, i)
+		for _, lbSpec := range elbScope.ControlPlaneLoadBalancers() {
+			if lbSpec.LoadBalancerType == infrav1.LoadBalancerTypeClassic {
+				machineScope.Debug("deregistering from classic load balancer")
+				if err := r.deregisterInstanceFromClassicLB(machineScope, elbsvc, i); err != nil {
+					return err
+				}
+				continue
+			}
+			machineScope.Debug("deregistering from v2 load balancer")
+			if err := r.deregisterInstanceFromV2LB(machineScope, elbsvc, i, lbSpec); err != nil {
+				return err
+			}
 		}
-		machineScope.Debug("deregistering from v2 load balancer")
-		return r.deregisterInstanceFromV2LB(machineScope, elbsvc, i)
+		return nil
 	}
 
-	switch elbScope.ControlPlaneLoadBalancer().LoadBalancerType {
-	case infrav1.LoadBalancerTypeClassic:
-		fallthrough
-	case "":
-		machineScope.Debug("registering to classic load balancer")
-		return r.registerInstanceToClassicLB(machineScope, elbsvc, i)
+	for _, lbSpec := range elbScope.ControlPlaneLoadBalancers() {
+		switch lbSpec.LoadBalancerType {
+		case infrav1.LoadBalancerTypeClassic:
+			fallthrough
+		case "":
+			machineScope.Debug("registering to classic load balancer")
+			if err := r.registerInstanceToClassicLB(machineScope, elbsvc, i); err != nil {
+				return err
+			}
+
+		case infrav1.LoadBalancerTypeELB:
+			fallthrough
+		case infrav1.LoadBalancerTypeALB:
+			fallthrough
+		case infrav1.LoadBalancerTypeNLB:
+			machineScope.Debug("registering to v2 load balancer")
+			if err := r.registerInstanceToV2LB(machineScope, elbsvc, i, lbSpec); err != nil {
+				return err
+			}
 
-	case infrav1.LoadBalancerTypeELB:
-		fallthrough
-	case infrav1.LoadBalancerTypeALB:
-		fallthrough
-	case infrav1.LoadBalancerTypeNLB:
-		machineScope.Debug("registering to v2 load balancer")
-		return r.registerInstanceToV2LB(machineScope, elbsvc, i)
+		default:
+			return errors.Errorf("unknown load balancer type %q", lbSpec.LoadBalancerType)
+		}
 	}
 
-	return errors.Errorf("unknown load balancer type %q", elbScope.ControlPlaneLoadBalancer().LoadBalancerType)
+	return nil
 }
 
 func (r *AWSMachineReconciler) registerInstanceToClassicLB(machineScope *scope.MachineScope, elbsvc services.ELBInterface, i *infrav1.Instance) error {
@@ -882,8 +943,8 @@ func (r *AWSMachineReconciler) registerInstanceToClassicLB(machineScope *scope.M
 	return nil
 }
 
-func (r *AWSMachineReconciler) registerInstanceToV2LB(machineScope *scope.MachineScope, elbsvc services.ELBInterface, instance *infrav1.Instance) error {
-	_, registered, err := elbsvc.IsInstanceRegisteredWithAPIServerLB(instance)
+func (r *AWSMachineReconciler) registerInstanceToV2LB(machineScope *scope.MachineScope, elbsvc services.ELBInterface, instance *infrav1.Instance, lb *infrav1.AWSLoadBalancerSpec) error {
+	_, registered, err := elbsvc.IsInstanceRegisteredWithAPIServerLB(instance, lb)
 	if err != nil {
 		r.Recorder.Eventf(machineScope.AWSMachine, corev1.EventTypeWarning, "FailedAttachControlPlaneELB",
 			"Failed to register control plane instance %q with load balancer: failed to determine registration status: %v", instance.ID, err)
@@ -894,7 +955,7 @@ func (r *AWSMachineReconciler) registerInstanceToV2LB(machineScope *scope.Machin
 		return nil
 	}
 
-	if err := elbsvc.RegisterInstanceWithAPIServerLB(instance); err != nil {
+	if err := elbsvc.RegisterInstanceWithAPIServerLB(instance, lb); err != nil {
 		r.Recorder.Eventf(machineScope.AWSMachine, corev1.EventTypeWarning, "FailedAttachControlPlaneELB",
 			"Failed to register control plane instance %q with load balancer: %v", instance.ID, err)
 		conditions.MarkFalse(machineScope.AWSMachine, infrav1.ELBAttachedCondition, infrav1.ELBAttachFailedReason, clusterv1.ConditionSeverityError, err.Error())
@@ -930,8 +991,8 @@ func (r *AWSMachineReconciler) deregisterInstanceFromClassicLB(machineScope *sco
 	return nil
 }
 
-func (r *AWSMachineReconciler) deregisterInstanceFromV2LB(machineScope *scope.MachineScope, elbsvc services.ELBInterface, i *infrav1.Instance) error {
-	targetGroupArn, registered, err := elbsvc.IsInstanceRegisteredWithAPIServerLB(i)
+func (r *AWSMachineReconciler) deregisterInstanceFromV2LB(machineScope *scope.MachineScope, elbsvc services.ELBInterface, i *infrav1.Instance, lb *infrav1.AWSLoadBalancerSpec) error {
+	targetGroupArn, registered, err := elbsvc.IsInstanceRegisteredWithAPIServerLB(i, lb)
 	if err != nil {
 		r.Recorder.Eventf(machineScope.AWSMachine, corev1.EventTypeWarning, "FailedDetachControlPlaneELB",
 			"Failed to deregister control plane instance %q from load balancer: failed to determine registration status: %v", i.ID, err)
@@ -1134,6 +1195,76 @@ func (r *AWSMachineReconciler) ensureStorageTags(ec2svc services.EC2Interface, i
 	}
 }
 
+// ensureElasticIP associates the Elastic IP of the machine with its running instance and reports it as the
+// external address of the machine.
+func (r *AWSMachineReconciler) ensureElasticIP(ec2svc services.EC2Interface, machineScope *scope.MachineScope, instance *infrav1.Instance) error {
+	if machineScope.AWSMachine.Spec.ElasticIP == nil || instance.State != infrav1.InstanceStateRunning {
+		return nil
+	}
+
+	publicIP, err := ec2svc.ReconcileElasticIP(machineScope, instance.ID)
+	if err != nil {
+		return err
+	}
+
+	// The instance was described before the association, so its addresses may still hold the public IP
+	// it was launched with.
+	addresses := make([]clusterv1.MachineAddress, 0, len(instance.Addresses)+1)
+	for _, address := range instance.Addresses {
+		if address.Type != clusterv1.MachineExternalIP {
+			addresses = append(addresses, address)
+		}
+	}
+	addresses = append(addresses, clusterv1.MachineAddress{Type: clusterv1.MachineExternalIP, Address: publicIP})
+	machineScope.SetAddresses(addresses)
+
+	return nil
+}
+
+func (r *AWSMachineReconciler) ensureInstanceMaintenanceOptions(ec2svc services.EC2Interface, instance *infrav1.Instance, machine *infrav1.AWSMachine) error {
+	options := machine.Spec.MaintenanceOptions
+	if options == nil {
+		return nil
+	}
+
+	// The options are compared with the instance attributes on every reconcile, so changes made outside of
+	// Cluster API are reverted.
+	if err := ec2svc.ModifyInstanceMaintenanceOptions(instance, options); err != nil {
+		return err
+	}
+
+	// Options removed from the spec are left as they are on the instance, so they stay recorded.
+	applied := machine.Status.MaintenanceOptions
+	if applied == nil {
+		applied = &infrav1.InstanceMaintenanceOptions{}
+	}
+	if options.AutoRecovery != "" {
+		applied.AutoRecovery = options.AutoRecovery
+	}
+	if options.DisableAPITermination != nil {
+		applied.DisableAPITermination = aws.Bool(*options.DisableAPITermination)
+	}
+	if options.DisableAPIStop != nil {
+		applied.DisableAPIStop = aws.Bool(*options.DisableAPIStop)
+	}
+	if options.InstanceInitiatedShutdownBehavior != "" {
+		applied.InstanceInitiatedShutdownBehavior = options.InstanceInitiatedShutdownBehavior
+	}
+	machine.Status.MaintenanceOptions = applied
+	return nil
+}
+
+// terminationProtected returns whether termination protection was enabled from the machine maintenance options,
+// either by the current spec or by the options last applied to the instance.
+func terminationProtected(machine *infrav1.AWSMachine) bool {
+	for _, options := range []*infrav1.InstanceMaintenanceOptions{machine.Spec.MaintenanceOptions, machine.Status.MaintenanceOptions} {
+		if options != nil && aws.BoolValue(options.DisableAPITermination) {
+			return true
+		}
+	}
+	return false
+}
+
 func (r *AWSMachineReconciler) ensureInstanceMetadataOptions(ec2svc services.EC2Interface, instance *infrav1.Instance, machine *infrav1.AWSMachine) error {
 	if cmp.Equal(machine.Spec.InstanceMetadataOptions, instance.InstanceMetadataOptions) {
 		return nil
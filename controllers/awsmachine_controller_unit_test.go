@@ -1079,7 +1079,7 @@ func TestAWSMachineReconciler(t *testing.T) {
 
 				instance.State = infrav1.InstanceStateRunning
 				secretSvc.EXPECT().Delete(gomock.Any()).Return(nil).Times(1)
-				ec2Svc.EXPECT().TerminateInstance(gomock.Any()).Return(nil).AnyTimes()
+				ec2Svc.EXPECT().TerminateInstanceAndWait(gomock.Any()).Return(nil).AnyTimes()
 				_, _ = reconciler.reconcileDelete(ms, cs, cs, cs, cs)
 			})
 
@@ -1092,7 +1092,7 @@ func TestAWSMachineReconciler(t *testing.T) {
 
 				ms.AWSMachine.Status.FailureReason = capierrors.MachineStatusErrorPtr(capierrors.UpdateMachineError)
 				secretSvc.EXPECT().Delete(gomock.Any()).Return(nil).Times(1)
-				ec2Svc.EXPECT().TerminateInstance(gomock.Any()).Return(nil).AnyTimes()
+				ec2Svc.EXPECT().TerminateInstanceAndWait(gomock.Any()).Return(nil).AnyTimes()
 				_, _ = reconciler.reconcileDelete(ms, cs, cs, cs, cs)
 			})
 			t.Run("should not attempt to delete the secret if InsecureSkipSecretsManager is set on CloudInit", func(t *testing.T) {
@@ -1105,7 +1105,7 @@ func TestAWSMachineReconciler(t *testing.T) {
 				ms.AWSMachine.Spec.CloudInit.InsecureSkipSecretsManager = true
 
 				secretSvc.EXPECT().Delete(gomock.Any()).Return(nil).Times(0)
-				ec2Svc.EXPECT().TerminateInstance(gomock.Any()).Return(nil).AnyTimes()
+				ec2Svc.EXPECT().TerminateInstanceAndWait(gomock.Any()).Return(nil).AnyTimes()
 
 				_, _ = reconciler.reconcileDelete(ms, cs, cs, cs, cs)
 			})
@@ -1165,7 +1165,7 @@ func TestAWSMachineReconciler(t *testing.T) {
 
 				instance.State = infrav1.InstanceStateRunning
 				secretSvc.EXPECT().Delete(gomock.Any()).Return(nil).Times(1)
-				ec2Svc.EXPECT().TerminateInstance(gomock.Any()).Return(nil).AnyTimes()
+				ec2Svc.EXPECT().TerminateInstanceAndWait(gomock.Any()).Return(nil).AnyTimes()
 				_, _ = reconciler.reconcileDelete(ms, cs, cs, cs, cs)
 			})
 
@@ -1178,7 +1178,7 @@ func TestAWSMachineReconciler(t *testing.T) {
 
 				ms.AWSMachine.Status.FailureReason = capierrors.MachineStatusErrorPtr(capierrors.UpdateMachineError)
 				secretSvc.EXPECT().Delete(gomock.Any()).Return(nil).Times(1)
-				ec2Svc.EXPECT().TerminateInstance(gomock.Any()).Return(nil).AnyTimes()
+				ec2Svc.EXPECT().TerminateInstanceAndWait(gomock.Any()).Return(nil).AnyTimes()
 				_, _ = reconciler.reconcileDelete(ms, cs, cs, cs, cs)
 			})
 		})
@@ -1346,7 +1346,7 @@ func TestAWSMachineReconciler(t *testing.T) {
 
 				instance.State = infrav1.InstanceStateRunning
 				objectStoreSvc.EXPECT().Delete(gomock.Any()).Return(nil).Times(1)
-				ec2Svc.EXPECT().TerminateInstance(gomock.Any()).Return(nil).AnyTimes()
+				ec2Svc.EXPECT().TerminateInstanceAndWait(gomock.Any()).Return(nil).AnyTimes()
 
 				_, _ = reconciler.reconcileDelete(ms, cs, cs, cs, cs)
 			})
@@ -1363,7 +1363,7 @@ func TestAWSMachineReconciler(t *testing.T) {
 				ms.AWSMachine.Status.FailureReason = capierrors.MachineStatusErrorPtr(capierrors.UpdateMachineError)
 
 				objectStoreSvc.EXPECT().Delete(gomock.Any()).Return(nil).Times(1)
-				ec2Svc.EXPECT().TerminateInstance(gomock.Any()).Return(nil).AnyTimes()
+				ec2Svc.EXPECT().TerminateInstanceAndWait(gomock.Any()).Return(nil).AnyTimes()
 
 				_, _ = reconciler.reconcileDelete(ms, cs, cs, cs, cs)
 			})
@@ -1427,7 +1427,7 @@ func TestAWSMachineReconciler(t *testing.T) {
 
 				instance.State = infrav1.InstanceStateRunning
 				objectStoreSvc.EXPECT().Delete(gomock.Any()).Return(nil).Times(1)
-				ec2Svc.EXPECT().TerminateInstance(gomock.Any()).Return(nil).AnyTimes()
+				ec2Svc.EXPECT().TerminateInstanceAndWait(gomock.Any()).Return(nil).AnyTimes()
 				_, _ = reconciler.reconcileDelete(ms, cs, cs, cs, cs)
 			})
 
@@ -1442,7 +1442,7 @@ func TestAWSMachineReconciler(t *testing.T) {
 				// TODO: This seems to have no effect on the test result.
 				ms.AWSMachine.Status.FailureReason = capierrors.MachineStatusErrorPtr(capierrors.UpdateMachineError)
 				objectStoreSvc.EXPECT().Delete(gomock.Any()).Return(nil).Times(1)
-				ec2Svc.EXPECT().TerminateInstance(gomock.Any()).Return(nil).AnyTimes()
+				ec2Svc.EXPECT().TerminateInstanceAndWait(gomock.Any()).Return(nil).AnyTimes()
 				_, _ = reconciler.reconcileDelete(ms, cs, cs, cs, cs)
 			})
 		})
@@ -1514,6 +1514,22 @@ func TestAWSMachineReconciler(t *testing.T) {
 			g.Expect(ms.AWSMachine.Finalizers).To(ConsistOf(metav1.FinalizerDeleteDependents))
 			g.Eventually(recorder.Events).Should(Receive(ContainSubstring("NoInstanceFound")))
 		})
+		t.Run("should release the Elastic IP when no machine exists", func(t *testing.T) {
+			g := NewWithT(t)
+			awsMachine := getAWSMachine()
+			setup(t, g, awsMachine)
+			defer teardown(t, g)
+			finalizer(t, g)
+
+			ms.AWSMachine.Spec.ElasticIP = &infrav1.ElasticIPSpec{}
+			ec2Svc.EXPECT().GetRunningInstanceByTags(gomock.Any()).Return(nil, nil)
+			ec2Svc.EXPECT().ReleaseElasticIP(gomock.Any()).Return(nil)
+			secretSvc.EXPECT().Delete(gomock.Any()).Return(nil).AnyTimes()
+
+			_, err := reconciler.reconcileDelete(ms, cs, cs, cs, cs)
+			g.Expect(err).To(BeNil())
+			g.Expect(ms.AWSMachine.Finalizers).To(ConsistOf(metav1.FinalizerDeleteDependents))
+		})
 		t.Run("should ignore instances in shutting down state", func(t *testing.T) {
 			g := NewWithT(t)
 			awsMachine := getAWSMachine()
@@ -1569,7 +1585,7 @@ func TestAWSMachineReconciler(t *testing.T) {
 				getRunningInstance(t, g)
 
 				expected := errors.New("can't reach AWS to terminate machine")
-				ec2Svc.EXPECT().TerminateInstance(gomock.Any()).Return(expected)
+				ec2Svc.EXPECT().TerminateInstanceAndWait(gomock.Any()).Return(expected)
 
 				buf := new(bytes.Buffer)
 				klog.SetOutput(buf)
@@ -1579,10 +1595,60 @@ func TestAWSMachineReconciler(t *testing.T) {
 				g.Expect(buf.String()).To(ContainSubstring("Terminating EC2 instance"))
 				g.Eventually(recorder.Events).Should(Receive(ContainSubstring("FailedTerminate")))
 			})
+			t.Run("should not release the Elastic IP when the instance can't be terminated", func(t *testing.T) {
+				g := NewWithT(t)
+				awsMachine := getAWSMachine()
+				setup(t, g, awsMachine)
+				defer teardown(t, g)
+				finalizer(t, g)
+				getRunningInstance(t, g)
+
+				ms.AWSMachine.Spec.ElasticIP = &infrav1.ElasticIPSpec{}
+				expected := errors.New("can't reach AWS to terminate machine")
+				ec2Svc.EXPECT().TerminateInstanceAndWait(gomock.Any()).Return(expected)
+				ec2Svc.EXPECT().ReleaseElasticIP(gomock.Any()).Times(0)
+
+				_, err := reconciler.reconcileDelete(ms, cs, cs, cs, cs)
+				g.Expect(errors.Cause(err)).To(MatchError(expected))
+			})
+			t.Run("should release the Elastic IP once the instance is terminated", func(t *testing.T) {
+				g := NewWithT(t)
+				awsMachine := getAWSMachine()
+				setup(t, g, awsMachine)
+				defer teardown(t, g)
+				finalizer(t, g)
+				getRunningInstance(t, g)
+
+				ms.AWSMachine.Spec.ElasticIP = &infrav1.ElasticIPSpec{}
+				gomock.InOrder(
+					ec2Svc.EXPECT().TerminateInstanceAndWait(gomock.Any()).Return(nil),
+					ec2Svc.EXPECT().ReleaseElasticIP(gomock.Any()).Return(nil),
+				)
+
+				_, err := reconciler.reconcileDelete(ms, cs, cs, cs, cs)
+				g.Expect(err).To(BeNil())
+			})
+			t.Run("should disable termination protection enabled from the maintenance options before terminating", func(t *testing.T) {
+				g := NewWithT(t)
+				awsMachine := getAWSMachine()
+				setup(t, g, awsMachine)
+				defer teardown(t, g)
+				finalizer(t, g)
+				getRunningInstance(t, g)
+
+				ms.AWSMachine.Status.MaintenanceOptions = &infrav1.InstanceMaintenanceOptions{DisableAPITermination: aws.Bool(true)}
+				gomock.InOrder(
+					ec2Svc.EXPECT().ModifyInstanceMaintenanceOptions(gomock.Any(), gomock.Eq(&infrav1.InstanceMaintenanceOptions{DisableAPITermination: aws.Bool(false)})).Return(nil),
+					ec2Svc.EXPECT().TerminateInstanceAndWait(gomock.Any()).Return(nil),
+				)
+
+				_, err := reconciler.reconcileDelete(ms, cs, cs, cs, cs)
+				g.Expect(err).To(BeNil())
+			})
 			t.Run("when instance can be shut down", func(t *testing.T) {
 				terminateInstance := func(t *testing.T, g *WithT) {
 					t.Helper()
-					ec2Svc.EXPECT().TerminateInstance(gomock.Any()).Return(nil)
+					ec2Svc.EXPECT().TerminateInstanceAndWait(gomock.Any()).Return(nil)
 					secretSvc.EXPECT().Delete(gomock.Any()).Return(nil).AnyTimes()
 				}
 
@@ -2592,6 +2658,180 @@ func TestAWSMachineReconcilerReconcileDefaultsToLoadBalancerTypeClassic(t *testi
 	g.Expect(err).To(BeNil())
 }
 
+func TestAWSMachineReconcilerReconcileLBAttachmentWithSecondaryLB(t *testing.T) {
+	primaryLB := &infrav1.AWSLoadBalancerSpec{
+		Name:             aws.String("capi-test-apiserver"),
+		LoadBalancerType: infrav1.LoadBalancerTypeNLB,
+	}
+	secondaryLB := &infrav1.AWSLoadBalancerSpec{
+		Name:             aws.String("capi-test-apiserver-internal"),
+		LoadBalancerType: infrav1.LoadBalancerTypeNLB,
+	}
+
+	testCases := []struct {
+		name       string
+		deleting   bool
+		expect     func(m *mock_services.MockELBInterfaceMockRecorder, instance *infrav1.Instance)
+		conditions []conditionAssertion
+	}{
+		{
+			name: "registers the instance with both load balancers",
+			expect: func(m *mock_services.MockELBInterfaceMockRecorder, instance *infrav1.Instance) {
+				m.IsInstanceRegisteredWithAPIServerLB(instance, primaryLB).Return("", false, nil)
+				m.RegisterInstanceWithAPIServerLB(instance, primaryLB).Return(nil)
+				m.IsInstanceRegisteredWithAPIServerLB(instance, secondaryLB).Return("", false, nil)
+				m.RegisterInstanceWithAPIServerLB(instance, secondaryLB).Return(nil)
+			},
+			conditions: []conditionAssertion{{infrav1.ELBAttachedCondition, corev1.ConditionTrue, "", ""}},
+		},
+		{
+			name:     "deregisters the instance from both load balancers",
+			deleting: true,
+			expect: func(m *mock_services.MockELBInterfaceMockRecorder, instance *infrav1.Instance) {
+				m.IsInstanceRegisteredWithAPIServerLB(instance, primaryLB).Return("primary::target-group", true, nil)
+				m.DeregisterInstanceFromAPIServerLB("primary::target-group", instance).Return(nil)
+				m.IsInstanceRegisteredWithAPIServerLB(instance, secondaryLB).Return("secondary::target-group", true, nil)
+				m.DeregisterInstanceFromAPIServerLB("secondary::target-group", instance).Return(nil)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			elbSvc := mock_services.NewMockELBInterface(mockCtrl)
+
+			awsMachine := &infrav1.AWSMachine{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test",
+					Namespace: "default",
+				},
+				Status: infrav1.AWSMachineStatus{
+					InstanceState: &infrav1.InstanceStateRunning,
+				},
+			}
+			if tc.deleting {
+				awsMachine.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+			}
+			client := fake.NewClientBuilder().Build()
+			cs, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Client:  client,
+				Cluster: &clusterv1.Cluster{},
+				AWSCluster: &infrav1.AWSCluster{
+					ObjectMeta: metav1.ObjectMeta{Name: "test"},
+					Spec: infrav1.AWSClusterSpec{
+						ControlPlaneLoadBalancer:          primaryLB,
+						SecondaryControlPlaneLoadBalancer: secondaryLB,
+					},
+				},
+			})
+			g.Expect(err).NotTo(HaveOccurred())
+			ms, err := scope.NewMachineScope(scope.MachineScopeParams{
+				Client:  client,
+				Cluster: &clusterv1.Cluster{},
+				Machine: &clusterv1.Machine{
+					ObjectMeta: metav1.ObjectMeta{
+						Labels: map[string]string{clusterv1.MachineControlPlaneLabel: ""},
+					},
+				},
+				InfraCluster: cs,
+				AWSMachine:   awsMachine,
+			})
+			g.Expect(err).NotTo(HaveOccurred())
+
+			instance := &infrav1.Instance{ID: "i-1"}
+			tc.expect(elbSvc.EXPECT(), instance)
+
+			reconciler := &AWSMachineReconciler{
+				elbServiceFactory: func(scope.ELBScope) services.ELBInterface {
+					return elbSvc
+				},
+				Recorder: record.NewFakeRecorder(4),
+			}
+			g.Expect(reconciler.reconcileLBAttachment(ms, cs, instance)).To(Succeed())
+			expectConditions(g, ms.AWSMachine, tc.conditions)
+		})
+	}
+}
+
+func TestAWSMachineReconcilerEnsureInstanceMaintenanceOptions(t *testing.T) {
+	options := &infrav1.InstanceMaintenanceOptions{
+		AutoRecovery:          infrav1.AutoRecoveryStateDisabled,
+		DisableAPITermination: aws.Bool(true),
+	}
+
+	testCases := []struct {
+		name          string
+		spec          *infrav1.InstanceMaintenanceOptions
+		status        *infrav1.InstanceMaintenanceOptions
+		expectOptions *infrav1.InstanceMaintenanceOptions
+		expectStatus  *infrav1.InstanceMaintenanceOptions
+	}{
+		{
+			name: "does nothing without maintenance options",
+		},
+		{
+			name:          "applies all the options when they were never applied",
+			spec:          options,
+			expectOptions: options,
+			expectStatus:  options,
+		},
+		{
+			name:          "applies all the options when the spec changed",
+			spec:          options,
+			status:        &infrav1.InstanceMaintenanceOptions{DisableAPITermination: aws.Bool(false)},
+			expectOptions: options,
+			expectStatus:  options,
+		},
+		{
+			name:          "checks all the options again when they were already applied",
+			spec:          options,
+			status:        options.DeepCopy(),
+			expectOptions: options,
+			expectStatus:  options,
+		},
+		{
+			name:          "keeps the applied options that are removed from the spec",
+			spec:          &infrav1.InstanceMaintenanceOptions{AutoRecovery: infrav1.AutoRecoveryStateDefault},
+			status:        options.DeepCopy(),
+			expectOptions: &infrav1.InstanceMaintenanceOptions{AutoRecovery: infrav1.AutoRecoveryStateDefault},
+			expectStatus: &infrav1.InstanceMaintenanceOptions{
+				AutoRecovery:          infrav1.AutoRecoveryStateDefault,
+				DisableAPITermination: aws.Bool(true),
+			},
+		},
+		{
+			name:         "keeps the applied options when the maintenance options are removed from the spec",
+			status:       options.DeepCopy(),
+			expectStatus: options,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			ec2Svc := mock_services.NewMockEC2Interface(mockCtrl)
+
+			instance := &infrav1.Instance{ID: "i-1"}
+			machine := &infrav1.AWSMachine{
+				Spec:   infrav1.AWSMachineSpec{MaintenanceOptions: tc.spec},
+				Status: infrav1.AWSMachineStatus{MaintenanceOptions: tc.status},
+			}
+			if tc.expectOptions != nil {
+				ec2Svc.EXPECT().ModifyInstanceMaintenanceOptions(instance, gomock.Eq(tc.expectOptions)).Return(nil)
+			}
+
+			reconciler := &AWSMachineReconciler{}
+			g.Expect(reconciler.ensureInstanceMaintenanceOptions(ec2Svc, instance, machine)).To(Succeed())
+			g.Expect(machine.Status.MaintenanceOptions).To(Equal(tc.expectStatus))
+		})
+	}
+}
+
 func createObject(g *WithT, obj client.Object, namespace string) {
 	if obj.DeepCopyObject() != nil {
 		obj.SetNamespace(namespace)
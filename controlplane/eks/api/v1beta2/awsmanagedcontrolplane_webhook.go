@@ -21,6 +21,7 @@ import (
 	"net"
 
 	"github.com/apparentlymart/go-cidr/cidr"
+	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -85,6 +86,13 @@ func (r *AWSManagedControlPlane) ValidateCreate() error {
 	// TODO: Add ipv6 validation things in these validations.
 	allErrs = append(allErrs, r.validateEKSVersion(nil)...)
 	allErrs = append(allErrs, r.Spec.Bastion.Validate()...)
+	allErrs = append(allErrs, r.Spec.NetworkSpec.TransitGateway.Validate()...)
+	allErrs = append(allErrs, r.Spec.NetworkSpec.ElasticIPPool.Validate()...)
+	allErrs = append(allErrs, r.Spec.NetworkSpec.ValidateAdditionalRoutes()...)
+	allErrs = append(allErrs, r.Spec.NetworkSpec.NetworkACLs.Validate()...)
+	allErrs = append(allErrs, r.Spec.NetworkSpec.VPC.ValidateFlowLogs()...)
+	allErrs = append(allErrs, r.Spec.NetworkSpec.ValidateSecurityGroupEgressRules()...)
+	allErrs = append(allErrs, r.Spec.NetworkSpec.ValidateAdditionalIngressRules()...)
 	allErrs = append(allErrs, r.validateIAMAuthConfig()...)
 	allErrs = append(allErrs, r.validateSecondaryCIDR()...)
 	allErrs = append(allErrs, r.validateEKSAddons()...)
@@ -119,6 +127,13 @@ func (r *AWSManagedControlPlane) ValidateUpdate(old runtime.Object) error {
 	allErrs = append(allErrs, r.validateEKSClusterNameSame(oldAWSManagedControlplane)...)
 	allErrs = append(allErrs, r.validateEKSVersion(oldAWSManagedControlplane)...)
 	allErrs = append(allErrs, r.Spec.Bastion.Validate()...)
+	allErrs = append(allErrs, r.Spec.NetworkSpec.TransitGateway.Validate()...)
+	allErrs = append(allErrs, r.Spec.NetworkSpec.ElasticIPPool.Validate()...)
+	allErrs = append(allErrs, r.Spec.NetworkSpec.ValidateAdditionalRoutes()...)
+	allErrs = append(allErrs, r.Spec.NetworkSpec.NetworkACLs.Validate()...)
+	allErrs = append(allErrs, r.Spec.NetworkSpec.VPC.ValidateFlowLogs()...)
+	allErrs = append(allErrs, r.Spec.NetworkSpec.ValidateSecurityGroupEgressRules()...)
+	allErrs = append(allErrs, r.Spec.NetworkSpec.ValidateAdditionalIngressRules()...)
 	allErrs = append(allErrs, r.validateIAMAuthConfig()...)
 	allErrs = append(allErrs, r.validateSecondaryCIDR()...)
 	allErrs = append(allErrs, r.validateEKSAddons()...)
@@ -163,6 +178,16 @@ func (r *AWSManagedControlPlane) ValidateUpdate(old runtime.Object) error {
 			field.Invalid(field.NewPath("spec", "networkSpec", "vpc", "enableIPv6"), r.Spec.NetworkSpec.VPC.IsIPv6Enabled(), "changing IP family is not allowed after it has been set"))
 	}
 
+	if !cmp.Equal(oldAWSManagedControlplane.Spec.NetworkSpec.VPC.IPAMPool, r.Spec.NetworkSpec.VPC.IPAMPool) {
+		allErrs = append(allErrs,
+			field.Invalid(field.NewPath("spec", "networkSpec", "vpc", "ipamPool"), r.Spec.NetworkSpec.VPC.IPAMPool, "field is immutable"))
+	}
+
+	if oldAWSManagedControlplane.Spec.NetworkSpec.NetworkACLs != nil && r.Spec.NetworkSpec.NetworkACLs == nil {
+		allErrs = append(allErrs,
+			field.Invalid(field.NewPath("spec", "networkSpec", "networkAcls"), r.Spec.NetworkSpec.NetworkACLs, "field cannot be removed once set, unset its public and private network ACLs instead"))
+	}
+
 	if len(allErrs) == 0 {
 		return nil
 	}
@@ -396,6 +421,8 @@ func (r *AWSManagedControlPlane) validateNetwork() field.ErrorList {
 		allErrs = append(allErrs, field.Invalid(poolField, r.Spec.NetworkSpec.VPC.IPv6.PoolID, "poolId cannot be empty if cidrBlock is set"))
 	}
 
+	allErrs = append(allErrs, r.Spec.NetworkSpec.VPC.ValidateIPAMPool()...)
+
 	return allErrs
 }
 
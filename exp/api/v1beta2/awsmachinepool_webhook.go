@@ -119,6 +119,8 @@ func (r *AWSMachinePool) ValidateCreate() error {
 	allErrs = append(allErrs, r.Spec.AdditionalTags.Validate()...)
 	allErrs = append(allErrs, r.validateSubnets()...)
 	allErrs = append(allErrs, r.validateAdditionalSecurityGroups()...)
+	allErrs = append(allErrs, r.Spec.AWSLaunchTemplate.CapacityReservation.Validate(field.NewPath("spec", "awsLaunchTemplate", "capacityReservation"), r.Spec.AWSLaunchTemplate.SpotMarketOptions)...)
+	allErrs = append(allErrs, r.Spec.AWSLaunchTemplate.MaintenanceOptions.Validate(field.NewPath("spec", "awsLaunchTemplate", "maintenanceOptions"), r.Spec.AWSLaunchTemplate.SpotMarketOptions)...)
 
 	if len(allErrs) == 0 {
 		return nil
@@ -139,6 +141,8 @@ func (r *AWSMachinePool) ValidateUpdate(old runtime.Object) error {
 	allErrs = append(allErrs, r.Spec.AdditionalTags.Validate()...)
 	allErrs = append(allErrs, r.validateSubnets()...)
 	allErrs = append(allErrs, r.validateAdditionalSecurityGroups()...)
+	allErrs = append(allErrs, r.Spec.AWSLaunchTemplate.CapacityReservation.Validate(field.NewPath("spec", "awsLaunchTemplate", "capacityReservation"), r.Spec.AWSLaunchTemplate.SpotMarketOptions)...)
+	allErrs = append(allErrs, r.Spec.AWSLaunchTemplate.MaintenanceOptions.Validate(field.NewPath("spec", "awsLaunchTemplate", "maintenanceOptions"), r.Spec.AWSLaunchTemplate.SpotMarketOptions)...)
 
 	if len(allErrs) == 0 {
 		return nil
@@ -139,6 +139,65 @@ func TestAWSMachinePoolValidateCreate(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "Should pass if a capacity reservation resource group is targeted",
+			pool: &AWSMachinePool{
+				Spec: AWSMachinePoolSpec{
+					AWSLaunchTemplate: AWSLaunchTemplate{
+						CapacityReservation: &infrav1.CapacityReservationSpecification{
+							Target: &infrav1.CapacityReservationTarget{
+								ResourceGroupARN: aws.String("arn:aws:resource-groups:us-east-1:123456789012:group/reservations"),
+							},
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Should fail if a capacity reservation target has neither id nor resource group",
+			pool: &AWSMachinePool{
+				Spec: AWSMachinePoolSpec{
+					AWSLaunchTemplate: AWSLaunchTemplate{
+						CapacityReservation: &infrav1.CapacityReservationSpecification{
+							Target: &infrav1.CapacityReservationTarget{},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Should fail if a capacity reservation id is invalid",
+			pool: &AWSMachinePool{
+				Spec: AWSMachinePoolSpec{
+					AWSLaunchTemplate: AWSLaunchTemplate{
+						CapacityReservation: &infrav1.CapacityReservationSpecification{
+							Target: &infrav1.CapacityReservationTarget{
+								ID: aws.String("reservation"),
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Should fail if a capacity reservation is targeted by spot instances",
+			pool: &AWSMachinePool{
+				Spec: AWSMachinePoolSpec{
+					AWSLaunchTemplate: AWSLaunchTemplate{
+						SpotMarketOptions: &infrav1.SpotMarketOptions{},
+						CapacityReservation: &infrav1.CapacityReservationSpecification{
+							Target: &infrav1.CapacityReservationTarget{
+								ID: aws.String("cr-12345"),
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
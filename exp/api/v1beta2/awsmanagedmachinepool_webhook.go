@@ -134,6 +134,13 @@ func (r *AWSManagedMachinePool) validateLaunchTemplate() field.ErrorList {
 		allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "AWSLaunchTemplate", "IamInstanceProfile"), r.Spec.AWSLaunchTemplate.IamInstanceProfile, "IAM instance profile in launch template is prohibited in EKS managed node group"))
 	}
 
+	allErrs = append(allErrs, r.Spec.AWSLaunchTemplate.CapacityReservation.Validate(field.NewPath("spec", "awsLaunchTemplate", "capacityReservation"), r.Spec.AWSLaunchTemplate.SpotMarketOptions)...)
+	allErrs = append(allErrs, r.Spec.AWSLaunchTemplate.MaintenanceOptions.Validate(field.NewPath("spec", "awsLaunchTemplate", "maintenanceOptions"), r.Spec.AWSLaunchTemplate.SpotMarketOptions)...)
+
+	if opts := r.Spec.AWSLaunchTemplate.MaintenanceOptions; opts != nil && opts.InstanceInitiatedShutdownBehavior != "" {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "awsLaunchTemplate", "maintenanceOptions", "instanceInitiatedShutdownBehavior"), "instance-initiated shutdown behavior in launch template is prohibited in EKS managed node group"))
+	}
+
 	return allErrs
 }
 
@@ -122,6 +122,20 @@ type AWSLaunchTemplate struct {
 
 	// SpotMarketOptions are options for configuring AWSMachinePool instances to be run using AWS Spot instances.
 	SpotMarketOptions *infrav1.SpotMarketOptions `json:"spotMarketOptions,omitempty"`
+
+	// CapacityReservation allows users to launch the instances into an On-Demand Capacity Reservation
+	// or a Capacity Reservation resource group, or to keep them out of any Capacity Reservation.
+	// +optional
+	CapacityReservation *infrav1.CapacityReservationSpecification `json:"capacityReservation,omitempty"`
+
+	// PrivateDNSName is the options for the hostname of the instances and the DNS records that resolve it.
+	// +optional
+	PrivateDNSName *infrav1.PrivateDNSName `json:"privateDnsName,omitempty"`
+
+	// MaintenanceOptions configures the automatic recovery, termination and stop protection, and the behavior
+	// on instance-initiated shutdown of the instances.
+	// +optional
+	MaintenanceOptions *infrav1.InstanceMaintenanceOptions `json:"maintenanceOptions,omitempty"`
 }
 
 // Overrides are used to override the instance type specified by the launch template with multiple
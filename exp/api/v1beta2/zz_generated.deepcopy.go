@@ -118,6 +118,21 @@ func (in *AWSLaunchTemplate) DeepCopyInto(out *AWSLaunchTemplate) {
 		*out = new(apiv1beta2.SpotMarketOptions)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.CapacityReservation != nil {
+		in, out := &in.CapacityReservation, &out.CapacityReservation
+		*out = new(apiv1beta2.CapacityReservationSpecification)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PrivateDNSName != nil {
+		in, out := &in.PrivateDNSName, &out.PrivateDNSName
+		*out = new(apiv1beta2.PrivateDNSName)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MaintenanceOptions != nil {
+		in, out := &in.MaintenanceOptions, &out.MaintenanceOptions
+		*out = new(apiv1beta2.InstanceMaintenanceOptions)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSLaunchTemplate.
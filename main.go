@@ -57,7 +57,9 @@ import (
 	"sigs.k8s.io/cluster-api-provider-aws/v2/exp/instancestate"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/feature"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/endpoints"
+	awsmetrics "sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/metrics"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/throttle"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/logger"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/record"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/version"
@@ -101,6 +103,10 @@ var (
 	webhookCertDir           string
 	healthAddr               string
 	serviceEndpoints         string
+	rateLimitsConfigFile     string
+	rateLimitsReloadPeriod   time.Duration
+	metricsMaxClusters       int
+	metricsMaxErrorCodes     int
 
 	// maxEKSSyncPeriod is the maximum allowed duration for the sync-period flag when using EKS. It is set to 10 minutes
 	// because during resync it will create a new AWS auth token which can a maximum life of 15 minutes and this ensures
@@ -198,6 +204,28 @@ func main() {
 		os.Exit(1)
 	}
 
+	awsmetrics.SetCardinalityLimits(metricsMaxClusters, metricsMaxErrorCodes)
+
+	if rateLimitsConfigFile != "" {
+		if rateLimitsReloadPeriod <= 0 {
+			setupLog.Error(errors.New("rate-limits-reload-period must be greater than 0"), "invalid rate limits configuration flags")
+			os.Exit(1)
+		}
+		reloader := &throttle.ConfigReloader{
+			Path:     rateLimitsConfigFile,
+			Interval: rateLimitsReloadPeriod,
+			Log:      logger.NewLogger(ctrl.Log.WithName("throttle")),
+		}
+		if err := reloader.Load(); err != nil {
+			setupLog.Error(err, "unable to load rate limits configuration")
+			os.Exit(1)
+		}
+		if err := mgr.Add(reloader); err != nil {
+			setupLog.Error(err, "unable to reload rate limits configuration")
+			os.Exit(1)
+		}
+	}
+
 	setupReconcilersAndWebhooks(ctx, mgr, awsServiceEndpoints, externalResourceGC, alternativeGCStrategy)
 	if feature.Gates.Enabled(feature.EKS) {
 		setupEKSReconcilersAndWebhooks(ctx, mgr, awsServiceEndpoints, externalResourceGC, alternativeGCStrategy, waitInfraPeriod)
@@ -318,6 +346,10 @@ func setupReconcilersAndWebhooks(ctx context.Context, mgr ctrl.Manager, awsServi
 		setupLog.Error(err, "unable to create webhook", "webhook", "AWSClusterStaticIdentity")
 		os.Exit(1)
 	}
+	if err := (&infrav1.AWSClusterWebIdentity{}).SetupWebhookWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create webhook", "webhook", "AWSClusterWebIdentity")
+		os.Exit(1)
+	}
 	if err := (&infrav1.AWSMachine{}).SetupWebhookWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create webhook", "webhook", "AWSMachine")
 		os.Exit(1)
@@ -509,6 +541,30 @@ func initFlags(fs *pflag.FlagSet) {
 		"Set custom AWS service endpoins in semi-colon separated format: ${SigningRegion1}:${ServiceID1}=${URL},${ServiceID2}=${URL};${SigningRegion2}...",
 	)
 
+	fs.StringVar(&rateLimitsConfigFile,
+		"rate-limits-config-file",
+		"",
+		"Path of a file defining the rate limits of the AWS API calls per service, operation and region. Services it does not configure keep the default limits.",
+	)
+
+	fs.DurationVar(&rateLimitsReloadPeriod,
+		"rate-limits-reload-period",
+		time.Minute,
+		"How often the rate limits configuration file is checked for changes.",
+	)
+
+	fs.IntVar(&metricsMaxClusters,
+		"metrics-max-clusters",
+		awsmetrics.DefaultMaxClusters,
+		"Maximum number of clusters with their own AWS API metrics. The requests of the clusters above it are reported together as \"other\".",
+	)
+
+	fs.IntVar(&metricsMaxErrorCodes,
+		"metrics-max-error-codes",
+		awsmetrics.DefaultMaxErrorCodes,
+		"Maximum number of AWS error codes with their own metrics. The errors with codes above it are reported together as \"other\".",
+	)
+
 	fs.StringVar(
 		&watchFilterValue,
 		"watch-filter",
@@ -20,11 +20,13 @@ import (
 	"net/http"
 
 	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/service/ssm"
 )
 
 // Error singletons for AWS errors.
 const (
+	AllocationIDNotFound              = "InvalidAllocationID.NotFound"
 	AssociationIDNotFound             = "InvalidAssociationID.NotFound"
 	AuthFailure                       = "AuthFailure"
 	BucketAlreadyOwnedByYou           = "BucketAlreadyOwnedByYou"
@@ -32,6 +34,7 @@ const (
 	GatewayNotFound                   = "InvalidGatewayID.NotFound"
 	GroupNotFound                     = "InvalidGroup.NotFound"
 	InternetGatewayNotFound           = "InvalidInternetGatewayID.NotFound"
+	CarrierGatewayNotFound            = "InvalidCarrierGatewayID.NotFound"
 	EgressOnlyInternetGatewayNotFound = "InvalidEgressOnlyInternetGatewayID.NotFound"
 	InUseIPAddress                    = "InvalidIPAddress.InUse"
 	InvalidAccessKeyID                = "InvalidAccessKeyId"
@@ -41,17 +44,22 @@ const (
 	LaunchTemplateNameNotFound        = "InvalidLaunchTemplateName.NotFoundException"
 	LoadBalancerNotFound              = "LoadBalancerNotFound"
 	NATGatewayNotFound                = "InvalidNatGatewayID.NotFound"
+	NetworkACLNotFound                = "InvalidNetworkAclID.NotFound"
 	//nolint:gosec
 	NoCredentialProviders                   = "NoCredentialProviders"
 	NoSuchKey                               = "NoSuchKey"
 	PermissionNotFound                      = "InvalidPermission.NotFound"
+	PlacementGroupInUse                     = "InvalidPlacementGroup.InUse"
+	PlacementGroupNotFound                  = "InvalidPlacementGroup.Unknown"
 	ResourceExists                          = "ResourceExistsException"
 	ResourceNotFound                        = "InvalidResourceID.NotFound"
 	RouteTableNotFound                      = "InvalidRouteTableID.NotFound"
 	SubnetNotFound                          = "InvalidSubnetID.NotFound"
+	TransitGatewayAttachmentNotFound        = "InvalidTransitGatewayAttachmentID.NotFound"
 	UnrecognizedClientException             = "UnrecognizedClientException"
 	UnauthorizedOperation                   = "UnauthorizedOperation"
 	VPCNotFound                             = "InvalidVpcID.NotFound"
+	VPCEndpointNotFound                     = "InvalidVpcEndpointId.NotFound"
 	VPCMissingParameter                     = "MissingParameter"
 	ErrCodeRepositoryAlreadyExistsException = "RepositoryAlreadyExistsException"
 )
@@ -184,6 +192,11 @@ func IsPermissionsError(err error) bool {
 	return false
 }
 
+// IsThrottling tests for the errors AWS returns when it throttles a request, such as Throttling or RequestLimitExceeded.
+func IsThrottling(err error) bool {
+	return request.IsErrorThrottle(err)
+}
+
 // ReasonForError returns the HTTP status for a particular error.
 func ReasonForError(err error) int {
 	if t, ok := err.(*EC2Error); ok {
@@ -96,6 +96,22 @@ func (ec2Filters) VPC(vpcID string) *ec2.Filter {
 	}
 }
 
+// ResourceID returns a filter based on the id of the resource monitored by a flow log.
+func (ec2Filters) ResourceID(resourceID string) *ec2.Filter {
+	return &ec2.Filter{
+		Name:   aws.String("resource-id"),
+		Values: aws.StringSlice([]string{resourceID}),
+	}
+}
+
+// SecurityGroupIDs returns a filter based on the ids of the security groups the rules belong to.
+func (ec2Filters) SecurityGroupIDs(groupIDs ...string) *ec2.Filter {
+	return &ec2.Filter{
+		Name:   aws.String("group-id"),
+		Values: aws.StringSlice(groupIDs),
+	}
+}
+
 // VPCAttachment returns a filter based on the vpc id attached to the resource.
 func (ec2Filters) VPCAttachment(vpcID string) *ec2.Filter {
 	return &ec2.Filter{
@@ -104,6 +120,22 @@ func (ec2Filters) VPCAttachment(vpcID string) *ec2.Filter {
 	}
 }
 
+// InstanceID returns a filter based on the id of the instance an Elastic IP address is associated with.
+func (ec2Filters) InstanceID(instanceID string) *ec2.Filter {
+	return &ec2.Filter{
+		Name:   aws.String("instance-id"),
+		Values: aws.StringSlice([]string{instanceID}),
+	}
+}
+
+// MachineName returns a filter based on the tag holding the namespaced name of the machine a resource belongs to.
+func (ec2Filters) MachineName(namespacedName string) *ec2.Filter {
+	return &ec2.Filter{
+		Name:   aws.String(fmt.Sprintf("tag:%s", infrav1.MachineNameTagKey)),
+		Values: aws.StringSlice([]string{namespacedName}),
+	}
+}
+
 // Available returns a filter based on the state being available.
 func (ec2Filters) Available() *ec2.Filter {
 	return &ec2.Filter{
@@ -144,6 +176,22 @@ func (ec2Filters) SubnetStates(states ...string) *ec2.Filter {
 	}
 }
 
+// TransitGatewayAttachmentStates returns a filter based on the list of states passed in.
+func (ec2Filters) TransitGatewayAttachmentStates(states ...string) *ec2.Filter {
+	return &ec2.Filter{
+		Name:   aws.String("state"),
+		Values: aws.StringSlice(states),
+	}
+}
+
+// CarrierGatewayStates returns a filter based on the list of states passed in.
+func (ec2Filters) CarrierGatewayStates(states ...string) *ec2.Filter {
+	return &ec2.Filter{
+		Name:   aws.String("state"),
+		Values: aws.StringSlice(states),
+	}
+}
+
 func (ec2Filters) AvailabilityZone(zone string) *ec2.Filter {
 	return &ec2.Filter{
 		Name:   aws.String(filterAvailabilityZone),
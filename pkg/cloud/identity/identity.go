@@ -20,19 +20,27 @@ import (
 	"bytes"
 	"crypto/sha256"
 	"encoding/gob"
+	"strconv"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/credentials"
 	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
 	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/sts"
 	"github.com/aws/aws-sdk-go/service/sts/stsiface"
+	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/logger"
 )
 
+// CredentialsExpiryWindow is how long before their expiry temporary credentials are renewed,
+// so that they are not used while they expire.
+const CredentialsExpiryWindow = 5 * time.Minute
+
 // AWSPrincipalTypeProvider defines the interface for AWS Principal Type Provider.
 type AWSPrincipalTypeProvider interface {
 	credentials.Provider
@@ -40,6 +48,9 @@ type AWSPrincipalTypeProvider interface {
 	// for this Principal
 	Hash() (string, error)
 	Name() string
+	// ExpiresAt returns when the last retrieved credentials expire. It returns the zero time
+	// when the credentials do not expire or have not been retrieved yet.
+	ExpiresAt() time.Time
 }
 
 // NewAWSStaticPrincipalTypeProvider will create a new AWSStaticPrincipalTypeProvider from a given AWSClusterStaticIdentity.
@@ -49,11 +60,12 @@ func NewAWSStaticPrincipalTypeProvider(identity *infrav1.AWSClusterStaticIdentit
 	sessionToken := string(secret.Data["SessionToken"])
 
 	return &AWSStaticPrincipalTypeProvider{
-		Principal:       identity,
-		credentials:     credentials.NewStaticCredentials(accessKeyID, secretAccessKey, sessionToken),
-		AccessKeyID:     accessKeyID,
-		SecretAccessKey: secretAccessKey,
-		SessionToken:    sessionToken,
+		Principal:             identity,
+		credentials:           credentials.NewStaticCredentials(accessKeyID, secretAccessKey, sessionToken),
+		AccessKeyID:           accessKeyID,
+		SecretAccessKey:       secretAccessKey,
+		SessionToken:          sessionToken,
+		SecretResourceVersion: secret.ResourceVersion,
 	}
 }
 
@@ -70,6 +82,7 @@ func GetAssumeRoleCredentials(roleIdentityProvider *AWSRolePrincipalTypeProvider
 			p.Policy = aws.String(roleIdentityProvider.Principal.Spec.InlinePolicy)
 		}
 		p.Duration = time.Duration(roleIdentityProvider.Principal.Spec.DurationSeconds) * time.Second
+		p.ExpiryWindow = CredentialsExpiryWindow
 		// For testing
 		if roleIdentityProvider.stsClient != nil {
 			p.Client = roleIdentityProvider.stsClient
@@ -97,6 +110,8 @@ type AWSStaticPrincipalTypeProvider struct {
 	AccessKeyID     string
 	SecretAccessKey string
 	SessionToken    string
+	// SecretResourceVersion is part of the hash, so that a provider is replaced whenever its secret changes.
+	SecretResourceVersion string
 }
 
 // Hash returns the byte encoded AWSStaticPrincipalTypeProvider.
@@ -121,10 +136,16 @@ func (p *AWSStaticPrincipalTypeProvider) Name() string {
 }
 
 // IsExpired checks the expiration state of the AWSStaticPrincipalTypeProvider.
+// Static credentials never expire, a rotated secret results in a new provider instead.
 func (p *AWSStaticPrincipalTypeProvider) IsExpired() bool {
 	return p.credentials.IsExpired()
 }
 
+// ExpiresAt returns the zero time, as static credentials do not expire.
+func (p *AWSStaticPrincipalTypeProvider) ExpiresAt() time.Time {
+	return time.Time{}
+}
+
 // AWSRolePrincipalTypeProvider defines the specs for a AWSPrincipalTypeProvider with a role.
 type AWSRolePrincipalTypeProvider struct {
 	Principal      *infrav1.AWSClusterRoleIdentity
@@ -163,13 +184,190 @@ func (p *AWSRolePrincipalTypeProvider) Retrieve() (credentials.Value, error) {
 		}
 
 		creds := GetAssumeRoleCredentials(p, awsConfig)
+		value, err := creds.Get()
+		if err != nil {
+			// Keep the current credentials, so that their expiry can still be reported.
+			return credentials.Value{}, err
+		}
 		// Update credentials
 		p.credentials = creds
+		return value, nil
 	}
 	return p.credentials.Get()
 }
 
 // IsExpired checks the expiration state of the AWSRolePrincipalTypeProvider.
 func (p *AWSRolePrincipalTypeProvider) IsExpired() bool {
-	return p.credentials.IsExpired()
+	return p.credentials == nil || p.credentials.IsExpired()
+}
+
+// ExpiresAt returns when the credentials of the AWSRolePrincipalTypeProvider expire.
+func (p *AWSRolePrincipalTypeProvider) ExpiresAt() time.Time {
+	return expiresAt(p.credentials)
+}
+
+// NewAWSWebIdentityPrincipalTypeProvider will create a new AWSWebIdentityPrincipalTypeProvider from an AWSClusterWebIdentity.
+// The token is read through tokenFetcher every time the credentials are renewed.
+func NewAWSWebIdentityPrincipalTypeProvider(identity *infrav1.AWSClusterWebIdentity, tokenFetcher stscreds.TokenFetcher, log logger.Wrapper) *AWSWebIdentityPrincipalTypeProvider {
+	return &AWSWebIdentityPrincipalTypeProvider{
+		credentials:  nil,
+		stsClient:    nil,
+		Principal:    identity,
+		tokenFetcher: tokenFetcher,
+		log:          log.WithName("AWSWebIdentityPrincipalTypeProvider"),
+	}
+}
+
+// NewSecretTokenFetcher returns a TokenFetcher reading the web identity token from the given secret,
+// so that a token rotated in the secret is used the next time credentials are renewed.
+func NewSecretTokenFetcher(k8sClient client.Client, key client.ObjectKey) stscreds.TokenFetcher {
+	return &secretTokenFetcher{
+		client: k8sClient,
+		key:    key,
+	}
+}
+
+// GetWebIdentityCredentials will return the Credentials of a given AWSWebIdentityPrincipalTypeProvider.
+func GetWebIdentityCredentials(webIdentityProvider *AWSWebIdentityPrincipalTypeProvider, awsConfig *aws.Config) *credentials.Credentials {
+	stsClient := webIdentityProvider.stsClient
+	// For testing
+	if stsClient == nil {
+		stsClient = sts.New(session.Must(session.NewSession(awsConfig)))
+	}
+
+	return credentials.NewCredentials(&webIdentityRoleProvider{
+		client:       stsClient,
+		role:         webIdentityProvider.Principal.Spec.AWSRoleSpec,
+		tokenFetcher: webIdentityProvider.tokenFetcher,
+	})
+}
+
+// AWSWebIdentityPrincipalTypeProvider defines the specs for a AWSPrincipalTypeProvider exchanging a web identity token.
+type AWSWebIdentityPrincipalTypeProvider struct {
+	Principal    *infrav1.AWSClusterWebIdentity
+	credentials  *credentials.Credentials
+	tokenFetcher stscreds.TokenFetcher
+	log          logger.Wrapper
+	stsClient    stsiface.STSAPI
+	// SecretResourceVersion is part of the hash, so that a provider is replaced whenever the token secret changes.
+	SecretResourceVersion string
+}
+
+// Hash returns the byte encoded AWSWebIdentityPrincipalTypeProvider.
+func (p *AWSWebIdentityPrincipalTypeProvider) Hash() (string, error) {
+	var webIdentityValue bytes.Buffer
+	err := gob.NewEncoder(&webIdentityValue).Encode(p)
+	if err != nil {
+		return "", err
+	}
+	hash := sha256.New()
+	return string(hash.Sum(webIdentityValue.Bytes())), nil
+}
+
+// Name returns the name of the AWSWebIdentityPrincipalTypeProvider.
+func (p *AWSWebIdentityPrincipalTypeProvider) Name() string {
+	return p.Principal.Name
+}
+
+// Retrieve returns the credential values for the AWSWebIdentityPrincipalTypeProvider.
+func (p *AWSWebIdentityPrincipalTypeProvider) Retrieve() (credentials.Value, error) {
+	if p.credentials == nil {
+		p.credentials = GetWebIdentityCredentials(p, aws.NewConfig())
+	}
+	return p.credentials.Get()
+}
+
+// IsExpired checks the expiration state of the AWSWebIdentityPrincipalTypeProvider.
+func (p *AWSWebIdentityPrincipalTypeProvider) IsExpired() bool {
+	return p.credentials == nil || p.credentials.IsExpired()
+}
+
+// ExpiresAt returns when the credentials of the AWSWebIdentityPrincipalTypeProvider expire.
+func (p *AWSWebIdentityPrincipalTypeProvider) ExpiresAt() time.Time {
+	return expiresAt(p.credentials)
+}
+
+// expiresAt returns the actual expiry of credentials renewed CredentialsExpiryWindow ahead of it.
+func expiresAt(creds *credentials.Credentials) time.Time {
+	if creds == nil {
+		return time.Time{}
+	}
+	expiry, err := creds.ExpiresAt()
+	if err != nil || expiry.IsZero() {
+		return time.Time{}
+	}
+	return expiry.Add(CredentialsExpiryWindow)
+}
+
+// webIdentityRoleProvider assumes a role with AssumeRoleWithWebIdentity. Unlike the provider from the SDK, it
+// supports all the fields of an AWSRoleSpec, including inline session policies.
+type webIdentityRoleProvider struct {
+	credentials.Expiry
+
+	client       stsiface.STSAPI
+	role         infrav1.AWSRoleSpec
+	tokenFetcher stscreds.TokenFetcher
+}
+
+// Retrieve fetches the current token and exchanges it for temporary credentials.
+func (p *webIdentityRoleProvider) Retrieve() (credentials.Value, error) {
+	ctx := aws.BackgroundContext()
+	token, err := p.tokenFetcher.FetchToken(ctx)
+	if err != nil {
+		return credentials.Value{}, errors.Wrap(err, "failed to fetch web identity token")
+	}
+
+	sessionName := p.role.SessionName
+	if sessionName == "" {
+		// A session name is required by STS, use the same default as the SDK.
+		sessionName = strconv.FormatInt(time.Now().UnixNano(), 10)
+	}
+
+	input := &sts.AssumeRoleWithWebIdentityInput{
+		RoleArn:          aws.String(p.role.RoleArn),
+		RoleSessionName:  aws.String(sessionName),
+		WebIdentityToken: aws.String(string(token)),
+	}
+	if p.role.DurationSeconds != 0 {
+		input.DurationSeconds = aws.Int64(int64(p.role.DurationSeconds))
+	}
+	if p.role.InlinePolicy != "" {
+		input.Policy = aws.String(p.role.InlinePolicy)
+	}
+	for _, arn := range p.role.PolicyARNs {
+		input.PolicyArns = append(input.PolicyArns, &sts.PolicyDescriptorType{Arn: aws.String(arn)})
+	}
+
+	out, err := p.client.AssumeRoleWithWebIdentityWithContext(ctx, input)
+	if err != nil {
+		return credentials.Value{}, errors.Wrapf(err, "failed to assume role %q with web identity", p.role.RoleArn)
+	}
+
+	p.SetExpiration(aws.TimeValue(out.Credentials.Expiration), CredentialsExpiryWindow)
+	return credentials.Value{
+		AccessKeyID:     aws.StringValue(out.Credentials.AccessKeyId),
+		SecretAccessKey: aws.StringValue(out.Credentials.SecretAccessKey),
+		SessionToken:    aws.StringValue(out.Credentials.SessionToken),
+		ProviderName:    stscreds.WebIdentityProviderName,
+	}, nil
+}
+
+// secretTokenFetcher reads a web identity token from a secret.
+type secretTokenFetcher struct {
+	client client.Client
+	key    client.ObjectKey
+}
+
+// FetchToken returns the token stored in the secret.
+func (f *secretTokenFetcher) FetchToken(ctx credentials.Context) ([]byte, error) {
+	secret := &corev1.Secret{}
+	if err := f.client.Get(ctx, f.key, secret); err != nil {
+		return nil, errors.Wrapf(err, "failed to get secret %s", f.key)
+	}
+
+	token := secret.Data[infrav1.WebIdentityTokenSecretKey]
+	if len(token) == 0 {
+		return nil, errors.Errorf("secret %s does not contain a %s key", f.key, infrav1.WebIdentityTokenSecretKey)
+	}
+	return token, nil
 }
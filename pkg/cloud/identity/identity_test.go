@@ -28,10 +28,15 @@ import (
 	. "github.com/onsi/gomega"
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
 	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/sts/mock_stsiface"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/logger"
 )
 
 func TestAWSStaticPrincipalTypeProvider(t *testing.T) {
@@ -199,3 +204,176 @@ func TestAWSStaticPrincipalTypeProvider(t *testing.T) {
 		})
 	}
 }
+
+func TestAWSWebIdentityPrincipalTypeProvider(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	webIdentity := &infrav1.AWSClusterWebIdentity{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "web-identity",
+		},
+		Spec: infrav1.AWSClusterWebIdentitySpec{
+			AWSRoleSpec: infrav1.AWSRoleSpec{
+				RoleArn:         "arn:aws:iam::123456789012:role/capa",
+				SessionName:     "web-identity-session",
+				DurationSeconds: 900,
+				InlinePolicy:    "{}",
+				PolicyARNs:      []string{"arn:aws:iam::aws:policy/ReadOnlyAccess"},
+			},
+			TokenSecretRef: "web-identity-token",
+		},
+	}
+	secretKey := client.ObjectKey{Name: "web-identity-token", Namespace: "capa-system"}
+	expectedInput := &sts.AssumeRoleWithWebIdentityInput{
+		RoleArn:          aws.String("arn:aws:iam::123456789012:role/capa"),
+		RoleSessionName:  aws.String("web-identity-session"),
+		WebIdentityToken: aws.String("first-token"),
+		DurationSeconds:  aws.Int64(900),
+		Policy:           aws.String("{}"),
+		PolicyArns: []*sts.PolicyDescriptorType{
+			{Arn: aws.String("arn:aws:iam::aws:policy/ReadOnlyAccess")},
+		},
+	}
+
+	testCases := []struct {
+		name      string
+		secret    *corev1.Secret
+		expect    func(m *mock_stsiface.MockSTSAPIMockRecorder)
+		expectErr bool
+		value     credentials.Value
+	}{
+		{
+			name: "Exchanges the token from the secret for credentials",
+			secret: &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: secretKey.Name, Namespace: secretKey.Namespace},
+				Data: map[string][]byte{
+					infrav1.WebIdentityTokenSecretKey: []byte("first-token"),
+				},
+			},
+			expect: func(m *mock_stsiface.MockSTSAPIMockRecorder) {
+				m.AssumeRoleWithWebIdentityWithContext(gomock.Any(), expectedInput).Return(&sts.AssumeRoleWithWebIdentityOutput{
+					Credentials: &sts.Credentials{
+						AccessKeyId:     aws.String("assumedAccessKeyId"),
+						SecretAccessKey: aws.String("assumedSecretAccessKey"),
+						SessionToken:    aws.String("assumedSessionToken"),
+						Expiration:      aws.Time(time.Now().Add(time.Hour)),
+					},
+				}, nil)
+			},
+			value: credentials.Value{
+				AccessKeyID:     "assumedAccessKeyId",
+				SecretAccessKey: "assumedSecretAccessKey",
+				SessionToken:    "assumedSessionToken",
+				ProviderName:    "WebIdentityCredentials",
+			},
+		},
+		{
+			name: "Fails when the secret does not contain a token",
+			secret: &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: secretKey.Name, Namespace: secretKey.Namespace},
+			},
+			expect:    func(m *mock_stsiface.MockSTSAPIMockRecorder) {},
+			expectErr: true,
+		},
+		{
+			name: "Fails when the role cannot be assumed",
+			secret: &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: secretKey.Name, Namespace: secretKey.Namespace},
+				Data: map[string][]byte{
+					infrav1.WebIdentityTokenSecretKey: []byte("first-token"),
+				},
+			},
+			expect: func(m *mock_stsiface.MockSTSAPIMockRecorder) {
+				m.AssumeRoleWithWebIdentityWithContext(gomock.Any(), expectedInput).Return(nil, errors.New("Not authorized to assume role"))
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			stsMock := mock_stsiface.NewMockSTSAPI(mockCtrl)
+			tc.expect(stsMock.EXPECT())
+			k8sClient := fake.NewClientBuilder().WithObjects(tc.secret).Build()
+
+			provider := NewAWSWebIdentityPrincipalTypeProvider(webIdentity, NewSecretTokenFetcher(k8sClient, secretKey), logger.NewLogger(klog.Background()))
+			provider.stsClient = stsMock
+
+			value, err := provider.Retrieve()
+			if tc.expectErr {
+				g.Expect(err).ToNot(BeNil())
+				return
+			}
+
+			g.Expect(err).To(BeNil())
+			g.Expect(value).To(Equal(tc.value))
+			g.Expect(provider.Name()).To(Equal("web-identity"))
+		})
+	}
+}
+
+func TestAWSStaticPrincipalTypeProviderHashTracksSecret(t *testing.T) {
+	g := NewWithT(t)
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{ResourceVersion: "1"},
+		Data: map[string][]byte{
+			"AccessKeyID":     []byte("static-AccessKeyID"),
+			"SecretAccessKey": []byte("static-SecretAccessKey"),
+		},
+	}
+	provider := NewAWSStaticPrincipalTypeProvider(&infrav1.AWSClusterStaticIdentity{}, secret)
+	hash, err := provider.Hash()
+	g.Expect(err).To(BeNil())
+	g.Expect(provider.ExpiresAt().IsZero()).To(BeTrue())
+
+	secret.ResourceVersion = "2"
+	updatedHash, err := NewAWSStaticPrincipalTypeProvider(&infrav1.AWSClusterStaticIdentity{}, secret).Hash()
+	g.Expect(err).To(BeNil())
+	g.Expect(updatedHash).ToNot(Equal(hash))
+}
+
+func TestAWSRolePrincipalTypeProviderExpiry(t *testing.T) {
+	g := NewWithT(t)
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	stsMock := mock_stsiface.NewMockSTSAPI(mockCtrl)
+	roleProvider := &AWSRolePrincipalTypeProvider{
+		Principal: &infrav1.AWSClusterRoleIdentity{
+			Spec: infrav1.AWSClusterRoleIdentitySpec{
+				AWSRoleSpec: infrav1.AWSRoleSpec{
+					RoleArn:     "arn:aws:iam::123456789012:role/capa",
+					SessionName: "role-provider-session",
+				},
+			},
+		},
+		stsClient: stsMock,
+	}
+	g.Expect(roleProvider.IsExpired()).To(BeTrue())
+	g.Expect(roleProvider.ExpiresAt().IsZero()).To(BeTrue())
+
+	// Credentials expiring within the expiry window are due for renewal.
+	expiration := time.Now().Add(CredentialsExpiryWindow / 2).Truncate(time.Second)
+	stsMock.EXPECT().AssumeRoleWithContext(gomock.Any(), gomock.Any()).Return(&sts.AssumeRoleOutput{
+		Credentials: &sts.Credentials{
+			AccessKeyId:     aws.String("assumedAccessKeyId"),
+			SecretAccessKey: aws.String("assumedSecretAccessKey"),
+			SessionToken:    aws.String("assumedSessionToken"),
+			Expiration:      aws.Time(expiration),
+		},
+	}, nil)
+	_, err := roleProvider.Retrieve()
+	g.Expect(err).To(BeNil())
+	g.Expect(roleProvider.IsExpired()).To(BeTrue())
+	g.Expect(roleProvider.ExpiresAt()).To(BeTemporally("==", expiration))
+
+	// The current credentials are kept when their renewal fails.
+	stsMock.EXPECT().AssumeRoleWithContext(gomock.Any(), gomock.Any()).Return(nil, errors.New("Not authorized to assume role"))
+	_, err = roleProvider.Retrieve()
+	g.Expect(err).ToNot(BeNil())
+	g.Expect(roleProvider.ExpiresAt()).To(BeTemporally("==", expiration))
+}
@@ -0,0 +1,206 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/awserrors"
+)
+
+const (
+	metricClusterRequestCountKey    = "api_cluster_requests_total"
+	metricClusterRequestDurationKey = "api_cluster_request_duration_seconds"
+	metricClusterRetriesKey         = "api_cluster_retries_total"
+	metricClusterThrottledKey       = "api_cluster_throttled_requests_total"
+	metricClusterErrorsKey          = "api_cluster_errors_total"
+	metricClusterLabel              = "cluster"
+	metricIdentityLabel             = "identity"
+
+	// OtherLabelValue replaces the label values above the limit of a cardinality guard.
+	OtherLabelValue = "other"
+	// DefaultMaxClusters is the default maximum number of clusters with their own metrics.
+	DefaultMaxClusters = 500
+	// DefaultMaxErrorCodes is the default maximum number of error codes with their own metrics.
+	DefaultMaxErrorCodes = 100
+)
+
+var (
+	clusterLabels = []string{metricClusterLabel, metricIdentityLabel, metricServiceLabel, metricRegionLabel}
+
+	awsClusterRequestCount = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Subsystem: metricAWSSubsystem,
+		Name:      metricClusterRequestCountKey,
+		Help:      "Total number of AWS requests made for a cluster",
+	}, clusterLabels)
+	awsClusterRequestDurationSeconds = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Subsystem: metricAWSSubsystem,
+		Name:      metricClusterRequestDurationKey,
+		Help:      "Latency of HTTP requests to AWS made for a cluster",
+	}, clusterLabels)
+	awsClusterRetries = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Subsystem: metricAWSSubsystem,
+		Name:      metricClusterRetriesKey,
+		Help:      "Total number of retries of AWS requests made for a cluster",
+	}, clusterLabels)
+	awsClusterThrottled = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Subsystem: metricAWSSubsystem,
+		Name:      metricClusterThrottledKey,
+		Help:      "Total number of AWS requests made for a cluster that were throttled",
+	}, append(clusterLabels, metricOperationLabel))
+	awsClusterErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Subsystem: metricAWSSubsystem,
+		Name:      metricClusterErrorsKey,
+		Help:      "Total number of AWS requests made for a cluster that failed, by error code",
+	}, append(clusterLabels, metricErrorCodeLabel))
+
+	clusterGuard   = newLabelGuard(DefaultMaxClusters)
+	errorCodeGuard = newLabelGuard(DefaultMaxErrorCodes)
+)
+
+func init() {
+	metrics.Registry.MustRegister(awsClusterRequestCount)
+	metrics.Registry.MustRegister(awsClusterRequestDurationSeconds)
+	metrics.Registry.MustRegister(awsClusterRetries)
+	metrics.Registry.MustRegister(awsClusterThrottled)
+	metrics.Registry.MustRegister(awsClusterErrors)
+}
+
+// labelGuard bounds the cardinality of a label. Once the limit of distinct values is reached, new values are
+// replaced by OtherLabelValue.
+type labelGuard struct {
+	lock   sync.Mutex
+	limit  int
+	values map[string]struct{}
+}
+
+func newLabelGuard(limit int) *labelGuard {
+	return &labelGuard{
+		limit:  limit,
+		values: map[string]struct{}{},
+	}
+}
+
+func (g *labelGuard) value(v string) string {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+
+	if _, ok := g.values[v]; ok {
+		return v
+	}
+	if len(g.values) >= g.limit {
+		return OtherLabelValue
+	}
+	g.values[v] = struct{}{}
+	return v
+}
+
+func (g *labelGuard) forget(v string) bool {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+
+	_, ok := g.values[v]
+	delete(g.values, v)
+	return ok
+}
+
+func (g *labelGuard) setLimit(limit int) {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+
+	g.limit = limit
+}
+
+// SetCardinalityLimits sets the maximum number of clusters and error codes with their own metrics. The metrics
+// of the clusters and error codes above the limits are reported with the "other" label value.
+func SetCardinalityLimits(maxClusters, maxErrorCodes int) {
+	clusterGuard.setLimit(maxClusters)
+	errorCodeGuard.setLimit(maxErrorCodes)
+}
+
+// CaptureClusterRequestMetrics will monitor and capture the metrics of the requests made for a cluster with an
+// identity, including their errors. It is meant for the Complete handlers, so a request is counted once, after
+// its last attempt.
+func CaptureClusterRequestMetrics(cluster, identity string) func(r *request.Request) {
+	return func(r *request.Request) {
+		duration := time.Since(r.Time)
+		labels := clusterRequestLabels(r, cluster, identity)
+		awsClusterRequestCount.With(labels).Inc()
+		awsClusterRequestDurationSeconds.With(labels).Observe(duration.Seconds())
+		if r.Error == nil {
+			return
+		}
+
+		errorCode, ok := awserrors.Code(r.Error)
+		if !ok {
+			errorCode = "internal"
+		}
+		awsClusterErrors.With(withLabel(labels, metricErrorCodeLabel, errorCodeGuard.value(errorCode))).Inc()
+	}
+}
+
+// CaptureClusterAttemptMetrics will monitor and capture the retries and throttling of the requests made for a
+// cluster with an identity. It is meant for the CompleteAttempt handlers, so every attempt is seen.
+func CaptureClusterAttemptMetrics(cluster, identity string) func(r *request.Request) {
+	return func(r *request.Request) {
+		labels := clusterRequestLabels(r, cluster, identity)
+		if r.RetryCount > 0 {
+			awsClusterRetries.With(labels).Inc()
+		}
+		if r.Error != nil && awserrors.IsThrottling(r.Error) {
+			awsClusterThrottled.With(withLabel(labels, metricOperationLabel, r.Operation.Name)).Inc()
+		}
+	}
+}
+
+func clusterRequestLabels(r *request.Request, cluster, identity string) prometheus.Labels {
+	return prometheus.Labels{
+		metricClusterLabel:  clusterGuard.value(cluster),
+		metricIdentityLabel: identity,
+		metricServiceLabel:  endpointToService(r.ClientInfo.Endpoint),
+		metricRegionLabel:   aws.StringValue(r.Config.Region),
+	}
+}
+
+// DeleteClusterMetrics deletes the metrics of a cluster, once it is deleted, and lets another cluster have its
+// own metrics in its place.
+func DeleteClusterMetrics(cluster string) {
+	if !clusterGuard.forget(cluster) {
+		return
+	}
+	labels := prometheus.Labels{metricClusterLabel: cluster}
+	awsClusterRequestCount.DeletePartialMatch(labels)
+	awsClusterRequestDurationSeconds.DeletePartialMatch(labels)
+	awsClusterRetries.DeletePartialMatch(labels)
+	awsClusterThrottled.DeletePartialMatch(labels)
+	awsClusterErrors.DeletePartialMatch(labels)
+}
+
+func withLabel(labels prometheus.Labels, name, value string) prometheus.Labels {
+	extended := make(prometheus.Labels, len(labels)+1)
+	for k, v := range labels {
+		extended[k] = v
+	}
+	extended[name] = value
+	return extended
+}
@@ -0,0 +1,89 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/client/metadata"
+	"github.com/aws/aws-sdk-go/aws/request"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+func TestLabelGuard(t *testing.T) {
+	g := NewWithT(t)
+
+	guard := newLabelGuard(2)
+	g.Expect(guard.value("a")).To(Equal("a"))
+	g.Expect(guard.value("b")).To(Equal("b"))
+	g.Expect(guard.value("c")).To(Equal(OtherLabelValue))
+	g.Expect(guard.value("a")).To(Equal("a"))
+
+	// A forgotten value makes room for a new one.
+	g.Expect(guard.forget("a")).To(BeTrue())
+	g.Expect(guard.forget("a")).To(BeFalse())
+	g.Expect(guard.value("c")).To(Equal("c"))
+	g.Expect(guard.value("a")).To(Equal(OtherLabelValue))
+}
+
+func TestCaptureClusterRequestMetrics(t *testing.T) {
+	g := NewWithT(t)
+
+	cluster := "metrics-test/cluster"
+	t.Cleanup(func() { DeleteClusterMetrics(cluster) })
+	captureRequest := CaptureClusterRequestMetrics(cluster, "AWSClusterRoleIdentity/role")
+	captureAttempt := CaptureClusterAttemptMetrics(cluster, "AWSClusterRoleIdentity/role")
+	newRequest := func(retryCount int, err error) *request.Request {
+		return &request.Request{
+			ClientInfo: metadata.ClientInfo{Endpoint: "https://ec2.us-east-1.amazonaws.com"},
+			Config:     aws.Config{Region: aws.String("us-east-1")},
+			Operation:  &request.Operation{Name: "RunInstances"},
+			Time:       time.Now(),
+			RetryCount: retryCount,
+			Error:      err,
+		}
+	}
+
+	// A successful request.
+	captureAttempt(newRequest(0, nil))
+	captureRequest(newRequest(0, nil))
+	// A throttled request that failed on its retry.
+	captureAttempt(newRequest(0, awserr.New("RequestLimitExceeded", "Request limit exceeded.", nil)))
+	captureAttempt(newRequest(1, awserr.New("InvalidParameterValue", "Invalid value.", nil)))
+	captureRequest(newRequest(1, awserr.New("InvalidParameterValue", "Invalid value.", nil)))
+
+	labels := prometheus.Labels{
+		metricClusterLabel:  cluster,
+		metricIdentityLabel: "AWSClusterRoleIdentity/role",
+		metricServiceLabel:  "ec2",
+		metricRegionLabel:   "us-east-1",
+	}
+	g.Expect(testutil.ToFloat64(awsClusterRequestCount.With(labels))).To(Equal(2.0))
+	g.Expect(testutil.ToFloat64(awsClusterRetries.With(labels))).To(Equal(1.0))
+	g.Expect(testutil.ToFloat64(awsClusterThrottled.With(withLabel(labels, metricOperationLabel, "RunInstances")))).To(Equal(1.0))
+	g.Expect(testutil.ToFloat64(awsClusterErrors.With(withLabel(labels, metricErrorCodeLabel, "RequestLimitExceeded")))).To(Equal(0.0))
+	g.Expect(testutil.ToFloat64(awsClusterErrors.With(withLabel(labels, metricErrorCodeLabel, "InvalidParameterValue")))).To(Equal(1.0))
+
+	DeleteClusterMetrics(cluster)
+	g.Expect(testutil.CollectAndCount(awsClusterRequestCount)).To(Equal(0))
+	g.Expect(testutil.CollectAndCount(awsClusterErrors)).To(Equal(0))
+}
@@ -36,6 +36,8 @@ import (
 	"github.com/aws/aws-sdk-go/service/iam/iamiface"
 	"github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi"
 	"github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi/resourcegroupstaggingapiiface"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/aws/aws-sdk-go/service/route53/route53iface"
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/aws/aws-sdk-go/service/s3/s3iface"
 	"github.com/aws/aws-sdk-go/service/secretsmanager"
@@ -147,6 +149,22 @@ func NewResourgeTaggingClient(scopeUser cloud.ScopeUsage, session cloud.Session,
 	return resourceTagging
 }
 
+// NewRoute53Client creates a new Route53 API client for a given session.
+func NewRoute53Client(scopeUser cloud.ScopeUsage, session cloud.Session, logger logger.Wrapper, target runtime.Object) route53iface.Route53API {
+	route53Client := route53.New(session.Session(), aws.NewConfig().WithLogLevel(awslogs.GetAWSLogLevel(logger.GetLogger())).WithLogger(awslogs.NewWrapLogr(logger.GetLogger())))
+	route53Client.Handlers.Build.PushFrontNamed(getUserAgentHandler())
+	if session.ServiceLimiter(route53.ServiceID) != nil {
+		route53Client.Handlers.Sign.PushFront(session.ServiceLimiter(route53.ServiceID).LimitRequest)
+	}
+	route53Client.Handlers.CompleteAttempt.PushFront(awsmetrics.CaptureRequestMetrics(scopeUser.ControllerName()))
+	if session.ServiceLimiter(route53.ServiceID) != nil {
+		route53Client.Handlers.CompleteAttempt.PushFront(session.ServiceLimiter(route53.ServiceID).ReviewResponse)
+	}
+	route53Client.Handlers.Complete.PushBack(recordAWSPermissionsIssue(target))
+
+	return route53Client
+}
+
 // NewSecretsManagerClient creates a new Secrets API client for a given session..
 func NewSecretsManagerClient(scopeUser cloud.ScopeUsage, session cloud.Session, logger logger.Wrapper, target runtime.Object) secretsmanageriface.SecretsManagerAPI {
 	secretsClient := secretsmanager.New(session.Session(), aws.NewConfig().WithLogLevel(awslogs.GetAWSLogLevel(logger.GetLogger())).WithLogger(awslogs.NewWrapLogr(logger.GetLogger())))
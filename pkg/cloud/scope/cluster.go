@@ -71,17 +71,21 @@ func NewClusterScope(params ClusterScopeParams) (*ClusterScope, error) {
 		tagUnmanagedNetworkResources: params.TagUnmanagedNetworkResources,
 	}
 
-	session, serviceLimiters, err := sessionForClusterWithRegion(params.Client, clusterScope, params.AWSCluster.Spec.Region, params.Endpoints, params.Logger)
+	// The patch helper is created first, so that the identity conditions set while creating the session are persisted.
+	helper, err := patch.NewHelper(params.AWSCluster, params.Client)
 	if err != nil {
-		return nil, errors.Errorf("failed to create aws session: %v", err)
+		return nil, errors.Wrap(err, "failed to init patch helper")
 	}
+	clusterScope.patchHelper = helper
 
-	helper, err := patch.NewHelper(params.AWSCluster, params.Client)
+	session, serviceLimiters, err := sessionForClusterWithRegion(params.Client, clusterScope, params.AWSCluster.Spec.Region, params.Endpoints, params.Logger)
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to init patch helper")
+		if patchErr := clusterScope.PatchObject(); patchErr != nil {
+			return nil, errors.Errorf("failed to create aws session: %v, failed to patch conditions: %v", err, patchErr)
+		}
+		return nil, errors.Errorf("failed to create aws session: %v", err)
 	}
 
-	clusterScope.patchHelper = helper
 	clusterScope.session = session
 	clusterScope.serviceLimiters = serviceLimiters
 
@@ -142,16 +146,61 @@ func (s *ClusterScope) SecurityGroupOverrides() map[infrav1.SecurityGroupRole]st
 	return s.AWSCluster.Spec.NetworkSpec.SecurityGroupOverrides
 }
 
+// SecurityGroupEgressRules returns the egress rules of the cluster security groups, keyed by role.
+func (s *ClusterScope) SecurityGroupEgressRules() map[infrav1.SecurityGroupRole]infrav1.EgressRules {
+	return s.AWSCluster.Spec.NetworkSpec.SecurityGroupEgressRules
+}
+
+// AdditionalIngressRules returns the additional ingress rules of the cluster security groups, keyed by role.
+func (s *ClusterScope) AdditionalIngressRules() map[infrav1.SecurityGroupRole]infrav1.IngressRules {
+	return s.AWSCluster.Spec.NetworkSpec.AdditionalIngressRules
+}
+
 // SecurityGroups returns the cluster security groups as a map, it creates the map if empty.
 func (s *ClusterScope) SecurityGroups() map[infrav1.SecurityGroupRole]infrav1.SecurityGroup {
 	return s.AWSCluster.Status.Network.SecurityGroups
 }
 
+// PlacementGroups returns the placement groups declared in the cluster spec.
+func (s *ClusterScope) PlacementGroups() []infrav1.PlacementGroup {
+	return s.AWSCluster.Spec.PlacementGroups
+}
+
+// ManagedPlacementGroups returns the names of the placement groups created for the cluster.
+func (s *ClusterScope) ManagedPlacementGroups() []string {
+	return s.AWSCluster.Status.PlacementGroups
+}
+
+// SetManagedPlacementGroups sets the names of the placement groups created for the cluster.
+func (s *ClusterScope) SetManagedPlacementGroups(names []string) {
+	s.AWSCluster.Status.PlacementGroups = names
+}
+
 // SecondaryCidrBlock is currently unimplemented for non-managed clusters.
 func (s *ClusterScope) SecondaryCidrBlock() *string {
 	return nil
 }
 
+// TransitGateway returns the transit gateway to attach the cluster VPC to.
+func (s *ClusterScope) TransitGateway() *infrav1.TransitGatewaySpec {
+	return s.AWSCluster.Spec.NetworkSpec.TransitGateway
+}
+
+// ElasticIPPool returns the source of the Elastic IP addresses of the cluster.
+func (s *ClusterScope) ElasticIPPool() *infrav1.ElasticIPPool {
+	return s.AWSCluster.Spec.NetworkSpec.ElasticIPPool
+}
+
+// AdditionalRoutes returns the additional routes of the public and private subnets.
+func (s *ClusterScope) AdditionalRoutes() *infrav1.AdditionalRoutes {
+	return s.AWSCluster.Spec.NetworkSpec.AdditionalRoutes
+}
+
+// NetworkACLs returns the network ACLs of the public and private subnets.
+func (s *ClusterScope) NetworkACLs() *infrav1.NetworkACLs {
+	return s.AWSCluster.Spec.NetworkSpec.NetworkACLs
+}
+
 // Name returns the CAPI cluster name.
 func (s *ClusterScope) Name() string {
 	return s.Cluster.Name
@@ -183,6 +232,20 @@ func (s *ClusterScope) ControlPlaneLoadBalancer() *infrav1.AWSLoadBalancerSpec {
 	return s.AWSCluster.Spec.ControlPlaneLoadBalancer
 }
 
+// ControlPlaneLoadBalancers returns the primary and, if defined, the secondary AWSLoadBalancerSpec.
+func (s *ClusterScope) ControlPlaneLoadBalancers() []*infrav1.AWSLoadBalancerSpec {
+	lbs := []*infrav1.AWSLoadBalancerSpec{s.AWSCluster.Spec.ControlPlaneLoadBalancer}
+	if s.AWSCluster.Spec.SecondaryControlPlaneLoadBalancer != nil {
+		lbs = append(lbs, s.AWSCluster.Spec.SecondaryControlPlaneLoadBalancer)
+	}
+	return lbs
+}
+
+// ControlPlaneDNS returns the Route53 record for the control plane endpoint.
+func (s *ClusterScope) ControlPlaneDNS() *infrav1.ControlPlaneDNS {
+	return s.AWSCluster.Spec.ControlPlaneDNS
+}
+
 // ControlPlaneLoadBalancerScheme returns the Classic ELB scheme (public or internal facing).
 func (s *ClusterScope) ControlPlaneLoadBalancerScheme() infrav1.ELBScheme {
 	if s.ControlPlaneLoadBalancer() != nil && s.ControlPlaneLoadBalancer().Scheme != nil {
@@ -244,6 +307,10 @@ func (s *ClusterScope) PatchObject() error {
 		}
 	}
 
+	if len(s.PlacementGroups()) > 0 {
+		applicableConditions = append(applicableConditions, infrav1.PlacementGroupsReadyCondition)
+	}
+
 	conditions.SetSummary(s.AWSCluster,
 		conditions.WithConditions(applicableConditions...),
 		conditions.WithStepCounterIf(s.AWSCluster.ObjectMeta.DeletionTimestamp.IsZero()),
@@ -262,10 +329,12 @@ func (s *ClusterScope) PatchObject() error {
 			infrav1.NatGatewaysReadyCondition,
 			infrav1.RouteTablesReadyCondition,
 			infrav1.ClusterSecurityGroupsReadyCondition,
+			infrav1.PlacementGroupsReadyCondition,
 			infrav1.BastionHostReadyCondition,
 			infrav1.LoadBalancerReadyCondition,
 			infrav1.PrincipalUsageAllowedCondition,
 			infrav1.PrincipalCredentialRetrievedCondition,
+			infrav1.PrincipalCredentialValidCondition,
 		}})
 }
 
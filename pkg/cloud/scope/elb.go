@@ -41,6 +41,9 @@ type ELBScope interface {
 	// ControlPlaneLoadBalancer returns the AWSLoadBalancerSpec
 	ControlPlaneLoadBalancer() *infrav1.AWSLoadBalancerSpec
 
+	// ControlPlaneLoadBalancers returns both primary and secondary AWSLoadBalancerSpecs
+	ControlPlaneLoadBalancers() []*infrav1.AWSLoadBalancerSpec
+
 	// ControlPlaneLoadBalancerScheme returns the Classic ELB scheme (public or internal facing)
 	ControlPlaneLoadBalancerScheme() infrav1.ELBScheme
 
@@ -49,4 +52,10 @@ type ELBScope interface {
 
 	// ControlPlaneEndpoint returns AWSCluster control plane endpoint
 	ControlPlaneEndpoint() clusterv1.APIEndpoint
+
+	// ElasticIPPool returns the optional source of the Elastic IP addresses of the cluster.
+	ElasticIPPool() *infrav1.ElasticIPPool
+
+	// ControlPlaneDNS returns the Route53 record for the control plane endpoint
+	ControlPlaneDNS() *infrav1.ControlPlaneDNS
 }
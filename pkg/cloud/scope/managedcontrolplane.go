@@ -95,20 +95,24 @@ func NewManagedControlPlaneScope(params ManagedControlPlaneScopeParams) (*Manage
 		enableIAM:                    params.EnableIAM,
 		tagUnmanagedNetworkResources: params.TagUnmanagedNetworkResources,
 	}
+	// The patch helper is created first, so that the identity conditions set while creating the session are persisted.
+	helper, err := patch.NewHelper(params.ControlPlane, params.Client)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to init patch helper")
+	}
+	managedScope.patchHelper = helper
+
 	session, serviceLimiters, err := sessionForClusterWithRegion(params.Client, managedScope, params.ControlPlane.Spec.Region, params.Endpoints, params.Logger)
 	if err != nil {
+		if patchErr := managedScope.PatchObject(); patchErr != nil {
+			return nil, errors.Errorf("failed to create aws session: %v, failed to patch conditions: %v", err, patchErr)
+		}
 		return nil, errors.Errorf("failed to create aws session: %v", err)
 	}
 
 	managedScope.session = session
 	managedScope.serviceLimiters = serviceLimiters
 
-	helper, err := patch.NewHelper(params.ControlPlane, params.Client)
-	if err != nil {
-		return nil, errors.Wrap(err, "failed to init patch helper")
-	}
-
-	managedScope.patchHelper = helper
 	return managedScope, nil
 }
 
@@ -197,11 +201,41 @@ func (s *ManagedControlPlaneScope) SecondaryCidrBlock() *string {
 	return s.ControlPlane.Spec.SecondaryCidrBlock
 }
 
+// TransitGateway returns the transit gateway to attach the cluster VPC to.
+func (s *ManagedControlPlaneScope) TransitGateway() *infrav1.TransitGatewaySpec {
+	return s.ControlPlane.Spec.NetworkSpec.TransitGateway
+}
+
+// ElasticIPPool returns the source of the Elastic IP addresses of the cluster.
+func (s *ManagedControlPlaneScope) ElasticIPPool() *infrav1.ElasticIPPool {
+	return s.ControlPlane.Spec.NetworkSpec.ElasticIPPool
+}
+
+// AdditionalRoutes returns the additional routes of the public and private subnets.
+func (s *ManagedControlPlaneScope) AdditionalRoutes() *infrav1.AdditionalRoutes {
+	return s.ControlPlane.Spec.NetworkSpec.AdditionalRoutes
+}
+
+// NetworkACLs returns the network ACLs of the public and private subnets.
+func (s *ManagedControlPlaneScope) NetworkACLs() *infrav1.NetworkACLs {
+	return s.ControlPlane.Spec.NetworkSpec.NetworkACLs
+}
+
 // SecurityGroupOverrides returns the security groups that are overrides in the ControlPlane spec.
 func (s *ManagedControlPlaneScope) SecurityGroupOverrides() map[infrav1.SecurityGroupRole]string {
 	return s.ControlPlane.Spec.NetworkSpec.SecurityGroupOverrides
 }
 
+// SecurityGroupEgressRules returns the egress rules of the security groups in the ControlPlane spec, keyed by role.
+func (s *ManagedControlPlaneScope) SecurityGroupEgressRules() map[infrav1.SecurityGroupRole]infrav1.EgressRules {
+	return s.ControlPlane.Spec.NetworkSpec.SecurityGroupEgressRules
+}
+
+// AdditionalIngressRules returns the additional ingress rules of the security groups in the ControlPlane spec, keyed by role.
+func (s *ManagedControlPlaneScope) AdditionalIngressRules() map[infrav1.SecurityGroupRole]infrav1.IngressRules {
+	return s.ControlPlane.Spec.NetworkSpec.AdditionalIngressRules
+}
+
 // Name returns the CAPI cluster name.
 func (s *ManagedControlPlaneScope) Name() string {
 	return s.Cluster.Name
@@ -247,6 +281,9 @@ func (s *ManagedControlPlaneScope) PatchObject() error {
 			ekscontrolplanev1.EKSControlPlaneReadyCondition,
 			ekscontrolplanev1.EKSControlPlaneUpdatingCondition,
 			ekscontrolplanev1.IAMControlPlaneRolesReadyCondition,
+			infrav1.PrincipalUsageAllowedCondition,
+			infrav1.PrincipalCredentialRetrievedCondition,
+			infrav1.PrincipalCredentialValidCondition,
 		}})
 }
 
@@ -411,6 +448,16 @@ func (s *ManagedControlPlaneScope) ControlPlaneLoadBalancer() *infrav1.AWSLoadBa
 	return nil
 }
 
+// ControlPlaneLoadBalancers returns the AWSLoadBalancerSpecs.
+func (s *ManagedControlPlaneScope) ControlPlaneLoadBalancers() []*infrav1.AWSLoadBalancerSpec {
+	return nil
+}
+
+// ControlPlaneDNS returns the Route53 record for the control plane endpoint.
+func (s *ManagedControlPlaneScope) ControlPlaneDNS() *infrav1.ControlPlaneDNS {
+	return nil
+}
+
 // Partition returns the cluster partition.
 func (s *ManagedControlPlaneScope) Partition() string {
 	if s.ControlPlane.Spec.Partition == "" {
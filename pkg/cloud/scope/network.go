@@ -39,6 +39,14 @@ type NetworkScope interface {
 	SecurityGroups() map[infrav1.SecurityGroupRole]infrav1.SecurityGroup
 	// SecondaryCidrBlock returns the optional secondary CIDR block to use for pod IPs
 	SecondaryCidrBlock() *string
+	// TransitGateway returns the optional transit gateway to attach the VPC to.
+	TransitGateway() *infrav1.TransitGatewaySpec
+	// ElasticIPPool returns the optional source of the Elastic IP addresses of the cluster.
+	ElasticIPPool() *infrav1.ElasticIPPool
+	// AdditionalRoutes returns the optional additional routes of the public and private subnets.
+	AdditionalRoutes() *infrav1.AdditionalRoutes
+	// NetworkACLs returns the optional network ACLs of the public and private subnets.
+	NetworkACLs() *infrav1.NetworkACLs
 
 	// Bastion returns the bastion details for the cluster.
 	Bastion() *infrav1.Bastion
@@ -20,16 +20,14 @@ import (
 	"context"
 	"fmt"
 	"sync"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
 	"github.com/aws/aws-sdk-go/aws/endpoints"
 	"github.com/aws/aws-sdk-go/aws/session"
-	"github.com/aws/aws-sdk-go/service/ec2"
-	"github.com/aws/aws-sdk-go/service/elb"
-	"github.com/aws/aws-sdk-go/service/elbv2"
-	"github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi"
-	"github.com/aws/aws-sdk-go/service/secretsmanager"
 	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
@@ -39,13 +37,13 @@ import (
 	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/identity"
+	awsmetrics "sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/metrics"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/throttle"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/logger"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/util/system"
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
 	"sigs.k8s.io/cluster-api/util"
 	"sigs.k8s.io/cluster-api/util/conditions"
-	"sigs.k8s.io/cluster-api/util/patch"
 )
 
 const (
@@ -63,8 +61,15 @@ var sessionCache sync.Map
 var providerCache sync.Map
 
 type sessionCacheEntry struct {
-	session         *session.Session
-	serviceLimiters throttle.ServiceLimiters
+	session *session.Session
+	// account identifies the AWS account of the session, whose service limiters are shared by all the sessions
+	// using the account in the same region.
+	account string
+	// providers are the credential providers the session was created with, and providerHashes their hashes.
+	// The session is only reused while the hashes of the cluster's providers match, so that a change of
+	// identity, or of the secret backing it, results in a new session.
+	providers      []identity.AWSPrincipalTypeProvider
+	providerHashes []string
 }
 
 // SessionInterface is the interface for AWSCluster and ManagedCluster to be used to get session using identityRef.
@@ -74,7 +79,7 @@ var SessionInterface interface {
 func sessionForRegion(region string, endpoint []ServiceEndpoint) (*session.Session, throttle.ServiceLimiters, error) {
 	if s, ok := sessionCache.Load(region); ok {
 		entry := s.(*sessionCacheEntry)
-		return entry.session, entry.serviceLimiters, nil
+		return entry.session, throttle.SharedServiceLimiters(entry.account, region), nil
 	}
 
 	resolver := func(service, region string, optFns ...func(*endpoints.Options)) (endpoints.ResolvedEndpoint, error) {
@@ -96,12 +101,11 @@ func sessionForRegion(region string, endpoint []ServiceEndpoint) (*session.Sessi
 		return nil, nil, err
 	}
 
-	sl := newServiceLimiters()
 	sessionCache.Store(region, &sessionCacheEntry{
-		session:         ns,
-		serviceLimiters: sl,
+		session: ns,
+		account: throttle.DefaultAccount,
 	})
-	return ns, sl, nil
+	return ns, throttle.SharedServiceLimiters(throttle.DefaultAccount, region), nil
 }
 
 func sessionForClusterWithRegion(k8sClient client.Client, clusterScoper cloud.ClusterScoper, region string, endpoint []ServiceEndpoint, log logger.Wrapper) (*session.Session, throttle.ServiceLimiters, error) {
@@ -127,7 +131,7 @@ func sessionForClusterWithRegion(k8sClient client.Client, clusterScoper cloud.Cl
 		return nil, nil, errors.Wrap(err, "Failed to get providers for cluster")
 	}
 
-	isChanged := false
+	providerHashes := make([]string, len(providers))
 	awsProviders := make([]credentials.Provider, len(providers))
 	for i, provider := range providers {
 		// load an existing matching providers from the cache if such a providers exists
@@ -139,17 +143,30 @@ func sessionForClusterWithRegion(k8sClient client.Client, clusterScoper cloud.Cl
 		if ok {
 			provider = cachedProvider.(identity.AWSPrincipalTypeProvider)
 		} else {
-			isChanged = true
 			// add this provider to the cache
 			providerCache.Store(providerHash, provider)
 		}
+		providers[i] = provider
+		providerHashes[i] = providerHash
 		awsProviders[i] = provider.(credentials.Provider)
 	}
 
-	if !isChanged {
-		if s, ok := sessionCache.Load(getSessionName(region, clusterScoper)); ok {
-			entry := s.(*sessionCacheEntry)
-			return entry.session, entry.serviceLimiters, nil
+	sessionName := getSessionName(region, clusterScoper)
+	if s, ok := sessionCache.Load(sessionName); ok {
+		entry := s.(*sessionCacheEntry)
+		if cmp.Equal(entry.providerHashes, providerHashes) {
+			if err := reconcilePrincipalCredentialExpiry(clusterScoper, entry.providers); err != nil {
+				sessionCache.Delete(sessionName)
+				return nil, nil, err
+			}
+			return entry.session, throttle.SharedServiceLimiters(entry.account, region), nil
+		}
+		log.Debug("Identity of the cluster changed, creating a new AWS Session")
+		// The providers that are no longer used by this session are superseded, stop caching them.
+		for _, hash := range entry.providerHashes {
+			if !containsString(providerHashes, hash) {
+				providerCache.Delete(hash)
+			}
 		}
 	}
 	awsConfig := &aws.Config{
@@ -162,13 +179,18 @@ func sessionForClusterWithRegion(k8sClient client.Client, clusterScoper cloud.Cl
 		_, err := providers[0].Retrieve()
 		if err != nil {
 			conditions.MarkUnknown(clusterScoper.InfraCluster(), infrav1.PrincipalCredentialRetrievedCondition, infrav1.CredentialProviderBuildFailedReason, err.Error())
+			conditions.MarkFalse(clusterScoper.InfraCluster(), infrav1.PrincipalCredentialValidCondition, infrav1.PrincipalCredentialInvalidReason, clusterv1.ConditionSeverityError, err.Error())
 
 			// delete the existing session from cache. Otherwise, we give back a defective session on next method invocation with same cluster scope
-			sessionCache.Delete(getSessionName(region, clusterScoper))
+			sessionCache.Delete(sessionName)
 
 			return nil, nil, errors.Wrap(err, "Failed to retrieve identity credentials")
 		}
 		awsConfig = awsConfig.WithCredentials(credentials.NewChainCredentials(awsProviders))
+		conditions.MarkTrue(clusterScoper.InfraCluster(), infrav1.PrincipalCredentialValidCondition)
+	} else {
+		// The controller credentials are used, their expiry is handled by the SDK.
+		conditions.Delete(clusterScoper.InfraCluster(), infrav1.PrincipalCredentialValidCondition)
 	}
 
 	conditions.MarkTrue(clusterScoper.InfraCluster(), infrav1.PrincipalCredentialRetrievedCondition)
@@ -177,77 +199,104 @@ func sessionForClusterWithRegion(k8sClient client.Client, clusterScoper cloud.Cl
 	if err != nil {
 		return nil, nil, errors.Wrap(err, "Failed to create a new AWS session")
 	}
-	sl := newServiceLimiters()
-	sessionCache.Store(getSessionName(region, clusterScoper), &sessionCacheEntry{
-		session:         ns,
-		serviceLimiters: sl,
+	// The handlers of the session are copied to every client created from it.
+	clusterName, identityName := clusterMetricsName(clusterScoper), identityMetricsName(clusterScoper.IdentityRef())
+	ns.Handlers.Complete.PushBack(awsmetrics.CaptureClusterRequestMetrics(clusterName, identityName))
+	ns.Handlers.CompleteAttempt.PushBack(awsmetrics.CaptureClusterAttemptMetrics(clusterName, identityName))
+	account := limiterAccount(providers)
+	sessionCache.Store(sessionName, &sessionCacheEntry{
+		session:        ns,
+		account:        account,
+		providers:      providers,
+		providerHashes: providerHashes,
 	})
 
-	return ns, sl, nil
+	return ns, throttle.SharedServiceLimiters(account, region), nil
 }
 
-func getSessionName(region string, clusterScoper cloud.ClusterScoper) string {
-	return fmt.Sprintf("%s-%s-%s", region, clusterScoper.InfraClusterName(), clusterScoper.Namespace())
+// reconcilePrincipalCredentialExpiry renews the credentials of a cached session once they are due for renewal,
+// and reports their state with the PrincipalCredentialValidCondition. Credentials that could not be renewed
+// are reported as expiring until they actually expire, after which an error is returned.
+func reconcilePrincipalCredentialExpiry(clusterScoper cloud.ClusterScoper, providers []identity.AWSPrincipalTypeProvider) error {
+	if len(providers) == 0 {
+		conditions.Delete(clusterScoper.InfraCluster(), infrav1.PrincipalCredentialValidCondition)
+		return nil
+	}
+
+	provider := providers[0]
+	if !provider.IsExpired() {
+		conditions.MarkTrue(clusterScoper.InfraCluster(), infrav1.PrincipalCredentialValidCondition)
+		return nil
+	}
+
+	expiresAt := provider.ExpiresAt()
+	if _, err := provider.Retrieve(); err != nil {
+		if !expiresAt.IsZero() && time.Now().Before(expiresAt) {
+			conditions.MarkFalse(clusterScoper.InfraCluster(), infrav1.PrincipalCredentialValidCondition, infrav1.PrincipalCredentialExpiringReason, clusterv1.ConditionSeverityWarning,
+				"Credentials of %s expire at %s and could not be renewed: %v", provider.Name(), expiresAt.Format(time.RFC3339), err)
+			return nil
+		}
+		conditions.MarkFalse(clusterScoper.InfraCluster(), infrav1.PrincipalCredentialValidCondition, infrav1.PrincipalCredentialInvalidReason, clusterv1.ConditionSeverityError,
+			"Credentials of %s expired and could not be renewed: %v", provider.Name(), err)
+		return errors.Wrap(err, "Failed to renew identity credentials")
+	}
+
+	conditions.MarkTrue(clusterScoper.InfraCluster(), infrav1.PrincipalCredentialValidCondition)
+	return nil
 }
 
-func newServiceLimiters() throttle.ServiceLimiters {
-	return throttle.ServiceLimiters{
-		ec2.ServiceID:                      newEC2ServiceLimiter(),
-		elb.ServiceID:                      newGenericServiceLimiter(),
-		elbv2.ServiceID:                    newGenericServiceLimiter(),
-		resourcegroupstaggingapi.ServiceID: newGenericServiceLimiter(),
-		secretsmanager.ServiceID:           newGenericServiceLimiter(),
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
 	}
+	return false
+}
+
+// DeleteClusterMetrics deletes the metrics of the AWS requests made for a cluster, once it is deleted.
+func DeleteClusterMetrics(clusterScoper cloud.ClusterScoper) {
+	awsmetrics.DeleteClusterMetrics(clusterMetricsName(clusterScoper))
 }
 
-func newGenericServiceLimiter() *throttle.ServiceLimiter {
-	return &throttle.ServiceLimiter{
-		{
-			Operation:  throttle.NewMultiOperationMatch("Describe", "Get", "List"),
-			RefillRate: 20.0,
-			Burst:      100,
-		},
-		{
-			Operation:  ".*",
-			RefillRate: 5.0,
-			Burst:      200,
-		},
+func clusterMetricsName(clusterScoper cloud.ClusterScoper) string {
+	return fmt.Sprintf("%s/%s", clusterScoper.Namespace(), clusterScoper.Name())
+}
+
+func identityMetricsName(ref *infrav1.AWSIdentityReference) string {
+	if ref == nil {
+		// The controller credentials are used.
+		return "default"
 	}
+	return fmt.Sprintf("%s/%s", ref.Kind, ref.Name)
 }
 
-func newEC2ServiceLimiter() *throttle.ServiceLimiter {
-	return &throttle.ServiceLimiter{
-		{
-			Operation:  throttle.NewMultiOperationMatch("Describe", "Get"),
-			RefillRate: 20.0,
-			Burst:      100,
-		},
-		{
-			Operation: throttle.NewMultiOperationMatch(
-				"AuthorizeSecurityGroupIngress",
-				"CancelSpotInstanceRequests",
-				"CreateKeyPair",
-				"RequestSpotInstances",
-			),
-			RefillRate: 20.0,
-			Burst:      100,
-		},
-		{
-			Operation:  "RunInstances",
-			RefillRate: 2.0,
-			Burst:      5,
-		},
-		{
-			Operation:  "StartInstances",
-			RefillRate: 2.0,
-			Burst:      5,
-		},
-		{
-			Operation:  ".*",
-			RefillRate: 5.0,
-			Burst:      200,
-		},
+func getSessionName(region string, clusterScoper cloud.ClusterScoper) string {
+	return fmt.Sprintf("%s-%s-%s", region, clusterScoper.InfraClusterName(), clusterScoper.Namespace())
+}
+
+// limiterAccount returns the account whose service limiters are used by a session with the given providers.
+// The account of a role is read from its ARN, while static identities are assumed to belong to
+// an account of their own, as finding their account would require an API call.
+func limiterAccount(providers []identity.AWSPrincipalTypeProvider) string {
+	if len(providers) == 0 {
+		return throttle.DefaultAccount
+	}
+
+	var roleARN string
+	switch provider := providers[0].(type) {
+	case *identity.AWSRolePrincipalTypeProvider:
+		roleARN = provider.Principal.Spec.RoleArn
+	case *identity.AWSWebIdentityPrincipalTypeProvider:
+		roleARN = provider.Principal.Spec.RoleArn
+	default:
+		return "identity/" + providers[0].Name()
+	}
+	parsed, err := arn.Parse(roleARN)
+	if err != nil {
+		return "identity/" + providers[0].Name()
 	}
+	return parsed.AccountID
 }
 
 func buildProvidersForRef(
@@ -281,6 +330,12 @@ func buildProvidersForRef(
 			return providers, err
 		}
 		providers = append(providers, provider)
+	case infrav1.ClusterWebIdentityKind:
+		provider, err := buildAWSClusterWebIdentity(ctx, identityObjectKey, k8sClient, clusterScoper, log)
+		if err != nil {
+			return providers, err
+		}
+		providers = append(providers, provider)
 	case infrav1.ClusterRoleIdentityKind:
 		roleIdentity := &infrav1.AWSClusterRoleIdentity{}
 		err := k8sClient.Get(ctx, identityObjectKey, roleIdentity)
@@ -346,40 +401,86 @@ func buildAWSClusterStaticIdentity(ctx context.Context, identityObjectKey client
 	if err != nil {
 		return nil, err
 	}
-	secret := &corev1.Secret{}
-	err = k8sClient.Get(ctx, client.ObjectKey{Name: staticPrincipal.Spec.SecretRef, Namespace: system.GetManagerNamespace()}, secret)
+	secret, err := getIdentitySecret(ctx, k8sClient, staticPrincipal.Spec.SecretRef, infrav1.ClusterStaticIdentityKind, staticPrincipal)
 	if err != nil {
 		return nil, err
 	}
 
-	// Set ClusterStaticPrincipal as Secret's owner reference for 'clusterctl move'.
-	patchHelper, err := patch.NewHelper(secret, k8sClient)
+	canUse, err := isClusterPermittedToUsePrincipal(k8sClient, staticPrincipal.Spec.AllowedNamespaces, clusterScoper.Namespace())
 	if err != nil {
-		return nil, errors.Wrapf(err, "failed to init patch helper for secret name:%s namespace:%s", secret.Name, secret.Namespace)
+		return nil, err
+	}
+	if !canUse {
+		setPrincipalUsageNotAllowedCondition(infrav1.ClusterStaticIdentityKind, identityObjectKey, clusterScoper)
+		return nil, errors.Errorf(notPermittedError, infrav1.ClusterStaticIdentityKind, identityObjectKey.Name)
 	}
+	setPrincipalUsageAllowedCondition(clusterScoper)
 
-	secret.OwnerReferences = util.EnsureOwnerRef(secret.OwnerReferences, metav1.OwnerReference{
-		APIVersion: infrav1.GroupVersion.String(),
-		Kind:       string(infrav1.ClusterStaticIdentityKind),
-		Name:       staticPrincipal.Name,
-		UID:        staticPrincipal.UID,
-	})
+	return identity.NewAWSStaticPrincipalTypeProvider(staticPrincipal, secret), nil
+}
 
-	if err := patchHelper.Patch(ctx, secret); err != nil {
-		return nil, errors.Wrapf(err, "failed to patch secret name:%s namespace:%s", secret.Name, secret.Namespace)
+func buildAWSClusterWebIdentity(ctx context.Context, identityObjectKey client.ObjectKey, k8sClient client.Client, clusterScoper cloud.ClusterScoper, log logger.Wrapper) (*identity.AWSWebIdentityPrincipalTypeProvider, error) {
+	webIdentity := &infrav1.AWSClusterWebIdentity{}
+	err := k8sClient.Get(ctx, identityObjectKey, webIdentity)
+	if err != nil {
+		return nil, err
 	}
 
-	canUse, err := isClusterPermittedToUsePrincipal(k8sClient, staticPrincipal.Spec.AllowedNamespaces, clusterScoper.Namespace())
+	canUse, err := isClusterPermittedToUsePrincipal(k8sClient, webIdentity.Spec.AllowedNamespaces, clusterScoper.Namespace())
 	if err != nil {
 		return nil, err
 	}
 	if !canUse {
-		setPrincipalUsageNotAllowedCondition(infrav1.ClusterStaticIdentityKind, identityObjectKey, clusterScoper)
-		return nil, errors.Errorf(notPermittedError, infrav1.ClusterStaticIdentityKind, identityObjectKey.Name)
+		setPrincipalUsageNotAllowedCondition(infrav1.ClusterWebIdentityKind, identityObjectKey, clusterScoper)
+		return nil, errors.Errorf(notPermittedError, infrav1.ClusterWebIdentityKind, identityObjectKey.Name)
 	}
 	setPrincipalUsageAllowedCondition(clusterScoper)
 
-	return identity.NewAWSStaticPrincipalTypeProvider(staticPrincipal, secret), nil
+	if webIdentity.Spec.TokenSecretRef != "" {
+		secret, err := getIdentitySecret(ctx, k8sClient, webIdentity.Spec.TokenSecretRef, infrav1.ClusterWebIdentityKind, webIdentity)
+		if err != nil {
+			return nil, err
+		}
+		provider := identity.NewAWSWebIdentityPrincipalTypeProvider(webIdentity, identity.NewSecretTokenFetcher(k8sClient, client.ObjectKeyFromObject(secret)), log)
+		provider.SecretResourceVersion = secret.ResourceVersion
+		return provider, nil
+	}
+
+	tokenFile := webIdentity.Spec.TokenFile
+	if tokenFile == "" {
+		tokenFile = infrav1.DefaultWebIdentityTokenFile
+	}
+	return identity.NewAWSWebIdentityPrincipalTypeProvider(webIdentity, stscreds.FetchTokenPath(tokenFile), log), nil
+}
+
+// getIdentitySecret returns the named secret from the controller namespace and sets the identity as its owner,
+// so that 'clusterctl move' moves the secret together with the identity.
+func getIdentitySecret(ctx context.Context, k8sClient client.Client, name string, kind infrav1.AWSIdentityKind, owner client.Object) (*corev1.Secret, error) {
+	secret := &corev1.Secret{}
+	err := k8sClient.Get(ctx, client.ObjectKey{Name: name, Namespace: system.GetManagerNamespace()}, secret)
+	if err != nil {
+		return nil, err
+	}
+
+	ownerRef := metav1.OwnerReference{
+		APIVersion: infrav1.GroupVersion.String(),
+		Kind:       string(kind),
+		Name:       owner.GetName(),
+		UID:        owner.GetUID(),
+	}
+	if util.HasOwnerRef(secret.OwnerReferences, ownerRef) {
+		return secret, nil
+	}
+
+	// Patch the secret directly rather than with a patch helper, so that the returned secret has the resourceVersion
+	// set by the patch. Providers are cached by resourceVersion, a stale one would result in a new session.
+	original := secret.DeepCopy()
+	secret.OwnerReferences = util.EnsureOwnerRef(secret.OwnerReferences, ownerRef)
+	if err := k8sClient.Patch(ctx, secret, client.MergeFrom(original)); err != nil {
+		return nil, errors.Wrapf(err, "failed to patch secret name:%s namespace:%s", secret.Name, secret.Namespace)
+	}
+
+	return secret, nil
 }
 
 func buildAWSClusterControllerIdentity(ctx context.Context, identityObjectKey client.ObjectKey, k8sClient client.Client, clusterScoper cloud.ClusterScoper) error {
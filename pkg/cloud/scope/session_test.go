@@ -19,7 +19,10 @@ package scope
 import (
 	"context"
 	"testing"
+	"time"
 
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/service/ec2"
 	. "github.com/onsi/gomega"
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
@@ -32,9 +35,11 @@ import (
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/identity"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/throttle"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/logger"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/util/system"
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/conditions"
 )
 
 func TestIsClusterPermittedToUsePrincipal(t *testing.T) {
@@ -477,6 +482,116 @@ func TestPrincipalParsing(t *testing.T) {
 				}
 			},
 		},
+		{
+			name: "Can get a session for a web identity Principal",
+			awsCluster: infrav1.AWSCluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "cluster4",
+					Namespace: "default",
+				},
+				TypeMeta: metav1.TypeMeta{
+					APIVersion: infrav1.GroupVersion.String(),
+					Kind:       "AWSCluster",
+				},
+				Spec: infrav1.AWSClusterSpec{
+					IdentityRef: &infrav1.AWSIdentityReference{
+						Name: "web-identity",
+						Kind: infrav1.ClusterWebIdentityKind,
+					},
+				},
+			},
+			setup: func(t *testing.T, c client.Client) {
+				t.Helper()
+
+				identity := &infrav1.AWSClusterWebIdentity{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "web-identity",
+					},
+					Spec: infrav1.AWSClusterWebIdentitySpec{
+						AWSClusterIdentitySpec: infrav1.AWSClusterIdentitySpec{
+							AllowedNamespaces: &infrav1.AllowedNamespaces{},
+						},
+						AWSRoleSpec: infrav1.AWSRoleSpec{
+							RoleArn: "role-arn",
+						},
+						TokenSecretRef: "web-identity-token",
+					},
+				}
+				identity.SetGroupVersionKind(infrav1.GroupVersion.WithKind("AWSClusterWebIdentity"))
+				err := c.Create(context.Background(), identity)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				tokenSecret := &corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "web-identity-token",
+						Namespace: system.GetManagerNamespace(),
+					},
+					Data: map[string][]byte{
+						infrav1.WebIdentityTokenSecretKey: []byte("token"),
+					},
+				}
+				tokenSecret.SetGroupVersionKind(schema.GroupVersionKind{Group: "", Kind: "Secret", Version: "v1"})
+				err = c.Create(context.Background(), tokenSecret)
+				if err != nil {
+					t.Fatal(err)
+				}
+			},
+			expect: func(providers []identity.AWSPrincipalTypeProvider) {
+				if len(providers) != 1 {
+					t.Fatalf("Expected 1 providers, got %v", len(providers))
+				}
+				provider := providers[0]
+				p, ok := provider.(*identity.AWSWebIdentityPrincipalTypeProvider)
+				if !ok {
+					t.Fatal("Expected providers to be of type AWSWebIdentityPrincipalTypeProvider")
+				}
+				if p.Principal.Spec.RoleArn != "role-arn" {
+					t.Fatal(errors.Errorf("Expected Web Identity Provider ARN to be 'role-arn', got '%s'", p.Principal.Spec.RoleArn))
+				}
+			},
+		},
+		{
+			name: "Cannot use a web identity Principal from a namespace that is not allowed",
+			awsCluster: infrav1.AWSCluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "cluster5",
+					Namespace: "default",
+				},
+				TypeMeta: metav1.TypeMeta{
+					APIVersion: infrav1.GroupVersion.String(),
+					Kind:       "AWSCluster",
+				},
+				Spec: infrav1.AWSClusterSpec{
+					IdentityRef: &infrav1.AWSIdentityReference{
+						Name: "web-identity",
+						Kind: infrav1.ClusterWebIdentityKind,
+					},
+				},
+			},
+			setup: func(t *testing.T, c client.Client) {
+				t.Helper()
+
+				identity := &infrav1.AWSClusterWebIdentity{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "web-identity",
+					},
+					Spec: infrav1.AWSClusterWebIdentitySpec{
+						AWSRoleSpec: infrav1.AWSRoleSpec{
+							RoleArn: "role-arn",
+						},
+						TokenFile: infrav1.DefaultWebIdentityTokenFile,
+					},
+				}
+				identity.SetGroupVersionKind(infrav1.GroupVersion.WithKind("AWSClusterWebIdentity"))
+				err := c.Create(context.Background(), identity)
+				if err != nil {
+					t.Fatal(err)
+				}
+			},
+			expectError: true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -503,3 +618,241 @@ func TestPrincipalParsing(t *testing.T) {
 		})
 	}
 }
+
+func TestSessionIsRecreatedWhenIdentitySecretChanges(t *testing.T) {
+	g := NewWithT(t)
+
+	scheme, err := setupScheme()
+	g.Expect(err).To(BeNil())
+	k8sClient := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+	staticIdentity := &infrav1.AWSClusterStaticIdentity{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "rotated-identity",
+		},
+		Spec: infrav1.AWSClusterStaticIdentitySpec{
+			SecretRef: "rotated-credentials-secret",
+			AWSClusterIdentitySpec: infrav1.AWSClusterIdentitySpec{
+				AllowedNamespaces: &infrav1.AllowedNamespaces{},
+			},
+		},
+	}
+	g.Expect(k8sClient.Create(context.Background(), staticIdentity)).To(Succeed())
+
+	credentialsSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "rotated-credentials-secret",
+			Namespace: system.GetManagerNamespace(),
+		},
+		Data: map[string][]byte{
+			"AccessKeyID":     []byte("1234567890"),
+			"SecretAccessKey": []byte("abcdefghijklmnop"),
+		},
+	}
+	g.Expect(k8sClient.Create(context.Background(), credentialsSecret)).To(Succeed())
+
+	clusterScope := &ClusterScope{
+		Cluster: &clusterv1.Cluster{},
+		AWSCluster: &infrav1.AWSCluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "rotated-cluster",
+				Namespace: "default",
+			},
+			Spec: infrav1.AWSClusterSpec{
+				IdentityRef: &infrav1.AWSIdentityReference{
+					Name: "rotated-identity",
+					Kind: infrav1.ClusterStaticIdentityKind,
+				},
+			},
+		},
+	}
+	log := logger.NewLogger(klog.Background())
+
+	firstSession, _, err := sessionForClusterWithRegion(k8sClient, clusterScope, "us-east-1", nil, log)
+	g.Expect(err).To(BeNil())
+	cachedSession, _, err := sessionForClusterWithRegion(k8sClient, clusterScope, "us-east-1", nil, log)
+	g.Expect(err).To(BeNil())
+	g.Expect(cachedSession).To(BeIdenticalTo(firstSession))
+
+	g.Expect(k8sClient.Get(context.Background(), client.ObjectKeyFromObject(credentialsSecret), credentialsSecret)).To(Succeed())
+	credentialsSecret.Data["AccessKeyID"] = []byte("0987654321")
+	g.Expect(k8sClient.Update(context.Background(), credentialsSecret)).To(Succeed())
+
+	rotatedSession, _, err := sessionForClusterWithRegion(k8sClient, clusterScope, "us-east-1", nil, log)
+	g.Expect(err).To(BeNil())
+	g.Expect(rotatedSession).ToNot(BeIdenticalTo(firstSession))
+	value, err := rotatedSession.Config.Credentials.Get()
+	g.Expect(err).To(BeNil())
+	g.Expect(value.AccessKeyID).To(Equal("0987654321"))
+	g.Expect(conditions.IsTrue(clusterScope.AWSCluster, infrav1.PrincipalCredentialValidCondition)).To(BeTrue())
+}
+
+type fakePrincipalTypeProvider struct {
+	expired     bool
+	expiresAt   time.Time
+	retrieveErr error
+}
+
+func (p *fakePrincipalTypeProvider) Retrieve() (credentials.Value, error) {
+	if p.retrieveErr != nil {
+		return credentials.Value{}, p.retrieveErr
+	}
+	p.expired = false
+	p.expiresAt = time.Now().Add(time.Hour)
+	return credentials.Value{}, nil
+}
+
+func (p *fakePrincipalTypeProvider) IsExpired() bool {
+	return p.expired
+}
+
+func (p *fakePrincipalTypeProvider) ExpiresAt() time.Time {
+	return p.expiresAt
+}
+
+func (p *fakePrincipalTypeProvider) Hash() (string, error) {
+	return "fake", nil
+}
+
+func (p *fakePrincipalTypeProvider) Name() string {
+	return "fake"
+}
+
+func TestReconcilePrincipalCredentialExpiry(t *testing.T) {
+	testCases := []struct {
+		name           string
+		provider       *fakePrincipalTypeProvider
+		expectErr      bool
+		expectedStatus corev1.ConditionStatus
+		expectedReason string
+	}{
+		{
+			name: "Valid credentials are not renewed",
+			provider: &fakePrincipalTypeProvider{
+				expiresAt:   time.Now().Add(time.Hour),
+				retrieveErr: errors.New("should not be called"),
+			},
+			expectedStatus: corev1.ConditionTrue,
+		},
+		{
+			name: "Credentials due for renewal are renewed",
+			provider: &fakePrincipalTypeProvider{
+				expired:   true,
+				expiresAt: time.Now().Add(time.Minute),
+			},
+			expectedStatus: corev1.ConditionTrue,
+		},
+		{
+			name: "Credentials that could not be renewed are reported as expiring",
+			provider: &fakePrincipalTypeProvider{
+				expired:     true,
+				expiresAt:   time.Now().Add(time.Minute),
+				retrieveErr: errors.New("AccessDenied"),
+			},
+			expectedStatus: corev1.ConditionFalse,
+			expectedReason: infrav1.PrincipalCredentialExpiringReason,
+		},
+		{
+			name: "Expired credentials that could not be renewed are invalid",
+			provider: &fakePrincipalTypeProvider{
+				expired:     true,
+				expiresAt:   time.Now().Add(-time.Minute),
+				retrieveErr: errors.New("AccessDenied"),
+			},
+			expectErr:      true,
+			expectedStatus: corev1.ConditionFalse,
+			expectedReason: infrav1.PrincipalCredentialInvalidReason,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			clusterScope := &ClusterScope{AWSCluster: &infrav1.AWSCluster{}}
+			err := reconcilePrincipalCredentialExpiry(clusterScope, []identity.AWSPrincipalTypeProvider{tc.provider})
+			if tc.expectErr {
+				g.Expect(err).ToNot(BeNil())
+			} else {
+				g.Expect(err).To(BeNil())
+			}
+
+			condition := conditions.Get(clusterScope.AWSCluster, infrav1.PrincipalCredentialValidCondition)
+			g.Expect(condition).ToNot(BeNil())
+			g.Expect(condition.Status).To(Equal(tc.expectedStatus))
+			g.Expect(condition.Reason).To(Equal(tc.expectedReason))
+		})
+	}
+}
+
+func TestSessionServiceLimitersFollowRateLimitsConfig(t *testing.T) {
+	g := NewWithT(t)
+	region := "rate-limits-test-region"
+	t.Cleanup(func() {
+		throttle.SetConfig(nil)
+		sessionCache.Delete(region)
+	})
+
+	_, limiters, err := sessionForRegion(region, nil)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	// The limiters are shared while the configuration does not change.
+	_, cachedLimiters, err := sessionForRegion(region, nil)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(cachedLimiters[ec2.ServiceID]).To(BeIdenticalTo(limiters[ec2.ServiceID]))
+
+	throttle.SetConfig(&throttle.Config{
+		Services: map[string][]throttle.OperationLimit{
+			ec2.ServiceID: {{RefillRate: 1, Burst: 1}},
+		},
+	})
+	_, reloadedLimiters, err := sessionForRegion(region, nil)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(*reloadedLimiters[ec2.ServiceID]).To(HaveLen(1))
+	g.Expect((*reloadedLimiters[ec2.ServiceID])[0].Burst).To(Equal(1))
+}
+
+func TestLimiterAccount(t *testing.T) {
+	testCases := []struct {
+		name      string
+		providers []identity.AWSPrincipalTypeProvider
+		expected  string
+	}{
+		{
+			name:     "controller credentials",
+			expected: throttle.DefaultAccount,
+		},
+		{
+			name: "role identity",
+			providers: []identity.AWSPrincipalTypeProvider{
+				&identity.AWSRolePrincipalTypeProvider{
+					Principal: &infrav1.AWSClusterRoleIdentity{
+						ObjectMeta: metav1.ObjectMeta{Name: "role"},
+						Spec: infrav1.AWSClusterRoleIdentitySpec{
+							AWSRoleSpec: infrav1.AWSRoleSpec{RoleArn: "arn:aws:iam::123456789012:role/capa"},
+						},
+					},
+				},
+			},
+			expected: "123456789012",
+		},
+		{
+			name: "static identity",
+			providers: []identity.AWSPrincipalTypeProvider{
+				&identity.AWSStaticPrincipalTypeProvider{
+					Principal: &infrav1.AWSClusterStaticIdentity{
+						ObjectMeta: metav1.ObjectMeta{Name: "static"},
+					},
+				},
+			},
+			expected: "identity/static",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(limiterAccount(tc.providers)).To(Equal(tc.expected))
+		})
+	}
+}
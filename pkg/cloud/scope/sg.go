@@ -34,6 +34,12 @@ type SGScope interface {
 	// SecurityGroupOverrides returns the security groups that are used as overrides in the cluster spec
 	SecurityGroupOverrides() map[infrav1.SecurityGroupRole]string
 
+	// SecurityGroupEgressRules returns the egress rules of the security groups, keyed by role.
+	SecurityGroupEgressRules() map[infrav1.SecurityGroupRole]infrav1.EgressRules
+
+	// AdditionalIngressRules returns the additional ingress rules of the security groups, keyed by role.
+	AdditionalIngressRules() map[infrav1.SecurityGroupRole]infrav1.IngressRules
+
 	// VPC returns the cluster VPC.
 	VPC() *infrav1.VPCSpec
 
@@ -45,4 +51,7 @@ type SGScope interface {
 
 	// ControlPlaneLoadBalancer returns the load balancer settings that are requested.
 	ControlPlaneLoadBalancer() *infrav1.AWSLoadBalancerSpec
+
+	// ControlPlaneLoadBalancers returns both primary and secondary load balancer settings that are requested.
+	ControlPlaneLoadBalancers() []*infrav1.AWSLoadBalancerSpec
 }
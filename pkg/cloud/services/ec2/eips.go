@@ -0,0 +1,212 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/types"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/awserrors"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/filter"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/wait"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/tags"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/record"
+)
+
+// ReconcileElasticIP makes sure the Elastic IP address configured for the machine is associated with its instance,
+// and returns the public IP address of the Elastic IP.
+func (s *Service) ReconcileElasticIP(scope *scope.MachineScope, instanceID string) (string, error) {
+	spec := scope.AWSMachine.Spec.ElasticIP
+	if spec == nil {
+		return "", nil
+	}
+
+	out, err := s.EC2Client.DescribeAddresses(&ec2.DescribeAddressesInput{
+		Filters: []*ec2.Filter{filter.EC2.InstanceID(instanceID)},
+	})
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to describe Elastic IPs of instance %q", instanceID)
+	}
+	if len(out.Addresses) > 0 {
+		return aws.StringValue(out.Addresses[0].PublicIp), nil
+	}
+
+	address, err := s.getOrAllocateMachineAddress(scope)
+	if err != nil {
+		return "", err
+	}
+
+	if _, err := s.EC2Client.AssociateAddress(&ec2.AssociateAddressInput{
+		AllocationId:       address.AllocationId,
+		InstanceId:         aws.String(instanceID),
+		AllowReassociation: aws.Bool(false),
+	}); err != nil {
+		record.Warnf(scope.AWSMachine, "FailedAssociateEIP", "Failed to associate Elastic IP %q with instance %q: %v", aws.StringValue(address.AllocationId), instanceID, err)
+		return "", errors.Wrapf(err, "failed to associate Elastic IP %q with instance %q", aws.StringValue(address.AllocationId), instanceID)
+	}
+
+	record.Eventf(scope.AWSMachine, "SuccessfulAssociateEIP", "Associated Elastic IP %q with instance %q", aws.StringValue(address.PublicIp), instanceID)
+	return aws.StringValue(address.PublicIp), nil
+}
+
+// getOrAllocateMachineAddress returns an unassociated address for the machine. Addresses previously allocated for
+// the machine are preferred, then the pre-allocated addresses and the addresses matching the filters of the
+// specification. A new address is only allocated when no existing address is expected to be reused, or when a
+// public IPv4 pool to allocate from is set.
+func (s *Service) getOrAllocateMachineAddress(scope *scope.MachineScope) (*ec2.Address, error) {
+	spec := scope.AWSMachine.Spec.ElasticIP
+
+	owned, err := s.EC2Client.DescribeAddresses(&ec2.DescribeAddressesInput{
+		Filters: s.machineOwnedAddressFilters(scope),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to describe Elastic IPs allocated for the machine")
+	}
+	if address := firstUnassociatedAddress(owned.Addresses); address != nil {
+		return address, nil
+	}
+
+	if len(spec.AllocationIDs) > 0 {
+		out, err := s.EC2Client.DescribeAddresses(&ec2.DescribeAddressesInput{
+			AllocationIds: aws.StringSlice(spec.AllocationIDs),
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to describe pre-allocated Elastic IPs")
+		}
+		// Addresses are consumed in the order they are listed.
+		byID := make(map[string]*ec2.Address, len(out.Addresses))
+		for _, address := range out.Addresses {
+			byID[aws.StringValue(address.AllocationId)] = address
+		}
+		for _, id := range spec.AllocationIDs {
+			if address, ok := byID[id]; ok && address.AssociationId == nil {
+				return address, nil
+			}
+		}
+	}
+
+	if len(spec.Filters) > 0 {
+		filters := make([]*ec2.Filter, 0, len(spec.Filters))
+		for _, f := range spec.Filters {
+			filters = append(filters, &ec2.Filter{Name: aws.String(f.Name), Values: aws.StringSlice(f.Values)})
+		}
+		out, err := s.EC2Client.DescribeAddresses(&ec2.DescribeAddressesInput{Filters: filters})
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to describe Elastic IPs matching filters")
+		}
+		if address := firstUnassociatedAddress(out.Addresses); address != nil {
+			return address, nil
+		}
+	}
+
+	if (len(spec.AllocationIDs) > 0 || len(spec.Filters) > 0) && spec.PublicIpv4Pool == nil {
+		record.Warnf(scope.AWSMachine, "FailedAllocateEIP", "No unassociated Elastic IP available for machine %q", scope.Name())
+		return nil, awserrors.NewFailedDependency("no unassociated Elastic IP available")
+	}
+
+	return s.allocateMachineAddress(scope)
+}
+
+func (s *Service) allocateMachineAddress(scope *scope.MachineScope) (*ec2.Address, error) {
+	params := infrav1.BuildParams{
+		ClusterName: s.scope.KubernetesClusterName(),
+		Lifecycle:   infrav1.ResourceLifecycleOwned,
+		Name:        aws.String(scope.Name()),
+		Role:        aws.String(scope.Role()),
+		Additional:  scope.AdditionalTags(),
+	}.WithMachineName(scope.Machine)
+
+	input := &ec2.AllocateAddressInput{
+		Domain:            aws.String("vpc"),
+		PublicIpv4Pool:    scope.AWSMachine.Spec.ElasticIP.PublicIpv4Pool,
+		TagSpecifications: []*ec2.TagSpecification{tags.BuildParamsToTagSpecification(ec2.ResourceTypeElasticIp, params)},
+	}
+
+	out, err := s.EC2Client.AllocateAddress(input)
+	if err != nil {
+		record.Warnf(scope.AWSMachine, "FailedAllocateEIP", "Failed to allocate Elastic IP: %v", err)
+		return nil, errors.Wrap(err, "failed to allocate Elastic IP")
+	}
+
+	record.Eventf(scope.AWSMachine, "SuccessfulAllocateEIP", "Allocated Elastic IP %q", aws.StringValue(out.PublicIp))
+	return &ec2.Address{
+		AllocationId: out.AllocationId,
+		PublicIp:     out.PublicIp,
+	}, nil
+}
+
+// ReleaseElasticIP releases the Elastic IP addresses allocated for the machine. Addresses reused from the
+// pre-allocated addresses or from filters are left untouched.
+func (s *Service) ReleaseElasticIP(scope *scope.MachineScope) error {
+	out, err := s.EC2Client.DescribeAddresses(&ec2.DescribeAddressesInput{
+		Filters: s.machineOwnedAddressFilters(scope),
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to describe Elastic IPs allocated for the machine")
+	}
+
+	for _, address := range out.Addresses {
+		if address.AssociationId != nil {
+			if _, err := s.EC2Client.DisassociateAddress(&ec2.DisassociateAddressInput{
+				AssociationId: address.AssociationId,
+			}); err != nil {
+				if code, _ := awserrors.Code(errors.Cause(err)); code != awserrors.AssociationIDNotFound {
+					record.Warnf(scope.AWSMachine, "FailedDisassociateEIP", "Failed to disassociate Elastic IP %q: %v", aws.StringValue(address.AllocationId), err)
+					return errors.Wrapf(err, "failed to disassociate Elastic IP %q", aws.StringValue(address.AllocationId))
+				}
+			}
+		}
+
+		if err := wait.WaitForWithRetryable(wait.NewBackoff(), func() (bool, error) {
+			if _, err := s.EC2Client.ReleaseAddress(&ec2.ReleaseAddressInput{AllocationId: address.AllocationId}); err != nil {
+				if code, _ := awserrors.Code(errors.Cause(err)); code == awserrors.AllocationIDNotFound {
+					return true, nil
+				}
+				return false, err
+			}
+			return true, nil
+		}, awserrors.AuthFailure, awserrors.InUseIPAddress); err != nil {
+			record.Warnf(scope.AWSMachine, "FailedReleaseEIP", "Failed to release Elastic IP %q: %v", aws.StringValue(address.AllocationId), err)
+			return errors.Wrapf(err, "failed to release Elastic IP %q", aws.StringValue(address.AllocationId))
+		}
+
+		record.Eventf(scope.AWSMachine, "SuccessfulReleaseEIP", "Released Elastic IP %q", aws.StringValue(address.PublicIp))
+	}
+
+	return nil
+}
+
+// machineOwnedAddressFilters returns the filters matching the Elastic IP addresses allocated for the machine.
+func (s *Service) machineOwnedAddressFilters(scope *scope.MachineScope) []*ec2.Filter {
+	return []*ec2.Filter{
+		filter.EC2.ClusterOwned(s.scope.KubernetesClusterName()),
+		filter.EC2.MachineName(types.NamespacedName{Namespace: scope.Machine.Namespace, Name: scope.Machine.Name}.String()),
+	}
+}
+
+func firstUnassociatedAddress(addresses []*ec2.Address) *ec2.Address {
+	for _, address := range addresses {
+		if address.AssociationId == nil {
+			return address
+		}
+	}
+	return nil
+}
@@ -0,0 +1,216 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/test/mocks"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+)
+
+var machineOwnedAddressFilters = []*ec2.Filter{
+	{Name: aws.String("tag:sigs.k8s.io/cluster-api-provider-aws/cluster/test-cluster"), Values: aws.StringSlice([]string{"owned"})},
+	{Name: aws.String("tag:MachineName"), Values: aws.StringSlice([]string{"default/machine-1"})},
+}
+
+func TestReconcileElasticIP(t *testing.T) {
+	testCases := []struct {
+		name      string
+		spec      *infrav1.ElasticIPSpec
+		expect    func(m *mocks.MockEC2APIMockRecorder)
+		wantIP    string
+		expectErr bool
+	}{
+		{
+			name: "nothing to do without an Elastic IP specification",
+			spec: nil,
+		},
+		{
+			name: "address already associated with the instance",
+			spec: &infrav1.ElasticIPSpec{},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeAddresses(gomock.Eq(&ec2.DescribeAddressesInput{
+					Filters: []*ec2.Filter{{Name: aws.String("instance-id"), Values: aws.StringSlice([]string{"i-1"})}},
+				})).Return(&ec2.DescribeAddressesOutput{
+					Addresses: []*ec2.Address{{AllocationId: aws.String("eipalloc-1"), AssociationId: aws.String("eipassoc-1"), PublicIp: aws.String("1.2.3.4")}},
+				}, nil)
+			},
+			wantIP: "1.2.3.4",
+		},
+		{
+			name: "allocates a new address",
+			spec: &infrav1.ElasticIPSpec{},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeAddresses(gomock.Any()).Return(&ec2.DescribeAddressesOutput{}, nil)
+				m.DescribeAddresses(gomock.Eq(&ec2.DescribeAddressesInput{Filters: machineOwnedAddressFilters})).Return(&ec2.DescribeAddressesOutput{}, nil)
+				m.AllocateAddress(gomock.Any()).DoAndReturn(func(input *ec2.AllocateAddressInput) (*ec2.AllocateAddressOutput, error) {
+					if aws.StringValue(input.TagSpecifications[0].ResourceType) != ec2.ResourceTypeElasticIp {
+						t.Fatalf("unexpected tag specification resource type %q", aws.StringValue(input.TagSpecifications[0].ResourceType))
+					}
+					return &ec2.AllocateAddressOutput{AllocationId: aws.String("eipalloc-new"), PublicIp: aws.String("5.6.7.8")}, nil
+				})
+				m.AssociateAddress(gomock.Eq(&ec2.AssociateAddressInput{
+					AllocationId:       aws.String("eipalloc-new"),
+					InstanceId:         aws.String("i-1"),
+					AllowReassociation: aws.Bool(false),
+				})).Return(&ec2.AssociateAddressOutput{}, nil)
+			},
+			wantIP: "5.6.7.8",
+		},
+		{
+			name: "reuses the first unassociated pre-allocated address",
+			spec: &infrav1.ElasticIPSpec{AllocationIDs: []string{"eipalloc-1", "eipalloc-2"}},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeAddresses(gomock.Any()).Return(&ec2.DescribeAddressesOutput{}, nil)
+				m.DescribeAddresses(gomock.Eq(&ec2.DescribeAddressesInput{Filters: machineOwnedAddressFilters})).Return(&ec2.DescribeAddressesOutput{}, nil)
+				m.DescribeAddresses(gomock.Eq(&ec2.DescribeAddressesInput{AllocationIds: aws.StringSlice([]string{"eipalloc-1", "eipalloc-2"})})).
+					Return(&ec2.DescribeAddressesOutput{
+						Addresses: []*ec2.Address{
+							{AllocationId: aws.String("eipalloc-2"), PublicIp: aws.String("2.2.2.2")},
+							{AllocationId: aws.String("eipalloc-1"), AssociationId: aws.String("eipassoc-1"), PublicIp: aws.String("1.1.1.1")},
+						},
+					}, nil)
+				m.AssociateAddress(gomock.Eq(&ec2.AssociateAddressInput{
+					AllocationId:       aws.String("eipalloc-2"),
+					InstanceId:         aws.String("i-1"),
+					AllowReassociation: aws.Bool(false),
+				})).Return(&ec2.AssociateAddressOutput{}, nil)
+			},
+			wantIP: "2.2.2.2",
+		},
+		{
+			name: "reuses an address matching the filters",
+			spec: &infrav1.ElasticIPSpec{Filters: []infrav1.Filter{{Name: "tag:purpose", Values: []string{"egress"}}}},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeAddresses(gomock.Any()).Return(&ec2.DescribeAddressesOutput{}, nil)
+				m.DescribeAddresses(gomock.Eq(&ec2.DescribeAddressesInput{Filters: machineOwnedAddressFilters})).Return(&ec2.DescribeAddressesOutput{}, nil)
+				m.DescribeAddresses(gomock.Eq(&ec2.DescribeAddressesInput{
+					Filters: []*ec2.Filter{{Name: aws.String("tag:purpose"), Values: aws.StringSlice([]string{"egress"})}},
+				})).Return(&ec2.DescribeAddressesOutput{
+					Addresses: []*ec2.Address{{AllocationId: aws.String("eipalloc-3"), PublicIp: aws.String("3.3.3.3")}},
+				}, nil)
+				m.AssociateAddress(gomock.Eq(&ec2.AssociateAddressInput{
+					AllocationId:       aws.String("eipalloc-3"),
+					InstanceId:         aws.String("i-1"),
+					AllowReassociation: aws.Bool(false),
+				})).Return(&ec2.AssociateAddressOutput{}, nil)
+			},
+			wantIP: "3.3.3.3",
+		},
+		{
+			name: "fails when no address matches the filters and no pool is set",
+			spec: &infrav1.ElasticIPSpec{Filters: []infrav1.Filter{{Name: "tag:purpose", Values: []string{"egress"}}}},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeAddresses(gomock.Any()).Return(&ec2.DescribeAddressesOutput{}, nil).Times(3)
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			ec2Mock := mocks.NewMockEC2API(mockCtrl)
+
+			machineScope := newElasticIPMachineScope(t, tc.spec)
+			if tc.expect != nil {
+				tc.expect(ec2Mock.EXPECT())
+			}
+
+			s := NewService(machineScope.InfraCluster.(scope.EC2Scope))
+			s.EC2Client = ec2Mock
+
+			ip, err := s.ReconcileElasticIP(machineScope, "i-1")
+			if tc.expectErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(ip).To(Equal(tc.wantIP))
+		})
+	}
+}
+
+func TestReleaseElasticIP(t *testing.T) {
+	g := NewWithT(t)
+	mockCtrl := gomock.NewController(t)
+	ec2Mock := mocks.NewMockEC2API(mockCtrl)
+
+	machineScope := newElasticIPMachineScope(t, &infrav1.ElasticIPSpec{})
+
+	ec2Mock.EXPECT().DescribeAddresses(gomock.Eq(&ec2.DescribeAddressesInput{Filters: machineOwnedAddressFilters})).
+		Return(&ec2.DescribeAddressesOutput{
+			Addresses: []*ec2.Address{{AllocationId: aws.String("eipalloc-1"), AssociationId: aws.String("eipassoc-1"), PublicIp: aws.String("1.2.3.4")}},
+		}, nil)
+	ec2Mock.EXPECT().DisassociateAddress(gomock.Eq(&ec2.DisassociateAddressInput{AssociationId: aws.String("eipassoc-1")})).
+		Return(&ec2.DisassociateAddressOutput{}, nil)
+	ec2Mock.EXPECT().ReleaseAddress(gomock.Eq(&ec2.ReleaseAddressInput{AllocationId: aws.String("eipalloc-1")})).
+		Return(&ec2.ReleaseAddressOutput{}, nil)
+
+	s := NewService(machineScope.InfraCluster.(scope.EC2Scope))
+	s.EC2Client = ec2Mock
+
+	g.Expect(s.ReleaseElasticIP(machineScope)).To(Succeed())
+}
+
+func newElasticIPMachineScope(t *testing.T, spec *infrav1.ElasticIPSpec) *scope.MachineScope {
+	t.Helper()
+
+	scheme, err := setupScheme()
+	if err != nil {
+		t.Fatalf("Failed to create scheme: %v", err)
+	}
+	cluster := &clusterv1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "test-cluster", Namespace: "default"}}
+	machine := &clusterv1.Machine{ObjectMeta: metav1.ObjectMeta{Name: "machine-1", Namespace: "default"}}
+	awsMachine := &infrav1.AWSMachine{
+		ObjectMeta: metav1.ObjectMeta{Name: "aws-machine-1", Namespace: "default"},
+		Spec:       infrav1.AWSMachineSpec{ElasticIP: spec},
+	}
+
+	client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(cluster, machine, awsMachine).Build()
+	clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+		Client:     client,
+		Cluster:    cluster,
+		AWSCluster: &infrav1.AWSCluster{ObjectMeta: metav1.ObjectMeta{Name: "test-cluster", Namespace: "default"}},
+	})
+	if err != nil {
+		t.Fatalf("Failed to create test context: %v", err)
+	}
+
+	machineScope, err := scope.NewMachineScope(scope.MachineScopeParams{
+		Client:       client,
+		Cluster:      cluster,
+		Machine:      machine,
+		AWSMachine:   awsMachine,
+		InfraCluster: clusterScope,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create test context: %v", err)
+	}
+	return machineScope
+}
@@ -203,6 +203,11 @@ func (s *Service) CreateInstance(scope *scope.MachineScope, userData []byte, use
 	}
 	input.SecurityGroupIDs = append(input.SecurityGroupIDs, ids...)
 
+	input.AdditionalNetworkInterfaces, err = s.getAdditionalNetworkInterfaces(scope, input.SubnetID, input.SecurityGroupIDs)
+	if err != nil {
+		return nil, err
+	}
+
 	// If SSHKeyName WAS NOT provided in the AWSMachine Spec, fallback to the value provided in the AWSCluster Spec.
 	// If a value was not provided in the AWSCluster Spec, then use the defaultSSHKeyName
 	// Note that:
@@ -237,6 +242,14 @@ func (s *Service) CreateInstance(scope *scope.MachineScope, userData []byte, use
 
 	input.PlacementGroupName = scope.AWSMachine.Spec.PlacementGroupName
 
+	input.PlacementGroupPartition = scope.AWSMachine.Spec.PlacementGroupPartition
+
+	input.CapacityReservation = scope.AWSMachine.Spec.CapacityReservation
+
+	input.PrivateDNSName = scope.AWSMachine.Spec.PrivateDNSName
+
+	input.MaintenanceOptions = scope.AWSMachine.Spec.MaintenanceOptions
+
 	s.scope.Debug("Running instance", "machine-role", scope.Role())
 	s.scope.Debug("Running instance with instance metadata options", "metadata options", input.InstanceMetadataOptions)
 	out, err := s.runInstance(scope.Role(), input)
@@ -283,6 +296,66 @@ func (s *Service) CreateInstance(scope *scope.MachineScope, userData []byte, use
 	return out, nil
 }
 
+// getAdditionalNetworkInterfaces returns the additional network interfaces of the machine with their subnet and
+// security groups resolved to IDs. Network interfaces without a subnet or security groups use the ones of the
+// primary network interface.
+func (s *Service) getAdditionalNetworkInterfaces(scope *scope.MachineScope, subnetID string, securityGroupIDs []string) ([]infrav1.AdditionalNetworkInterface, error) {
+	if len(scope.AWSMachine.Spec.AdditionalNetworkInterfaces) == 0 {
+		return nil, nil
+	}
+
+	interfaces := make([]infrav1.AdditionalNetworkInterface, 0, len(scope.AWSMachine.Spec.AdditionalNetworkInterfaces))
+	for _, spec := range scope.AWSMachine.Spec.AdditionalNetworkInterfaces {
+		eni := *spec.DeepCopy()
+
+		eniSubnetID := subnetID
+		switch {
+		case eni.Subnet != nil && eni.Subnet.ID != nil:
+			eniSubnetID = *eni.Subnet.ID
+		case eni.Subnet != nil && len(eni.Subnet.Filters) > 0:
+			criteria := []*ec2.Filter{
+				filter.EC2.SubnetStates(ec2.SubnetStatePending, ec2.SubnetStateAvailable),
+			}
+			if !scope.IsExternallyManaged() {
+				criteria = append(criteria, filter.EC2.VPC(s.scope.VPC().ID))
+			}
+			for _, f := range eni.Subnet.Filters {
+				criteria = append(criteria, &ec2.Filter{Name: aws.String(f.Name), Values: aws.StringSlice(f.Values)})
+			}
+
+			subnets, err := s.getFilteredSubnets(criteria...)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to filter subnets for criteria %q", criteria)
+			}
+			if len(subnets) == 0 {
+				errMessage := fmt.Sprintf("failed to run machine %q, no subnets available for network interface with device index %d matching criteria %q",
+					scope.Name(), eni.DeviceIndex, criteria)
+				record.Warnf(scope.AWSMachine, "FailedCreate", errMessage)
+				return nil, awserrors.NewFailedDependency(errMessage)
+			}
+			eniSubnetID = aws.StringValue(subnets[0].SubnetId)
+		}
+		eni.Subnet = &infrav1.AWSResourceReference{ID: aws.String(eniSubnetID)}
+
+		eniSecurityGroupIDs := securityGroupIDs
+		if len(eni.SecurityGroups) > 0 {
+			ids, err := s.GetAdditionalSecurityGroupsIDs(eni.SecurityGroups)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to get security groups for network interface with device index %d", eni.DeviceIndex)
+			}
+			eniSecurityGroupIDs = ids
+		}
+		eni.SecurityGroups = make([]infrav1.AWSResourceReference, 0, len(eniSecurityGroupIDs))
+		for _, id := range eniSecurityGroupIDs {
+			eni.SecurityGroups = append(eni.SecurityGroups, infrav1.AWSResourceReference{ID: aws.String(id)})
+		}
+
+		interfaces = append(interfaces, eni)
+	}
+
+	return interfaces, nil
+}
+
 // findSubnet attempts to retrieve a subnet ID in the following order:
 // - subnetID specified in machine configuration,
 // - subnet based on filters in machine configuration
@@ -348,6 +421,9 @@ func (s *Service) findSubnet(scope *scope.MachineScope) (string, error) {
 	case failureDomain != nil:
 		if scope.AWSMachine.Spec.PublicIP != nil && *scope.AWSMachine.Spec.PublicIP {
 			subnets := s.scope.Subnets().FilterPublic().FilterByZone(*failureDomain)
+			if len(subnets) == 0 {
+				subnets = s.getEdgeSubnetsInZone(*failureDomain, true)
+			}
 			if len(subnets) == 0 {
 				errMessage := fmt.Sprintf("failed to run machine %q with public IP, no public subnets available in availability zone %q",
 					scope.Name(), *failureDomain)
@@ -358,6 +434,9 @@ func (s *Service) findSubnet(scope *scope.MachineScope) (string, error) {
 		}
 
 		subnets := s.scope.Subnets().FilterPrivate().FilterByZone(*failureDomain)
+		if len(subnets) == 0 {
+			subnets = s.getEdgeSubnetsInZone(*failureDomain, false)
+		}
 		if len(subnets) == 0 {
 			errMessage := fmt.Sprintf("failed to run machine %q, no subnets available in availability zone %q",
 				scope.Name(), *failureDomain)
@@ -388,6 +467,20 @@ func (s *Service) findSubnet(scope *scope.MachineScope) (string, error) {
 	}
 }
 
+// getEdgeSubnetsInZone returns the public or private subnets of the cluster in a Local Zone or Wavelength Zone.
+// Machines are only placed in edge subnets when their failure domain is an edge zone.
+func (s *Service) getEdgeSubnetsInZone(zone string, public bool) infrav1.Subnets {
+	var subnets infrav1.Subnets
+	for _, sn := range s.scope.Subnets().FilterEdge().FilterByZone(zone) {
+		// Instances in Wavelength Zones get carrier IP addresses, which are not supported.
+		if sn.IsPublic != public || (public && sn.IsEdgeWavelength()) {
+			continue
+		}
+		subnets = append(subnets, sn)
+	}
+	return subnets
+}
+
 // getFilteredSubnets fetches subnets filtered based on the criteria passed.
 func (s *Service) getFilteredSubnets(criteria ...*ec2.Filter) ([]*ec2.Subnet, error) {
 	out, err := s.EC2Client.DescribeSubnets(&ec2.DescribeSubnetsInput{Filters: criteria})
@@ -528,6 +621,16 @@ func (s *Service) runInstance(role string, i *infrav1.Instance) (*infrav1.Instan
 		}
 
 		input.NetworkInterfaces = netInterfaces
+	} else if len(i.AdditionalNetworkInterfaces) > 0 {
+		// The subnet and security groups of the instance cannot be set together with network interfaces,
+		// they are set on the primary network interface instead.
+		input.NetworkInterfaces = []*ec2.InstanceNetworkInterfaceSpecification{
+			{
+				DeviceIndex: aws.Int64(0),
+				SubnetId:    aws.String(i.SubnetID),
+				Groups:      aws.StringSlice(i.SecurityGroupIDs),
+			},
+		}
 	} else {
 		input.SubnetId = aws.String(i.SubnetID)
 
@@ -535,6 +638,7 @@ func (s *Service) runInstance(role string, i *infrav1.Instance) (*infrav1.Instan
 			input.SecurityGroupIds = aws.StringSlice(i.SecurityGroupIDs)
 		}
 	}
+	input.NetworkInterfaces = append(input.NetworkInterfaces, getAdditionalNetworkInterfacesRequest(i.AdditionalNetworkInterfaces)...)
 
 	if i.IAMProfile != "" {
 		input.IamInstanceProfile = &ec2.IamInstanceProfileSpecification{
@@ -571,25 +675,32 @@ func (s *Service) runInstance(role string, i *infrav1.Instance) (*infrav1.Instan
 	}
 
 	if len(i.Tags) > 0 {
-		spec := &ec2.TagSpecification{ResourceType: aws.String(ec2.ResourceTypeInstance)}
-		// We need to sort keys for tests to work
-		keys := make([]string, 0, len(i.Tags))
-		for k := range i.Tags {
-			keys = append(keys, k)
-		}
-		sort.Strings(keys)
-		for _, key := range keys {
-			spec.Tags = append(spec.Tags, &ec2.Tag{
-				Key:   aws.String(key),
-				Value: aws.String(i.Tags[key]),
-			})
-		}
+		input.TagSpecifications = append(input.TagSpecifications, getTagSpecification(ec2.ResourceTypeInstance, i.Tags))
+	}
 
-		input.TagSpecifications = append(input.TagSpecifications, spec)
+	// Mark the network interfaces created with the instance, to tell them apart from the ones attached by users.
+	if len(i.AdditionalNetworkInterfaces) > 0 {
+		eniTags := infrav1.Tags{}
+		for k, v := range i.Tags {
+			eniTags[k] = v
+		}
+		eniTags[infrav1.NameAWSNetworkInterfaceLifecycle] = string(infrav1.ResourceLifecycleOwned)
+		input.TagSpecifications = append(input.TagSpecifications, getTagSpecification(ec2.ResourceTypeNetworkInterface, eniTags))
 	}
 
 	input.InstanceMarketOptions = getInstanceMarketOptionsRequest(i.SpotMarketOptions)
 	input.MetadataOptions = getInstanceMetadataOptionsRequest(i.InstanceMetadataOptions)
+	input.CapacityReservationSpecification = getCapacityReservationSpecificationRequest(i.CapacityReservation)
+	input.PrivateDnsNameOptions = getPrivateDNSNameOptionsRequest(i.PrivateDNSName)
+
+	if i.MaintenanceOptions != nil {
+		input.MaintenanceOptions = getInstanceMaintenanceOptionsRequest(i.MaintenanceOptions)
+		input.DisableApiTermination = i.MaintenanceOptions.DisableAPITermination
+		input.DisableApiStop = i.MaintenanceOptions.DisableAPIStop
+		if i.MaintenanceOptions.InstanceInitiatedShutdownBehavior != "" {
+			input.InstanceInitiatedShutdownBehavior = aws.String(string(i.MaintenanceOptions.InstanceInitiatedShutdownBehavior))
+		}
+	}
 
 	if i.Tenancy != "" {
 		input.Placement = &ec2.Placement{
@@ -602,6 +713,9 @@ func (s *Service) runInstance(role string, i *infrav1.Instance) (*infrav1.Instan
 			input.Placement = &ec2.Placement{}
 		}
 		input.Placement.GroupName = &i.PlacementGroupName
+		if i.PlacementGroupPartition != 0 {
+			input.Placement.PartitionNumber = &i.PlacementGroupPartition
+		}
 	}
 
 	out, err := s.EC2Client.RunInstances(input)
@@ -616,6 +730,53 @@ func (s *Service) runInstance(role string, i *infrav1.Instance) (*infrav1.Instan
 	return s.SDKToInstance(out.Instances[0])
 }
 
+// getTagSpecification returns the tag specification of a resource created by RunInstances.
+func getTagSpecification(resourceType string, tags map[string]string) *ec2.TagSpecification {
+	spec := &ec2.TagSpecification{ResourceType: aws.String(resourceType)}
+	// We need to sort keys for tests to work
+	keys := make([]string, 0, len(tags))
+	for k := range tags {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		spec.Tags = append(spec.Tags, &ec2.Tag{
+			Key:   aws.String(key),
+			Value: aws.String(tags[key]),
+		})
+	}
+	return spec
+}
+
+// getAdditionalNetworkInterfacesRequest returns the network interfaces to create together with the instance.
+// Subnet and security groups are expected to be resolved to IDs already.
+func getAdditionalNetworkInterfacesRequest(interfaces []infrav1.AdditionalNetworkInterface) []*ec2.InstanceNetworkInterfaceSpecification {
+	specs := make([]*ec2.InstanceNetworkInterfaceSpecification, 0, len(interfaces))
+	for _, eni := range interfaces {
+		spec := &ec2.InstanceNetworkInterfaceSpecification{
+			DeviceIndex:         aws.Int64(eni.DeviceIndex),
+			DeleteOnTermination: aws.Bool(true),
+		}
+		if eni.NetworkCardIndex != 0 {
+			spec.NetworkCardIndex = aws.Int64(eni.NetworkCardIndex)
+		}
+		if eni.Subnet != nil {
+			spec.SubnetId = eni.Subnet.ID
+		}
+		for _, sg := range eni.SecurityGroups {
+			spec.Groups = append(spec.Groups, sg.ID)
+		}
+		if eni.InterfaceType != "" {
+			spec.InterfaceType = aws.String(string(eni.InterfaceType))
+		}
+		if eni.Description != "" {
+			spec.Description = aws.String(eni.Description)
+		}
+		specs = append(specs, spec)
+	}
+	return specs
+}
+
 func volumeToBlockDeviceMapping(v *infrav1.Volume) *ec2.BlockDeviceMapping {
 	ebsDevice := &ec2.EbsBlockDevice{
 		DeleteOnTermination: aws.Bool(true),
@@ -838,6 +999,22 @@ func (s *Service) SDKToInstance(v *ec2.Instance) (*infrav1.Instance, error) {
 
 	i.AvailabilityZone = aws.StringValue(v.Placement.AvailabilityZone)
 
+	for _, eni := range v.NetworkInterfaces {
+		// Only report the secondary network interfaces, the primary network interface is described by the instance itself.
+		if eni.Attachment == nil || (aws.Int64Value(eni.Attachment.DeviceIndex) == 0 && aws.Int64Value(eni.Attachment.NetworkCardIndex) == 0) {
+			continue
+		}
+		i.NetworkInterfaceAttachments = append(i.NetworkInterfaceAttachments, infrav1.NetworkInterfaceAttachment{
+			ID:                  aws.StringValue(eni.NetworkInterfaceId),
+			DeviceIndex:         aws.Int64Value(eni.Attachment.DeviceIndex),
+			NetworkCardIndex:    aws.Int64Value(eni.Attachment.NetworkCardIndex),
+			SubnetID:            aws.StringValue(eni.SubnetId),
+			InterfaceType:       infrav1.NetworkInterfaceType(aws.StringValue(eni.InterfaceType)),
+			PrivateIPAddress:    aws.StringValue(eni.PrivateIpAddress),
+			DeleteOnTermination: aws.BoolValue(eni.Attachment.DeleteOnTermination),
+		})
+	}
+
 	for _, volume := range v.BlockDeviceMappings {
 		i.VolumeIDs = append(i.VolumeIDs, *volume.Ebs.VolumeId)
 	}
@@ -860,21 +1037,69 @@ func (s *Service) SDKToInstance(v *ec2.Instance) (*infrav1.Instance, error) {
 		i.InstanceMetadataOptions = metadataOptions
 	}
 
+	if v.CapacityReservationSpecification != nil {
+		capacityReservation := &infrav1.CapacityReservationSpecification{
+			Preference: infrav1.CapacityReservationPreference(aws.StringValue(v.CapacityReservationSpecification.CapacityReservationPreference)),
+		}
+		if target := v.CapacityReservationSpecification.CapacityReservationTarget; target != nil {
+			capacityReservation.Target = &infrav1.CapacityReservationTarget{
+				ID:               target.CapacityReservationId,
+				ResourceGroupARN: target.CapacityReservationResourceGroupArn,
+			}
+		}
+
+		i.CapacityReservation = capacityReservation
+	}
+
+	if v.PrivateDnsNameOptions != nil {
+		i.PrivateDNSName = &infrav1.PrivateDNSName{
+			HostnameType:                    infrav1.HostnameType(aws.StringValue(v.PrivateDnsNameOptions.HostnameType)),
+			EnableResourceNameDNSARecord:    v.PrivateDnsNameOptions.EnableResourceNameDnsARecord,
+			EnableResourceNameDNSAAAARecord: v.PrivateDnsNameOptions.EnableResourceNameDnsAAAARecord,
+		}
+	}
+
+	if v.MaintenanceOptions != nil && v.MaintenanceOptions.AutoRecovery != nil {
+		i.MaintenanceOptions = &infrav1.InstanceMaintenanceOptions{
+			AutoRecovery: infrav1.AutoRecoveryState(aws.StringValue(v.MaintenanceOptions.AutoRecovery)),
+		}
+	}
+
 	return i, nil
 }
 
 func (s *Service) getInstanceAddresses(instance *ec2.Instance) []clusterv1.MachineAddress {
 	addresses := []clusterv1.MachineAddress{}
-	for _, eni := range instance.NetworkInterfaces {
-		privateDNSAddress := clusterv1.MachineAddress{
+
+	// The hostname of the instance depends on its hostname type: with resource name hostnames, it is based on the
+	// instance ID instead of the private IPv4 address found on the network interfaces.
+	if hostname := aws.StringValue(instance.PrivateDnsName); hostname != "" {
+		addresses = append(addresses, clusterv1.MachineAddress{
 			Type:    clusterv1.MachineInternalDNS,
-			Address: aws.StringValue(eni.PrivateDnsName),
+			Address: hostname,
+		})
+	}
+
+	for _, eni := range instance.NetworkInterfaces {
+		if aws.StringValue(eni.PrivateDnsName) != aws.StringValue(instance.PrivateDnsName) {
+			privateDNSAddress := clusterv1.MachineAddress{
+				Type:    clusterv1.MachineInternalDNS,
+				Address: aws.StringValue(eni.PrivateDnsName),
+			}
+			addresses = append(addresses, privateDNSAddress)
 		}
 		privateIPAddress := clusterv1.MachineAddress{
 			Type:    clusterv1.MachineInternalIP,
 			Address: aws.StringValue(eni.PrivateIpAddress),
 		}
-		addresses = append(addresses, privateDNSAddress, privateIPAddress)
+		addresses = append(addresses, privateIPAddress)
+
+		for _, ipv6 := range eni.Ipv6Addresses {
+			addresses = append(addresses, clusterv1.MachineAddress{
+				Type:    clusterv1.MachineInternalIP,
+				Address: aws.StringValue(ipv6.Ipv6Address),
+			})
+		}
 
 		// An elastic IP is attached if association is non nil pointer
 		if eni.Association != nil {
@@ -987,6 +1212,90 @@ func (s *Service) ModifyInstanceMetadataOptions(instanceID string, options *infr
 	return nil
 }
 
+// ModifyInstanceMaintenanceOptions modifies the maintenance options of the given EC2 instance.
+// Only the options that are set and differ from the current ones of the instance are modified.
+func (s *Service) ModifyInstanceMaintenanceOptions(instance *infrav1.Instance, options *infrav1.InstanceMaintenanceOptions) error {
+	if options == nil {
+		return nil
+	}
+
+	if options.AutoRecovery != "" && (instance.MaintenanceOptions == nil || instance.MaintenanceOptions.AutoRecovery != options.AutoRecovery) {
+		s.scope.Info("Updating instance auto-recovery", "instance id", instance.ID, "auto-recovery", options.AutoRecovery)
+		if _, err := s.EC2Client.ModifyInstanceMaintenanceOptions(&ec2.ModifyInstanceMaintenanceOptionsInput{
+			InstanceId:   aws.String(instance.ID),
+			AutoRecovery: aws.String(string(options.AutoRecovery)),
+		}); err != nil {
+			return errors.Wrapf(err, "failed to modify auto-recovery of instance %q", instance.ID)
+		}
+	}
+
+	if options.DisableAPITermination != nil {
+		out, err := s.describeInstanceAttribute(instance.ID, ec2.InstanceAttributeNameDisableApiTermination)
+		if err != nil {
+			return err
+		}
+		if out.DisableApiTermination == nil || aws.BoolValue(out.DisableApiTermination.Value) != *options.DisableAPITermination {
+			if err := s.modifyInstanceAttribute(&ec2.ModifyInstanceAttributeInput{
+				InstanceId:            aws.String(instance.ID),
+				DisableApiTermination: &ec2.AttributeBooleanValue{Value: options.DisableAPITermination},
+			}); err != nil {
+				return err
+			}
+		}
+	}
+
+	if options.DisableAPIStop != nil {
+		out, err := s.describeInstanceAttribute(instance.ID, ec2.InstanceAttributeNameDisableApiStop)
+		if err != nil {
+			return err
+		}
+		if out.DisableApiStop == nil || aws.BoolValue(out.DisableApiStop.Value) != *options.DisableAPIStop {
+			if err := s.modifyInstanceAttribute(&ec2.ModifyInstanceAttributeInput{
+				InstanceId:     aws.String(instance.ID),
+				DisableApiStop: &ec2.AttributeBooleanValue{Value: options.DisableAPIStop},
+			}); err != nil {
+				return err
+			}
+		}
+	}
+
+	if options.InstanceInitiatedShutdownBehavior != "" {
+		out, err := s.describeInstanceAttribute(instance.ID, ec2.InstanceAttributeNameInstanceInitiatedShutdownBehavior)
+		if err != nil {
+			return err
+		}
+		if out.InstanceInitiatedShutdownBehavior == nil || aws.StringValue(out.InstanceInitiatedShutdownBehavior.Value) != string(options.InstanceInitiatedShutdownBehavior) {
+			if err := s.modifyInstanceAttribute(&ec2.ModifyInstanceAttributeInput{
+				InstanceId:                        aws.String(instance.ID),
+				InstanceInitiatedShutdownBehavior: &ec2.AttributeValue{Value: aws.String(string(options.InstanceInitiatedShutdownBehavior))},
+			}); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (s *Service) describeInstanceAttribute(instanceID, attribute string) (*ec2.DescribeInstanceAttributeOutput, error) {
+	out, err := s.EC2Client.DescribeInstanceAttribute(&ec2.DescribeInstanceAttributeInput{
+		InstanceId: aws.String(instanceID),
+		Attribute:  aws.String(attribute),
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to describe attribute %q of instance %q", attribute, instanceID)
+	}
+	return out, nil
+}
+
+func (s *Service) modifyInstanceAttribute(input *ec2.ModifyInstanceAttributeInput) error {
+	s.scope.Info("Updating instance attribute", "instance id", aws.StringValue(input.InstanceId), "input", input)
+	if _, err := s.EC2Client.ModifyInstanceAttribute(input); err != nil {
+		return errors.Wrapf(err, "failed to modify attribute of instance %q", aws.StringValue(input.InstanceId))
+	}
+	return nil
+}
+
 // filterGroups filters a list for a string.
 func filterGroups(list []string, strToFilter string) (newList []string) {
 	for _, item := range list {
@@ -1027,6 +1336,51 @@ func getInstanceMarketOptionsRequest(spotMarketOptions *infrav1.SpotMarketOption
 	return instanceMarketOptionsRequest
 }
 
+func getInstanceMaintenanceOptionsRequest(maintenanceOptions *infrav1.InstanceMaintenanceOptions) *ec2.InstanceMaintenanceOptionsRequest {
+	if maintenanceOptions == nil || maintenanceOptions.AutoRecovery == "" {
+		return nil
+	}
+
+	return &ec2.InstanceMaintenanceOptionsRequest{
+		AutoRecovery: aws.String(string(maintenanceOptions.AutoRecovery)),
+	}
+}
+
+func getCapacityReservationSpecificationRequest(capacityReservation *infrav1.CapacityReservationSpecification) *ec2.CapacityReservationSpecification {
+	if capacityReservation == nil {
+		return nil
+	}
+
+	request := &ec2.CapacityReservationSpecification{}
+	if capacityReservation.Preference != "" {
+		request.SetCapacityReservationPreference(string(capacityReservation.Preference))
+	}
+	if capacityReservation.Target != nil {
+		request.SetCapacityReservationTarget(&ec2.CapacityReservationTarget{
+			CapacityReservationId:               capacityReservation.Target.ID,
+			CapacityReservationResourceGroupArn: capacityReservation.Target.ResourceGroupARN,
+		})
+	}
+
+	return request
+}
+
+func getPrivateDNSNameOptionsRequest(privateDNSName *infrav1.PrivateDNSName) *ec2.PrivateDnsNameOptionsRequest {
+	if privateDNSName == nil {
+		return nil
+	}
+
+	request := &ec2.PrivateDnsNameOptionsRequest{
+		EnableResourceNameDnsARecord:    privateDNSName.EnableResourceNameDNSARecord,
+		EnableResourceNameDnsAAAARecord: privateDNSName.EnableResourceNameDNSAAAARecord,
+	}
+	if privateDNSName.HostnameType != "" {
+		request.SetHostnameType(string(privateDNSName.HostnameType))
+	}
+
+	return request
+}
+
 func getInstanceMetadataOptionsRequest(metadataOptions *infrav1.InstanceMetadataOptions) *ec2.InstanceMetadataOptionsRequest {
 	if metadataOptions == nil {
 		return nil
@@ -271,6 +271,123 @@ func TestTerminateInstance(t *testing.T) {
 	}
 }
 
+func TestModifyInstanceMaintenanceOptions(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	testCases := []struct {
+		name      string
+		instance  *infrav1.Instance
+		options   *infrav1.InstanceMaintenanceOptions
+		expect    func(m *mocks.MockEC2APIMockRecorder)
+		expectErr bool
+	}{
+		{
+			name:     "nothing to do without maintenance options",
+			instance: &infrav1.Instance{ID: "i-1"},
+		},
+		{
+			name: "options already applied",
+			instance: &infrav1.Instance{
+				ID:                 "i-1",
+				MaintenanceOptions: &infrav1.InstanceMaintenanceOptions{AutoRecovery: infrav1.AutoRecoveryStateDisabled},
+			},
+			options: &infrav1.InstanceMaintenanceOptions{
+				AutoRecovery:          infrav1.AutoRecoveryStateDisabled,
+				DisableAPITermination: aws.Bool(true),
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeInstanceAttribute(gomock.Eq(&ec2.DescribeInstanceAttributeInput{
+					InstanceId: aws.String("i-1"),
+					Attribute:  aws.String("disableApiTermination"),
+				})).Return(&ec2.DescribeInstanceAttributeOutput{
+					DisableApiTermination: &ec2.AttributeBooleanValue{Value: aws.Bool(true)},
+				}, nil)
+			},
+		},
+		{
+			name: "changed options are modified",
+			instance: &infrav1.Instance{
+				ID:                 "i-1",
+				MaintenanceOptions: &infrav1.InstanceMaintenanceOptions{AutoRecovery: infrav1.AutoRecoveryStateDefault},
+			},
+			options: &infrav1.InstanceMaintenanceOptions{
+				AutoRecovery:                      infrav1.AutoRecoveryStateDisabled,
+				DisableAPIStop:                    aws.Bool(true),
+				InstanceInitiatedShutdownBehavior: infrav1.ShutdownBehaviorTerminate,
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.ModifyInstanceMaintenanceOptions(gomock.Eq(&ec2.ModifyInstanceMaintenanceOptionsInput{
+					InstanceId:   aws.String("i-1"),
+					AutoRecovery: aws.String("disabled"),
+				})).Return(&ec2.ModifyInstanceMaintenanceOptionsOutput{}, nil)
+				m.DescribeInstanceAttribute(gomock.Eq(&ec2.DescribeInstanceAttributeInput{
+					InstanceId: aws.String("i-1"),
+					Attribute:  aws.String("disableApiStop"),
+				})).Return(&ec2.DescribeInstanceAttributeOutput{
+					DisableApiStop: &ec2.AttributeBooleanValue{Value: aws.Bool(false)},
+				}, nil)
+				m.ModifyInstanceAttribute(gomock.Eq(&ec2.ModifyInstanceAttributeInput{
+					InstanceId:     aws.String("i-1"),
+					DisableApiStop: &ec2.AttributeBooleanValue{Value: aws.Bool(true)},
+				})).Return(&ec2.ModifyInstanceAttributeOutput{}, nil)
+				m.DescribeInstanceAttribute(gomock.Eq(&ec2.DescribeInstanceAttributeInput{
+					InstanceId: aws.String("i-1"),
+					Attribute:  aws.String("instanceInitiatedShutdownBehavior"),
+				})).Return(&ec2.DescribeInstanceAttributeOutput{
+					InstanceInitiatedShutdownBehavior: &ec2.AttributeValue{Value: aws.String("stop")},
+				}, nil)
+				m.ModifyInstanceAttribute(gomock.Eq(&ec2.ModifyInstanceAttributeInput{
+					InstanceId:                        aws.String("i-1"),
+					InstanceInitiatedShutdownBehavior: &ec2.AttributeValue{Value: aws.String("terminate")},
+				})).Return(&ec2.ModifyInstanceAttributeOutput{}, nil)
+			},
+		},
+		{
+			name:     "describe failure",
+			instance: &infrav1.Instance{ID: "i-1"},
+			options:  &infrav1.InstanceMaintenanceOptions{DisableAPITermination: aws.Bool(false)},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeInstanceAttribute(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ec2Mock := mocks.NewMockEC2API(mockCtrl)
+
+			scheme := runtime.NewScheme()
+			_ = infrav1.AddToScheme(scheme)
+			client := fake.NewClientBuilder().WithScheme(scheme).Build()
+			scope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Client:     client,
+				Cluster:    &clusterv1.Cluster{},
+				AWSCluster: &infrav1.AWSCluster{},
+			})
+			if err != nil {
+				t.Fatalf("Failed to create test context: %v", err)
+			}
+
+			if tc.expect != nil {
+				tc.expect(ec2Mock.EXPECT())
+			}
+
+			s := NewService(scope)
+			s.EC2Client = ec2Mock
+
+			err = s.ModifyInstanceMaintenanceOptions(tc.instance, tc.options)
+			if tc.expectErr && err == nil {
+				t.Fatal("expected error")
+			}
+			if !tc.expectErr && err != nil {
+				t.Fatalf("did not expect error: %v", err)
+			}
+		})
+	}
+}
+
 func TestCreateInstance(t *testing.T) {
 	secret := &corev1.Secret{
 		ObjectMeta: metav1.ObjectMeta{
@@ -2500,7 +2617,7 @@ func TestCreateInstance(t *testing.T) {
 			},
 		},
 		{
-			name: "with custom placement group cloud-config",
+			name: "with capacity reservation target cloud-config",
 			machine: clusterv1.Machine{
 				ObjectMeta: metav1.ObjectMeta{
 					Labels:    map[string]string{"set": "node"},
@@ -2517,8 +2634,12 @@ func TestCreateInstance(t *testing.T) {
 				AMI: infrav1.AMIReference{
 					ID: aws.String("abc"),
 				},
-				InstanceType:         "m5.large",
-				PlacementGroupName:   "placement-group1",
+				InstanceType: "m5.large",
+				CapacityReservation: &infrav1.CapacityReservationSpecification{
+					Target: &infrav1.CapacityReservationTarget{
+						ID: aws.String("cr-12345"),
+					},
+				},
 				UncompressedUserData: &isUncompressedFalse,
 			},
 			awsCluster: &infrav1.AWSCluster{
@@ -2562,8 +2683,10 @@ func TestCreateInstance(t *testing.T) {
 						KeyName:      aws.String("default"),
 						MaxCount:     aws.Int64(1),
 						MinCount:     aws.Int64(1),
-						Placement: &ec2.Placement{
-							GroupName: aws.String("placement-group1"),
+						CapacityReservationSpecification: &ec2.CapacityReservationSpecification{
+							CapacityReservationTarget: &ec2.CapacityReservationTarget{
+								CapacityReservationId: aws.String("cr-12345"),
+							},
 						},
 						SecurityGroupIds: []*string{aws.String("2"), aws.String("3")},
 						SubnetId:         aws.String("subnet-1"),
@@ -2620,7 +2743,12 @@ func TestCreateInstance(t *testing.T) {
 								},
 								Placement: &ec2.Placement{
 									AvailabilityZone: &az,
-									GroupName:        aws.String("placement-group1"),
+								},
+								CapacityReservationId: aws.String("cr-12345"),
+								CapacityReservationSpecification: &ec2.CapacityReservationSpecificationResponse{
+									CapacityReservationTarget: &ec2.CapacityReservationTargetResponse{
+										CapacityReservationId: aws.String("cr-12345"),
+									},
 								},
 							},
 						},
@@ -2653,10 +2781,19 @@ func TestCreateInstance(t *testing.T) {
 				if err != nil {
 					t.Fatalf("did not expect error: %v", err)
 				}
+
+				expected := &infrav1.CapacityReservationSpecification{
+					Target: &infrav1.CapacityReservationTarget{
+						ID: aws.String("cr-12345"),
+					},
+				}
+				if !cmp.Equal(instance.CapacityReservation, expected) {
+					t.Fatalf("expected capacity reservation %+v, got %+v", expected, instance.CapacityReservation)
+				}
 			},
 		},
 		{
-			name: "with dedicated tenancy and placement group ignition",
+			name: "with custom placement group cloud-config",
 			machine: clusterv1.Machine{
 				ObjectMeta: metav1.ObjectMeta{
 					Labels:    map[string]string{"set": "node"},
@@ -2674,13 +2811,10 @@ func TestCreateInstance(t *testing.T) {
 					ID: aws.String("abc"),
 				},
 				InstanceType:         "m5.large",
-				Tenancy:              "dedicated",
 				PlacementGroupName:   "placement-group1",
-				UncompressedUserData: &isUncompressedTrue,
-				Ignition:             &infrav1.Ignition{},
+				UncompressedUserData: &isUncompressedFalse,
 			},
 			awsCluster: &infrav1.AWSCluster{
-				ObjectMeta: metav1.ObjectMeta{Name: "test"},
 				Spec: infrav1.AWSClusterSpec{
 					NetworkSpec: infrav1.NetworkSpec{
 						Subnets: infrav1.Subnets{
@@ -2714,23 +2848,6 @@ func TestCreateInstance(t *testing.T) {
 				},
 			},
 			expect: func(m *mocks.MockEC2APIMockRecorder) {
-				m.
-					DescribeInstanceTypes(gomock.Eq(&ec2.DescribeInstanceTypesInput{
-						InstanceTypes: []*string{
-							aws.String("m5.large"),
-						},
-					})).
-					Return(&ec2.DescribeInstanceTypesOutput{
-						InstanceTypes: []*ec2.InstanceTypeInfo{
-							{
-								ProcessorInfo: &ec2.ProcessorInfo{
-									SupportedArchitectures: []*string{
-										aws.String("x86_64"),
-									},
-								},
-							},
-						},
-					}, nil)
 				m. // TODO: Restore these parameters, but with the tags as well
 					RunInstances(gomock.Eq(&ec2.RunInstancesInput{
 						ImageId:      aws.String("abc"),
@@ -2739,7 +2856,6 @@ func TestCreateInstance(t *testing.T) {
 						MaxCount:     aws.Int64(1),
 						MinCount:     aws.Int64(1),
 						Placement: &ec2.Placement{
-							Tenancy:   &tenancy,
 							GroupName: aws.String("placement-group1"),
 						},
 						SecurityGroupIds: []*string{aws.String("2"), aws.String("3")},
@@ -2771,7 +2887,7 @@ func TestCreateInstance(t *testing.T) {
 								},
 							},
 						},
-						UserData: aws.String(base64.StdEncoding.EncodeToString(data)),
+						UserData: aws.String(base64.StdEncoding.EncodeToString(userDataCompressed)),
 					})).
 					Return(&ec2.Reservation{
 						Instances: []*ec2.Instance{
@@ -2797,7 +2913,24 @@ func TestCreateInstance(t *testing.T) {
 								},
 								Placement: &ec2.Placement{
 									AvailabilityZone: &az,
-									Tenancy:          &tenancy,
+									GroupName:        aws.String("placement-group1"),
+								},
+							},
+						},
+					}, nil)
+				m.
+					DescribeInstanceTypes(gomock.Eq(&ec2.DescribeInstanceTypesInput{
+						InstanceTypes: []*string{
+							aws.String("m5.large"),
+						},
+					})).
+					Return(&ec2.DescribeInstanceTypesOutput{
+						InstanceTypes: []*ec2.InstanceTypeInfo{
+							{
+								ProcessorInfo: &ec2.ProcessorInfo{
+									SupportedArchitectures: []*string{
+										aws.String("x86_64"),
+									},
 								},
 							},
 						},
@@ -2816,23 +2949,29 @@ func TestCreateInstance(t *testing.T) {
 			},
 		},
 		{
-			name: "expect the default SSH key when none is provided",
+			name: "with placement group partition cloud-config",
 			machine: clusterv1.Machine{
 				ObjectMeta: metav1.ObjectMeta{
-					Labels: map[string]string{"set": "node"},
+					Labels:    map[string]string{"set": "node"},
+					Namespace: "default",
+					Name:      "machine-aws-test1",
 				},
 				Spec: clusterv1.MachineSpec{
 					Bootstrap: clusterv1.Bootstrap{
 						DataSecretName: pointer.String("bootstrap-data"),
 					},
-					Version: pointer.String("v1.16.1"),
 				},
 			},
 			machineConfig: &infrav1.AWSMachineSpec{
-				InstanceType: "m5.large",
+				AMI: infrav1.AMIReference{
+					ID: aws.String("abc"),
+				},
+				InstanceType:            "m5.large",
+				PlacementGroupName:      "placement-group1",
+				PlacementGroupPartition: 2,
+				UncompressedUserData:    &isUncompressedFalse,
 			},
 			awsCluster: &infrav1.AWSCluster{
-				ObjectMeta: metav1.ObjectMeta{Name: "test"},
 				Spec: infrav1.AWSClusterSpec{
 					NetworkSpec: infrav1.NetworkSpec{
 						Subnets: infrav1.Subnets{
@@ -2866,6 +3005,78 @@ func TestCreateInstance(t *testing.T) {
 				},
 			},
 			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m. // TODO: Restore these parameters, but with the tags as well
+					RunInstances(gomock.Eq(&ec2.RunInstancesInput{
+						ImageId:      aws.String("abc"),
+						InstanceType: aws.String("m5.large"),
+						KeyName:      aws.String("default"),
+						MaxCount:     aws.Int64(1),
+						MinCount:     aws.Int64(1),
+						Placement: &ec2.Placement{
+							GroupName:       aws.String("placement-group1"),
+							PartitionNumber: aws.Int64(2),
+						},
+						SecurityGroupIds: []*string{aws.String("2"), aws.String("3")},
+						SubnetId:         aws.String("subnet-1"),
+						TagSpecifications: []*ec2.TagSpecification{
+							{
+								ResourceType: aws.String("instance"),
+								Tags: []*ec2.Tag{
+									{
+										Key:   aws.String("MachineName"),
+										Value: aws.String("default/machine-aws-test1"),
+									},
+									{
+										Key:   aws.String("Name"),
+										Value: aws.String("aws-test1"),
+									},
+									{
+										Key:   aws.String("kubernetes.io/cluster/test1"),
+										Value: aws.String("owned"),
+									},
+									{
+										Key:   aws.String("sigs.k8s.io/cluster-api-provider-aws/cluster/test1"),
+										Value: aws.String("owned"),
+									},
+									{
+										Key:   aws.String("sigs.k8s.io/cluster-api-provider-aws/role"),
+										Value: aws.String("node"),
+									},
+								},
+							},
+						},
+						UserData: aws.String(base64.StdEncoding.EncodeToString(userDataCompressed)),
+					})).
+					Return(&ec2.Reservation{
+						Instances: []*ec2.Instance{
+							{
+								State: &ec2.InstanceState{
+									Name: aws.String(ec2.InstanceStateNamePending),
+								},
+								IamInstanceProfile: &ec2.IamInstanceProfile{
+									Arn: aws.String("arn:aws:iam::123456789012:instance-profile/foo"),
+								},
+								InstanceId:     aws.String("two"),
+								InstanceType:   aws.String("m5.large"),
+								SubnetId:       aws.String("subnet-1"),
+								ImageId:        aws.String("ami-1"),
+								RootDeviceName: aws.String("device-1"),
+								BlockDeviceMappings: []*ec2.InstanceBlockDeviceMapping{
+									{
+										DeviceName: aws.String("device-1"),
+										Ebs: &ec2.EbsInstanceBlockDevice{
+											VolumeId: aws.String("volume-1"),
+										},
+									},
+								},
+								Placement: &ec2.Placement{
+									AvailabilityZone: &az,
+									GroupName:        aws.String("placement-group1"),
+									PartitionNumber:  aws.Int64(2),
+								},
+							},
+						},
+					}, nil)
 				m.
 					DescribeInstanceTypes(gomock.Eq(&ec2.DescribeInstanceTypesInput{
 						InstanceTypes: []*string{
@@ -2883,54 +3094,6 @@ func TestCreateInstance(t *testing.T) {
 							},
 						},
 					}, nil)
-				m.
-					DescribeImages(gomock.Any()).
-					Return(&ec2.DescribeImagesOutput{
-						Images: []*ec2.Image{
-							{
-								Name:         aws.String("ami-1"),
-								CreationDate: aws.String("2011-02-08T17:02:31.000Z"),
-							},
-						},
-					}, nil)
-				m. // TODO: Restore these parameters, but with the tags as well
-					RunInstances(gomock.Any()).
-					DoAndReturn(func(input *ec2.RunInstancesInput) (*ec2.Reservation, error) {
-						if input.KeyName == nil {
-							t.Fatal("Expected key name not to be nil")
-						}
-						if *input.KeyName != defaultSSHKeyName {
-							t.Fatalf("Expected SSH key name to be '%s', not '%s'", defaultSSHKeyName, *input.KeyName)
-						}
-						return &ec2.Reservation{
-							Instances: []*ec2.Instance{
-								{
-									State: &ec2.InstanceState{
-										Name: aws.String(ec2.InstanceStateNamePending),
-									},
-									IamInstanceProfile: &ec2.IamInstanceProfile{
-										Arn: aws.String("arn:aws:iam::123456789012:instance-profile/foo"),
-									},
-									InstanceId:     aws.String("two"),
-									InstanceType:   aws.String("m5.large"),
-									SubnetId:       aws.String("subnet-1"),
-									ImageId:        aws.String("ami-1"),
-									RootDeviceName: aws.String("device-1"),
-									BlockDeviceMappings: []*ec2.InstanceBlockDeviceMapping{
-										{
-											DeviceName: aws.String("device-1"),
-											Ebs: &ec2.EbsInstanceBlockDevice{
-												VolumeId: aws.String("volume-1"),
-											},
-										},
-									},
-									Placement: &ec2.Placement{
-										AvailabilityZone: &az,
-									},
-								},
-							},
-						}, nil
-					})
 				m.
 					DescribeNetworkInterfaces(gomock.Any()).
 					Return(&ec2.DescribeNetworkInterfacesOutput{
@@ -2945,23 +3108,33 @@ func TestCreateInstance(t *testing.T) {
 			},
 		},
 		{
-			name: "expect to use the cluster level ssh key name when no machine key name is provided",
+			name: "with maintenance options",
 			machine: clusterv1.Machine{
 				ObjectMeta: metav1.ObjectMeta{
-					Labels: map[string]string{"set": "node"},
+					Labels:    map[string]string{"set": "node"},
+					Namespace: "default",
+					Name:      "machine-aws-test1",
 				},
 				Spec: clusterv1.MachineSpec{
 					Bootstrap: clusterv1.Bootstrap{
 						DataSecretName: pointer.String("bootstrap-data"),
 					},
-					Version: pointer.String("v1.16.1"),
 				},
 			},
 			machineConfig: &infrav1.AWSMachineSpec{
+				AMI: infrav1.AMIReference{
+					ID: aws.String("abc"),
+				},
 				InstanceType: "m5.large",
+				MaintenanceOptions: &infrav1.InstanceMaintenanceOptions{
+					AutoRecovery:                      infrav1.AutoRecoveryStateDisabled,
+					DisableAPITermination:             aws.Bool(true),
+					DisableAPIStop:                    aws.Bool(true),
+					InstanceInitiatedShutdownBehavior: infrav1.ShutdownBehaviorTerminate,
+				},
+				UncompressedUserData: &isUncompressedFalse,
 			},
 			awsCluster: &infrav1.AWSCluster{
-				ObjectMeta: metav1.ObjectMeta{Name: "test"},
 				Spec: infrav1.AWSClusterSpec{
 					NetworkSpec: infrav1.NetworkSpec{
 						Subnets: infrav1.Subnets{
@@ -2974,7 +3147,6 @@ func TestCreateInstance(t *testing.T) {
 							},
 						},
 					},
-					SSHKeyName: aws.String("specific-cluster-key-name"),
 				},
 				Status: infrav1.AWSClusterStatus{
 					Network: infrav1.NetworkStatus{
@@ -2996,7 +3168,691 @@ func TestCreateInstance(t *testing.T) {
 				},
 			},
 			expect: func(m *mocks.MockEC2APIMockRecorder) {
-				m.
+				m. // TODO: Restore these parameters, but with the tags as well
+					RunInstances(gomock.Eq(&ec2.RunInstancesInput{
+						ImageId:      aws.String("abc"),
+						InstanceType: aws.String("m5.large"),
+						KeyName:      aws.String("default"),
+						MaxCount:     aws.Int64(1),
+						MinCount:     aws.Int64(1),
+						MaintenanceOptions: &ec2.InstanceMaintenanceOptionsRequest{
+							AutoRecovery: aws.String("disabled"),
+						},
+						DisableApiTermination:             aws.Bool(true),
+						DisableApiStop:                    aws.Bool(true),
+						InstanceInitiatedShutdownBehavior: aws.String("terminate"),
+						SecurityGroupIds:                  []*string{aws.String("2"), aws.String("3")},
+						SubnetId:                          aws.String("subnet-1"),
+						TagSpecifications: []*ec2.TagSpecification{
+							{
+								ResourceType: aws.String("instance"),
+								Tags: []*ec2.Tag{
+									{
+										Key:   aws.String("MachineName"),
+										Value: aws.String("default/machine-aws-test1"),
+									},
+									{
+										Key:   aws.String("Name"),
+										Value: aws.String("aws-test1"),
+									},
+									{
+										Key:   aws.String("kubernetes.io/cluster/test1"),
+										Value: aws.String("owned"),
+									},
+									{
+										Key:   aws.String("sigs.k8s.io/cluster-api-provider-aws/cluster/test1"),
+										Value: aws.String("owned"),
+									},
+									{
+										Key:   aws.String("sigs.k8s.io/cluster-api-provider-aws/role"),
+										Value: aws.String("node"),
+									},
+								},
+							},
+						},
+						UserData: aws.String(base64.StdEncoding.EncodeToString(userDataCompressed)),
+					})).
+					Return(&ec2.Reservation{
+						Instances: []*ec2.Instance{
+							{
+								State: &ec2.InstanceState{
+									Name: aws.String(ec2.InstanceStateNamePending),
+								},
+								IamInstanceProfile: &ec2.IamInstanceProfile{
+									Arn: aws.String("arn:aws:iam::123456789012:instance-profile/foo"),
+								},
+								InstanceId:     aws.String("two"),
+								InstanceType:   aws.String("m5.large"),
+								SubnetId:       aws.String("subnet-1"),
+								ImageId:        aws.String("ami-1"),
+								RootDeviceName: aws.String("device-1"),
+								BlockDeviceMappings: []*ec2.InstanceBlockDeviceMapping{
+									{
+										DeviceName: aws.String("device-1"),
+										Ebs: &ec2.EbsInstanceBlockDevice{
+											VolumeId: aws.String("volume-1"),
+										},
+									},
+								},
+								Placement: &ec2.Placement{
+									AvailabilityZone: &az,
+								},
+								MaintenanceOptions: &ec2.InstanceMaintenanceOptions{
+									AutoRecovery: aws.String("disabled"),
+								},
+							},
+						},
+					}, nil)
+				m.
+					DescribeInstanceTypes(gomock.Eq(&ec2.DescribeInstanceTypesInput{
+						InstanceTypes: []*string{
+							aws.String("m5.large"),
+						},
+					})).
+					Return(&ec2.DescribeInstanceTypesOutput{
+						InstanceTypes: []*ec2.InstanceTypeInfo{
+							{
+								ProcessorInfo: &ec2.ProcessorInfo{
+									SupportedArchitectures: []*string{
+										aws.String("x86_64"),
+									},
+								},
+							},
+						},
+					}, nil)
+				m.
+					DescribeNetworkInterfaces(gomock.Any()).
+					Return(&ec2.DescribeNetworkInterfacesOutput{
+						NetworkInterfaces: []*ec2.NetworkInterface{},
+						NextToken:         nil,
+					}, nil)
+			},
+			check: func(instance *infrav1.Instance, err error) {
+				if err != nil {
+					t.Fatalf("did not expect error: %v", err)
+				}
+
+				expected := &infrav1.InstanceMaintenanceOptions{AutoRecovery: infrav1.AutoRecoveryStateDisabled}
+				if diff := cmp.Diff(expected, instance.MaintenanceOptions); diff != "" {
+					t.Fatalf("unexpected maintenance options (-want +got):\n%s", diff)
+				}
+			},
+		},
+		{
+			name: "with additional network interfaces",
+			machine: clusterv1.Machine{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels:    map[string]string{"set": "node"},
+					Namespace: "default",
+					Name:      "machine-aws-test1",
+				},
+				Spec: clusterv1.MachineSpec{
+					Bootstrap: clusterv1.Bootstrap{
+						DataSecretName: pointer.String("bootstrap-data"),
+					},
+				},
+			},
+			machineConfig: &infrav1.AWSMachineSpec{
+				AMI: infrav1.AMIReference{
+					ID: aws.String("abc"),
+				},
+				InstanceType: "m5.large",
+				AdditionalNetworkInterfaces: []infrav1.AdditionalNetworkInterface{
+					{
+						DeviceIndex:   1,
+						Subnet:        &infrav1.AWSResourceReference{ID: aws.String("subnet-2")},
+						InterfaceType: infrav1.NetworkInterfaceTypeEFA,
+					},
+				},
+				UncompressedUserData: &isUncompressedFalse,
+			},
+			awsCluster: &infrav1.AWSCluster{
+				Spec: infrav1.AWSClusterSpec{
+					NetworkSpec: infrav1.NetworkSpec{
+						Subnets: infrav1.Subnets{
+							infrav1.SubnetSpec{
+								ID:       "subnet-1",
+								IsPublic: false,
+							},
+							infrav1.SubnetSpec{
+								IsPublic: false,
+							},
+						},
+					},
+				},
+				Status: infrav1.AWSClusterStatus{
+					Network: infrav1.NetworkStatus{
+						SecurityGroups: map[infrav1.SecurityGroupRole]infrav1.SecurityGroup{
+							infrav1.SecurityGroupControlPlane: {
+								ID: "1",
+							},
+							infrav1.SecurityGroupNode: {
+								ID: "2",
+							},
+							infrav1.SecurityGroupLB: {
+								ID: "3",
+							},
+						},
+						APIServerELB: infrav1.LoadBalancer{
+							DNSName: "test-apiserver.us-east-1.aws",
+						},
+					},
+				},
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m. // TODO: Restore these parameters, but with the tags as well
+					RunInstances(gomock.Eq(&ec2.RunInstancesInput{
+						ImageId:      aws.String("abc"),
+						InstanceType: aws.String("m5.large"),
+						KeyName:      aws.String("default"),
+						MaxCount:     aws.Int64(1),
+						MinCount:     aws.Int64(1),
+						NetworkInterfaces: []*ec2.InstanceNetworkInterfaceSpecification{
+							{
+								DeviceIndex: aws.Int64(0),
+								SubnetId:    aws.String("subnet-1"),
+								Groups:      []*string{aws.String("2"), aws.String("3")},
+							},
+							{
+								DeviceIndex:         aws.Int64(1),
+								SubnetId:            aws.String("subnet-2"),
+								Groups:              []*string{aws.String("2"), aws.String("3")},
+								InterfaceType:       aws.String("efa"),
+								DeleteOnTermination: aws.Bool(true),
+							},
+						},
+						TagSpecifications: []*ec2.TagSpecification{
+							{
+								ResourceType: aws.String("instance"),
+								Tags: []*ec2.Tag{
+									{
+										Key:   aws.String("MachineName"),
+										Value: aws.String("default/machine-aws-test1"),
+									},
+									{
+										Key:   aws.String("Name"),
+										Value: aws.String("aws-test1"),
+									},
+									{
+										Key:   aws.String("kubernetes.io/cluster/test1"),
+										Value: aws.String("owned"),
+									},
+									{
+										Key:   aws.String("sigs.k8s.io/cluster-api-provider-aws/cluster/test1"),
+										Value: aws.String("owned"),
+									},
+									{
+										Key:   aws.String("sigs.k8s.io/cluster-api-provider-aws/role"),
+										Value: aws.String("node"),
+									},
+								},
+							},
+							{
+								ResourceType: aws.String("network-interface"),
+								Tags: []*ec2.Tag{
+									{
+										Key:   aws.String("MachineName"),
+										Value: aws.String("default/machine-aws-test1"),
+									},
+									{
+										Key:   aws.String("Name"),
+										Value: aws.String("aws-test1"),
+									},
+									{
+										Key:   aws.String("kubernetes.io/cluster/test1"),
+										Value: aws.String("owned"),
+									},
+									{
+										Key:   aws.String("sigs.k8s.io/cluster-api-provider-aws/cluster/test1"),
+										Value: aws.String("owned"),
+									},
+									{
+										Key:   aws.String("sigs.k8s.io/cluster-api-provider-aws/network-interface-lifecycle"),
+										Value: aws.String("owned"),
+									},
+									{
+										Key:   aws.String("sigs.k8s.io/cluster-api-provider-aws/role"),
+										Value: aws.String("node"),
+									},
+								},
+							},
+						},
+						UserData: aws.String(base64.StdEncoding.EncodeToString(userDataCompressed)),
+					})).
+					Return(&ec2.Reservation{
+						Instances: []*ec2.Instance{
+							{
+								State: &ec2.InstanceState{
+									Name: aws.String(ec2.InstanceStateNamePending),
+								},
+								IamInstanceProfile: &ec2.IamInstanceProfile{
+									Arn: aws.String("arn:aws:iam::123456789012:instance-profile/foo"),
+								},
+								InstanceId:     aws.String("two"),
+								InstanceType:   aws.String("m5.large"),
+								SubnetId:       aws.String("subnet-1"),
+								ImageId:        aws.String("ami-1"),
+								RootDeviceName: aws.String("device-1"),
+								BlockDeviceMappings: []*ec2.InstanceBlockDeviceMapping{
+									{
+										DeviceName: aws.String("device-1"),
+										Ebs: &ec2.EbsInstanceBlockDevice{
+											VolumeId: aws.String("volume-1"),
+										},
+									},
+								},
+								Placement: &ec2.Placement{
+									AvailabilityZone: &az,
+								},
+								NetworkInterfaces: []*ec2.InstanceNetworkInterface{
+									{
+										NetworkInterfaceId: aws.String("eni-primary"),
+										SubnetId:           aws.String("subnet-1"),
+										Attachment: &ec2.InstanceNetworkInterfaceAttachment{
+											DeviceIndex:         aws.Int64(0),
+											DeleteOnTermination: aws.Bool(true),
+										},
+									},
+									{
+										NetworkInterfaceId: aws.String("eni-efa"),
+										SubnetId:           aws.String("subnet-2"),
+										InterfaceType:      aws.String("efa"),
+										PrivateIpAddress:   aws.String("10.0.1.10"),
+										Attachment: &ec2.InstanceNetworkInterfaceAttachment{
+											DeviceIndex:         aws.Int64(1),
+											DeleteOnTermination: aws.Bool(true),
+										},
+									},
+								},
+							},
+						},
+					}, nil)
+				m.
+					DescribeInstanceTypes(gomock.Eq(&ec2.DescribeInstanceTypesInput{
+						InstanceTypes: []*string{
+							aws.String("m5.large"),
+						},
+					})).
+					Return(&ec2.DescribeInstanceTypesOutput{
+						InstanceTypes: []*ec2.InstanceTypeInfo{
+							{
+								ProcessorInfo: &ec2.ProcessorInfo{
+									SupportedArchitectures: []*string{
+										aws.String("x86_64"),
+									},
+								},
+							},
+						},
+					}, nil)
+				m.
+					DescribeNetworkInterfaces(gomock.Any()).
+					Return(&ec2.DescribeNetworkInterfacesOutput{
+						NetworkInterfaces: []*ec2.NetworkInterface{},
+						NextToken:         nil,
+					}, nil)
+			},
+			check: func(instance *infrav1.Instance, err error) {
+				if err != nil {
+					t.Fatalf("did not expect error: %v", err)
+				}
+
+				expected := []infrav1.NetworkInterfaceAttachment{
+					{
+						ID:                  "eni-efa",
+						DeviceIndex:         1,
+						SubnetID:            "subnet-2",
+						InterfaceType:       infrav1.NetworkInterfaceTypeEFA,
+						PrivateIPAddress:    "10.0.1.10",
+						DeleteOnTermination: true,
+					},
+				}
+				if diff := cmp.Diff(expected, instance.NetworkInterfaceAttachments); diff != "" {
+					t.Fatalf("unexpected network interface attachments (-want +got):\n%s", diff)
+				}
+			},
+		},
+		{
+			name: "with dedicated tenancy and placement group ignition",
+			machine: clusterv1.Machine{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels:    map[string]string{"set": "node"},
+					Namespace: "default",
+					Name:      "machine-aws-test1",
+				},
+				Spec: clusterv1.MachineSpec{
+					Bootstrap: clusterv1.Bootstrap{
+						DataSecretName: pointer.String("bootstrap-data"),
+					},
+				},
+			},
+			machineConfig: &infrav1.AWSMachineSpec{
+				AMI: infrav1.AMIReference{
+					ID: aws.String("abc"),
+				},
+				InstanceType:         "m5.large",
+				Tenancy:              "dedicated",
+				PlacementGroupName:   "placement-group1",
+				UncompressedUserData: &isUncompressedTrue,
+				Ignition:             &infrav1.Ignition{},
+			},
+			awsCluster: &infrav1.AWSCluster{
+				ObjectMeta: metav1.ObjectMeta{Name: "test"},
+				Spec: infrav1.AWSClusterSpec{
+					NetworkSpec: infrav1.NetworkSpec{
+						Subnets: infrav1.Subnets{
+							infrav1.SubnetSpec{
+								ID:       "subnet-1",
+								IsPublic: false,
+							},
+							infrav1.SubnetSpec{
+								IsPublic: false,
+							},
+						},
+					},
+				},
+				Status: infrav1.AWSClusterStatus{
+					Network: infrav1.NetworkStatus{
+						SecurityGroups: map[infrav1.SecurityGroupRole]infrav1.SecurityGroup{
+							infrav1.SecurityGroupControlPlane: {
+								ID: "1",
+							},
+							infrav1.SecurityGroupNode: {
+								ID: "2",
+							},
+							infrav1.SecurityGroupLB: {
+								ID: "3",
+							},
+						},
+						APIServerELB: infrav1.LoadBalancer{
+							DNSName: "test-apiserver.us-east-1.aws",
+						},
+					},
+				},
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.
+					DescribeInstanceTypes(gomock.Eq(&ec2.DescribeInstanceTypesInput{
+						InstanceTypes: []*string{
+							aws.String("m5.large"),
+						},
+					})).
+					Return(&ec2.DescribeInstanceTypesOutput{
+						InstanceTypes: []*ec2.InstanceTypeInfo{
+							{
+								ProcessorInfo: &ec2.ProcessorInfo{
+									SupportedArchitectures: []*string{
+										aws.String("x86_64"),
+									},
+								},
+							},
+						},
+					}, nil)
+				m. // TODO: Restore these parameters, but with the tags as well
+					RunInstances(gomock.Eq(&ec2.RunInstancesInput{
+						ImageId:      aws.String("abc"),
+						InstanceType: aws.String("m5.large"),
+						KeyName:      aws.String("default"),
+						MaxCount:     aws.Int64(1),
+						MinCount:     aws.Int64(1),
+						Placement: &ec2.Placement{
+							Tenancy:   &tenancy,
+							GroupName: aws.String("placement-group1"),
+						},
+						SecurityGroupIds: []*string{aws.String("2"), aws.String("3")},
+						SubnetId:         aws.String("subnet-1"),
+						TagSpecifications: []*ec2.TagSpecification{
+							{
+								ResourceType: aws.String("instance"),
+								Tags: []*ec2.Tag{
+									{
+										Key:   aws.String("MachineName"),
+										Value: aws.String("default/machine-aws-test1"),
+									},
+									{
+										Key:   aws.String("Name"),
+										Value: aws.String("aws-test1"),
+									},
+									{
+										Key:   aws.String("kubernetes.io/cluster/test1"),
+										Value: aws.String("owned"),
+									},
+									{
+										Key:   aws.String("sigs.k8s.io/cluster-api-provider-aws/cluster/test1"),
+										Value: aws.String("owned"),
+									},
+									{
+										Key:   aws.String("sigs.k8s.io/cluster-api-provider-aws/role"),
+										Value: aws.String("node"),
+									},
+								},
+							},
+						},
+						UserData: aws.String(base64.StdEncoding.EncodeToString(data)),
+					})).
+					Return(&ec2.Reservation{
+						Instances: []*ec2.Instance{
+							{
+								State: &ec2.InstanceState{
+									Name: aws.String(ec2.InstanceStateNamePending),
+								},
+								IamInstanceProfile: &ec2.IamInstanceProfile{
+									Arn: aws.String("arn:aws:iam::123456789012:instance-profile/foo"),
+								},
+								InstanceId:     aws.String("two"),
+								InstanceType:   aws.String("m5.large"),
+								SubnetId:       aws.String("subnet-1"),
+								ImageId:        aws.String("ami-1"),
+								RootDeviceName: aws.String("device-1"),
+								BlockDeviceMappings: []*ec2.InstanceBlockDeviceMapping{
+									{
+										DeviceName: aws.String("device-1"),
+										Ebs: &ec2.EbsInstanceBlockDevice{
+											VolumeId: aws.String("volume-1"),
+										},
+									},
+								},
+								Placement: &ec2.Placement{
+									AvailabilityZone: &az,
+									Tenancy:          &tenancy,
+								},
+							},
+						},
+					}, nil)
+				m.
+					DescribeNetworkInterfaces(gomock.Any()).
+					Return(&ec2.DescribeNetworkInterfacesOutput{
+						NetworkInterfaces: []*ec2.NetworkInterface{},
+						NextToken:         nil,
+					}, nil)
+			},
+			check: func(instance *infrav1.Instance, err error) {
+				if err != nil {
+					t.Fatalf("did not expect error: %v", err)
+				}
+			},
+		},
+		{
+			name: "expect the default SSH key when none is provided",
+			machine: clusterv1.Machine{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{"set": "node"},
+				},
+				Spec: clusterv1.MachineSpec{
+					Bootstrap: clusterv1.Bootstrap{
+						DataSecretName: pointer.String("bootstrap-data"),
+					},
+					Version: pointer.String("v1.16.1"),
+				},
+			},
+			machineConfig: &infrav1.AWSMachineSpec{
+				InstanceType: "m5.large",
+			},
+			awsCluster: &infrav1.AWSCluster{
+				ObjectMeta: metav1.ObjectMeta{Name: "test"},
+				Spec: infrav1.AWSClusterSpec{
+					NetworkSpec: infrav1.NetworkSpec{
+						Subnets: infrav1.Subnets{
+							infrav1.SubnetSpec{
+								ID:       "subnet-1",
+								IsPublic: false,
+							},
+							infrav1.SubnetSpec{
+								IsPublic: false,
+							},
+						},
+					},
+				},
+				Status: infrav1.AWSClusterStatus{
+					Network: infrav1.NetworkStatus{
+						SecurityGroups: map[infrav1.SecurityGroupRole]infrav1.SecurityGroup{
+							infrav1.SecurityGroupControlPlane: {
+								ID: "1",
+							},
+							infrav1.SecurityGroupNode: {
+								ID: "2",
+							},
+							infrav1.SecurityGroupLB: {
+								ID: "3",
+							},
+						},
+						APIServerELB: infrav1.LoadBalancer{
+							DNSName: "test-apiserver.us-east-1.aws",
+						},
+					},
+				},
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.
+					DescribeInstanceTypes(gomock.Eq(&ec2.DescribeInstanceTypesInput{
+						InstanceTypes: []*string{
+							aws.String("m5.large"),
+						},
+					})).
+					Return(&ec2.DescribeInstanceTypesOutput{
+						InstanceTypes: []*ec2.InstanceTypeInfo{
+							{
+								ProcessorInfo: &ec2.ProcessorInfo{
+									SupportedArchitectures: []*string{
+										aws.String("x86_64"),
+									},
+								},
+							},
+						},
+					}, nil)
+				m.
+					DescribeImages(gomock.Any()).
+					Return(&ec2.DescribeImagesOutput{
+						Images: []*ec2.Image{
+							{
+								Name:         aws.String("ami-1"),
+								CreationDate: aws.String("2011-02-08T17:02:31.000Z"),
+							},
+						},
+					}, nil)
+				m. // TODO: Restore these parameters, but with the tags as well
+					RunInstances(gomock.Any()).
+					DoAndReturn(func(input *ec2.RunInstancesInput) (*ec2.Reservation, error) {
+						if input.KeyName == nil {
+							t.Fatal("Expected key name not to be nil")
+						}
+						if *input.KeyName != defaultSSHKeyName {
+							t.Fatalf("Expected SSH key name to be '%s', not '%s'", defaultSSHKeyName, *input.KeyName)
+						}
+						return &ec2.Reservation{
+							Instances: []*ec2.Instance{
+								{
+									State: &ec2.InstanceState{
+										Name: aws.String(ec2.InstanceStateNamePending),
+									},
+									IamInstanceProfile: &ec2.IamInstanceProfile{
+										Arn: aws.String("arn:aws:iam::123456789012:instance-profile/foo"),
+									},
+									InstanceId:     aws.String("two"),
+									InstanceType:   aws.String("m5.large"),
+									SubnetId:       aws.String("subnet-1"),
+									ImageId:        aws.String("ami-1"),
+									RootDeviceName: aws.String("device-1"),
+									BlockDeviceMappings: []*ec2.InstanceBlockDeviceMapping{
+										{
+											DeviceName: aws.String("device-1"),
+											Ebs: &ec2.EbsInstanceBlockDevice{
+												VolumeId: aws.String("volume-1"),
+											},
+										},
+									},
+									Placement: &ec2.Placement{
+										AvailabilityZone: &az,
+									},
+								},
+							},
+						}, nil
+					})
+				m.
+					DescribeNetworkInterfaces(gomock.Any()).
+					Return(&ec2.DescribeNetworkInterfacesOutput{
+						NetworkInterfaces: []*ec2.NetworkInterface{},
+						NextToken:         nil,
+					}, nil)
+			},
+			check: func(instance *infrav1.Instance, err error) {
+				if err != nil {
+					t.Fatalf("did not expect error: %v", err)
+				}
+			},
+		},
+		{
+			name: "expect to use the cluster level ssh key name when no machine key name is provided",
+			machine: clusterv1.Machine{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{"set": "node"},
+				},
+				Spec: clusterv1.MachineSpec{
+					Bootstrap: clusterv1.Bootstrap{
+						DataSecretName: pointer.String("bootstrap-data"),
+					},
+					Version: pointer.String("v1.16.1"),
+				},
+			},
+			machineConfig: &infrav1.AWSMachineSpec{
+				InstanceType: "m5.large",
+			},
+			awsCluster: &infrav1.AWSCluster{
+				ObjectMeta: metav1.ObjectMeta{Name: "test"},
+				Spec: infrav1.AWSClusterSpec{
+					NetworkSpec: infrav1.NetworkSpec{
+						Subnets: infrav1.Subnets{
+							infrav1.SubnetSpec{
+								ID:       "subnet-1",
+								IsPublic: false,
+							},
+							infrav1.SubnetSpec{
+								IsPublic: false,
+							},
+						},
+					},
+					SSHKeyName: aws.String("specific-cluster-key-name"),
+				},
+				Status: infrav1.AWSClusterStatus{
+					Network: infrav1.NetworkStatus{
+						SecurityGroups: map[infrav1.SecurityGroupRole]infrav1.SecurityGroup{
+							infrav1.SecurityGroupControlPlane: {
+								ID: "1",
+							},
+							infrav1.SecurityGroupNode: {
+								ID: "2",
+							},
+							infrav1.SecurityGroupLB: {
+								ID: "3",
+							},
+						},
+						APIServerELB: infrav1.LoadBalancer{
+							DNSName: "test-apiserver.us-east-1.aws",
+						},
+					},
+				},
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.
 					DescribeInstanceTypes(gomock.Eq(&ec2.DescribeInstanceTypesInput{
 						InstanceTypes: []*string{
 							aws.String("m5.large"),
@@ -3726,6 +4582,171 @@ func TestGetInstanceMarketOptionsRequest(t *testing.T) {
 	}
 }
 
+func TestGetCapacityReservationSpecificationRequest(t *testing.T) {
+	testCases := []struct {
+		name                string
+		capacityReservation *infrav1.CapacityReservationSpecification
+		expectedRequest     *ec2.CapacityReservationSpecification
+	}{
+		{
+			name:                "with no capacity reservation specified",
+			capacityReservation: nil,
+			expectedRequest:     nil,
+		},
+		{
+			name: "with a preference specified",
+			capacityReservation: &infrav1.CapacityReservationSpecification{
+				Preference: infrav1.CapacityReservationPreferenceNone,
+			},
+			expectedRequest: &ec2.CapacityReservationSpecification{
+				CapacityReservationPreference: aws.String(ec2.CapacityReservationPreferenceNone),
+			},
+		},
+		{
+			name: "with a capacity reservation id specified",
+			capacityReservation: &infrav1.CapacityReservationSpecification{
+				Target: &infrav1.CapacityReservationTarget{
+					ID: aws.String("cr-12345"),
+				},
+			},
+			expectedRequest: &ec2.CapacityReservationSpecification{
+				CapacityReservationTarget: &ec2.CapacityReservationTarget{
+					CapacityReservationId: aws.String("cr-12345"),
+				},
+			},
+		},
+		{
+			name: "with a capacity reservation resource group specified",
+			capacityReservation: &infrav1.CapacityReservationSpecification{
+				Target: &infrav1.CapacityReservationTarget{
+					ResourceGroupARN: aws.String("arn:aws:resource-groups:us-east-1:123456789012:group/reservations"),
+				},
+			},
+			expectedRequest: &ec2.CapacityReservationSpecification{
+				CapacityReservationTarget: &ec2.CapacityReservationTarget{
+					CapacityReservationResourceGroupArn: aws.String("arn:aws:resource-groups:us-east-1:123456789012:group/reservations"),
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			request := getCapacityReservationSpecificationRequest(tc.capacityReservation)
+			if !cmp.Equal(request, tc.expectedRequest) {
+				t.Errorf("Case: %s. Got: %v, expected: %v", tc.name, request, tc.expectedRequest)
+			}
+		})
+	}
+}
+
+func TestGetPrivateDNSNameOptionsRequest(t *testing.T) {
+	testCases := []struct {
+		name            string
+		privateDNSName  *infrav1.PrivateDNSName
+		expectedRequest *ec2.PrivateDnsNameOptionsRequest
+	}{
+		{
+			name:            "with no private DNS name options specified",
+			privateDNSName:  nil,
+			expectedRequest: nil,
+		},
+		{
+			name: "with resource name hostnames and DNS records specified",
+			privateDNSName: &infrav1.PrivateDNSName{
+				HostnameType:                    infrav1.HostnameTypeResourceName,
+				EnableResourceNameDNSARecord:    aws.Bool(true),
+				EnableResourceNameDNSAAAARecord: aws.Bool(true),
+			},
+			expectedRequest: &ec2.PrivateDnsNameOptionsRequest{
+				HostnameType:                    aws.String(ec2.HostnameTypeResourceName),
+				EnableResourceNameDnsARecord:    aws.Bool(true),
+				EnableResourceNameDnsAAAARecord: aws.Bool(true),
+			},
+		},
+		{
+			name: "with only DNS records specified",
+			privateDNSName: &infrav1.PrivateDNSName{
+				EnableResourceNameDNSAAAARecord: aws.Bool(false),
+			},
+			expectedRequest: &ec2.PrivateDnsNameOptionsRequest{
+				EnableResourceNameDnsAAAARecord: aws.Bool(false),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			request := getPrivateDNSNameOptionsRequest(tc.privateDNSName)
+			if !cmp.Equal(request, tc.expectedRequest) {
+				t.Errorf("Case: %s. Got: %v, expected: %v", tc.name, request, tc.expectedRequest)
+			}
+		})
+	}
+}
+
+func TestGetInstanceAddresses(t *testing.T) {
+	testCases := []struct {
+		name     string
+		instance *ec2.Instance
+		expected []clusterv1.MachineAddress
+	}{
+		{
+			name: "ip name hostname",
+			instance: &ec2.Instance{
+				PrivateDnsName: aws.String("ip-10-0-0-1.ec2.internal"),
+				NetworkInterfaces: []*ec2.InstanceNetworkInterface{
+					{
+						PrivateDnsName:   aws.String("ip-10-0-0-1.ec2.internal"),
+						PrivateIpAddress: aws.String("10.0.0.1"),
+					},
+				},
+			},
+			expected: []clusterv1.MachineAddress{
+				{Type: clusterv1.MachineInternalDNS, Address: "ip-10-0-0-1.ec2.internal"},
+				{Type: clusterv1.MachineInternalIP, Address: "10.0.0.1"},
+			},
+		},
+		{
+			name: "resource name hostname with IPv6 and public addresses",
+			instance: &ec2.Instance{
+				PrivateDnsName: aws.String("i-0123456789abcdef0.ec2.internal"),
+				NetworkInterfaces: []*ec2.InstanceNetworkInterface{
+					{
+						PrivateDnsName:   aws.String("ip-10-0-0-1.ec2.internal"),
+						PrivateIpAddress: aws.String("10.0.0.1"),
+						Ipv6Addresses: []*ec2.InstanceIpv6Address{
+							{Ipv6Address: aws.String("2001:db8::1")},
+						},
+						Association: &ec2.InstanceNetworkInterfaceAssociation{
+							PublicDnsName: aws.String("ec2-1-2-3-4.compute-1.amazonaws.com"),
+							PublicIp:      aws.String("1.2.3.4"),
+						},
+					},
+				},
+			},
+			expected: []clusterv1.MachineAddress{
+				{Type: clusterv1.MachineInternalDNS, Address: "i-0123456789abcdef0.ec2.internal"},
+				{Type: clusterv1.MachineInternalDNS, Address: "ip-10-0-0-1.ec2.internal"},
+				{Type: clusterv1.MachineInternalIP, Address: "10.0.0.1"},
+				{Type: clusterv1.MachineInternalIP, Address: "2001:db8::1"},
+				{Type: clusterv1.MachineExternalDNS, Address: "ec2-1-2-3-4.compute-1.amazonaws.com"},
+				{Type: clusterv1.MachineExternalIP, Address: "1.2.3.4"},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			s := &Service{}
+			addresses := s.getInstanceAddresses(tc.instance)
+			if !cmp.Equal(addresses, tc.expected) {
+				t.Errorf("Case: %s. Got: %v, expected: %v", tc.name, addresses, tc.expected)
+			}
+		})
+	}
+}
+
 func TestGetFilteredSecurityGroupID(t *testing.T) {
 	mockCtrl := gomock.NewController(t)
 	defer mockCtrl.Finish()
@@ -482,6 +482,17 @@ func (s *Service) createLaunchTemplateData(scope scope.LaunchTemplateScope, imag
 	data.ImageId = imageID
 
 	data.InstanceMarketOptions = getLaunchTemplateInstanceMarketOptionsRequest(scope.GetLaunchTemplate().SpotMarketOptions)
+	data.CapacityReservationSpecification = getLaunchTemplateCapacityReservationSpecificationRequest(lt.CapacityReservation)
+	data.PrivateDnsNameOptions = getLaunchTemplatePrivateDNSNameOptionsRequest(lt.PrivateDNSName)
+
+	if lt.MaintenanceOptions != nil {
+		data.MaintenanceOptions = getLaunchTemplateInstanceMaintenanceOptionsRequest(lt.MaintenanceOptions)
+		data.DisableApiTermination = lt.MaintenanceOptions.DisableAPITermination
+		data.DisableApiStop = lt.MaintenanceOptions.DisableAPIStop
+		if lt.MaintenanceOptions.InstanceInitiatedShutdownBehavior != "" {
+			data.InstanceInitiatedShutdownBehavior = aws.String(string(lt.MaintenanceOptions.InstanceInitiatedShutdownBehavior))
+		}
+	}
 
 	// Set up root volume
 	if lt.RootVolume != nil {
@@ -650,6 +661,37 @@ func (s *Service) SDKToLaunchTemplate(d *ec2.LaunchTemplateVersion) (*expinfrav1
 		}
 	}
 
+	if v.CapacityReservationSpecification != nil {
+		i.CapacityReservation = &infrav1.CapacityReservationSpecification{
+			Preference: infrav1.CapacityReservationPreference(aws.StringValue(v.CapacityReservationSpecification.CapacityReservationPreference)),
+		}
+		if target := v.CapacityReservationSpecification.CapacityReservationTarget; target != nil {
+			i.CapacityReservation.Target = &infrav1.CapacityReservationTarget{
+				ID:               target.CapacityReservationId,
+				ResourceGroupARN: target.CapacityReservationResourceGroupArn,
+			}
+		}
+	}
+
+	if v.PrivateDnsNameOptions != nil {
+		i.PrivateDNSName = &infrav1.PrivateDNSName{
+			HostnameType:                    infrav1.HostnameType(aws.StringValue(v.PrivateDnsNameOptions.HostnameType)),
+			EnableResourceNameDNSARecord:    v.PrivateDnsNameOptions.EnableResourceNameDnsARecord,
+			EnableResourceNameDNSAAAARecord: v.PrivateDnsNameOptions.EnableResourceNameDnsAAAARecord,
+		}
+	}
+
+	if v.MaintenanceOptions != nil || v.DisableApiTermination != nil || v.DisableApiStop != nil || v.InstanceInitiatedShutdownBehavior != nil {
+		i.MaintenanceOptions = &infrav1.InstanceMaintenanceOptions{
+			DisableAPITermination:             v.DisableApiTermination,
+			DisableAPIStop:                    v.DisableApiStop,
+			InstanceInitiatedShutdownBehavior: infrav1.ShutdownBehavior(aws.StringValue(v.InstanceInitiatedShutdownBehavior)),
+		}
+		if v.MaintenanceOptions != nil {
+			i.MaintenanceOptions.AutoRecovery = infrav1.AutoRecoveryState(aws.StringValue(v.MaintenanceOptions.AutoRecovery))
+		}
+	}
+
 	for _, id := range v.SecurityGroupIds {
 		// FIXME(dlipovetsky): This will include the core security groups as well, making the
 		// "Additional" a bit dishonest. However, including the core groups drastically simplifies
@@ -681,6 +723,23 @@ func (s *Service) LaunchTemplateNeedsUpdate(scope scope.LaunchTemplateScope, inc
 		return true, nil
 	}
 
+	if !cmp.Equal(incoming.CapacityReservation, existing.CapacityReservation) {
+		return true, nil
+	}
+
+	if !cmp.Equal(incoming.PrivateDNSName, existing.PrivateDNSName) {
+		return true, nil
+	}
+
+	// Empty maintenance options are not stored in the launch template.
+	incomingMaintenanceOptions := incoming.MaintenanceOptions
+	if incomingMaintenanceOptions != nil && cmp.Equal(*incomingMaintenanceOptions, infrav1.InstanceMaintenanceOptions{}) {
+		incomingMaintenanceOptions = nil
+	}
+	if !cmp.Equal(incomingMaintenanceOptions, existing.MaintenanceOptions) {
+		return true, nil
+	}
+
 	incomingIDs, err := s.GetAdditionalSecurityGroupsIDs(incoming.AdditionalSecurityGroups)
 	if err != nil {
 		return false, err
@@ -880,3 +939,48 @@ func getLaunchTemplateInstanceMarketOptionsRequest(spotMarketOptions *infrav1.Sp
 
 	return launchTemplateInstanceMarketOptionsRequest
 }
+
+func getLaunchTemplateCapacityReservationSpecificationRequest(capacityReservation *infrav1.CapacityReservationSpecification) *ec2.LaunchTemplateCapacityReservationSpecificationRequest {
+	if capacityReservation == nil {
+		return nil
+	}
+
+	request := &ec2.LaunchTemplateCapacityReservationSpecificationRequest{}
+	if capacityReservation.Preference != "" {
+		request.SetCapacityReservationPreference(string(capacityReservation.Preference))
+	}
+	if capacityReservation.Target != nil {
+		request.SetCapacityReservationTarget(&ec2.CapacityReservationTarget{
+			CapacityReservationId:               capacityReservation.Target.ID,
+			CapacityReservationResourceGroupArn: capacityReservation.Target.ResourceGroupARN,
+		})
+	}
+
+	return request
+}
+
+func getLaunchTemplateInstanceMaintenanceOptionsRequest(maintenanceOptions *infrav1.InstanceMaintenanceOptions) *ec2.LaunchTemplateInstanceMaintenanceOptionsRequest {
+	if maintenanceOptions == nil || maintenanceOptions.AutoRecovery == "" {
+		return nil
+	}
+
+	return &ec2.LaunchTemplateInstanceMaintenanceOptionsRequest{
+		AutoRecovery: aws.String(string(maintenanceOptions.AutoRecovery)),
+	}
+}
+
+func getLaunchTemplatePrivateDNSNameOptionsRequest(privateDNSName *infrav1.PrivateDNSName) *ec2.LaunchTemplatePrivateDnsNameOptionsRequest {
+	if privateDNSName == nil {
+		return nil
+	}
+
+	request := &ec2.LaunchTemplatePrivateDnsNameOptionsRequest{
+		EnableResourceNameDnsARecord:    privateDNSName.EnableResourceNameDNSARecord,
+		EnableResourceNameDnsAAAARecord: privateDNSName.EnableResourceNameDNSAAAARecord,
+	}
+	if privateDNSName.HostnameType != "" {
+		request.SetHostnameType(string(privateDNSName.HostnameType))
+	}
+
+	return request
+}
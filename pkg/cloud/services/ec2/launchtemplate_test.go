@@ -340,6 +340,96 @@ func TestServiceSDKToLaunchTemplate(t *testing.T) {
 			},
 			wantHash: testUserDataHash,
 		},
+		{
+			name: "capacity reservation",
+			input: &ec2.LaunchTemplateVersion{
+				LaunchTemplateId:   aws.String("lt-12345"),
+				LaunchTemplateName: aws.String("foo"),
+				LaunchTemplateData: &ec2.ResponseLaunchTemplateData{
+					ImageId: aws.String("foo-image"),
+					CapacityReservationSpecification: &ec2.LaunchTemplateCapacityReservationSpecificationResponse{
+						CapacityReservationTarget: &ec2.CapacityReservationTargetResponse{
+							CapacityReservationId: aws.String("cr-12345"),
+						},
+					},
+					UserData: aws.String(base64.StdEncoding.EncodeToString([]byte(testUserData))),
+				},
+				VersionNumber: aws.Int64(1),
+			},
+			wantLT: &expinfrav1.AWSLaunchTemplate{
+				Name: "foo",
+				AMI: infrav1.AMIReference{
+					ID: aws.String("foo-image"),
+				},
+				VersionNumber: aws.Int64(1),
+				CapacityReservation: &infrav1.CapacityReservationSpecification{
+					Target: &infrav1.CapacityReservationTarget{
+						ID: aws.String("cr-12345"),
+					},
+				},
+			},
+			wantHash: testUserDataHash,
+		},
+		{
+			name: "private DNS name options",
+			input: &ec2.LaunchTemplateVersion{
+				LaunchTemplateId:   aws.String("lt-12345"),
+				LaunchTemplateName: aws.String("foo"),
+				LaunchTemplateData: &ec2.ResponseLaunchTemplateData{
+					ImageId: aws.String("foo-image"),
+					PrivateDnsNameOptions: &ec2.LaunchTemplatePrivateDnsNameOptions{
+						HostnameType:                    aws.String("resource-name"),
+						EnableResourceNameDnsARecord:    aws.Bool(false),
+						EnableResourceNameDnsAAAARecord: aws.Bool(true),
+					},
+					UserData: aws.String(base64.StdEncoding.EncodeToString([]byte(testUserData))),
+				},
+				VersionNumber: aws.Int64(1),
+			},
+			wantLT: &expinfrav1.AWSLaunchTemplate{
+				Name: "foo",
+				AMI: infrav1.AMIReference{
+					ID: aws.String("foo-image"),
+				},
+				VersionNumber: aws.Int64(1),
+				PrivateDNSName: &infrav1.PrivateDNSName{
+					HostnameType:                    infrav1.HostnameTypeResourceName,
+					EnableResourceNameDNSARecord:    aws.Bool(false),
+					EnableResourceNameDNSAAAARecord: aws.Bool(true),
+				},
+			},
+			wantHash: testUserDataHash,
+		},
+		{
+			name: "maintenance options",
+			input: &ec2.LaunchTemplateVersion{
+				LaunchTemplateId:   aws.String("lt-12345"),
+				LaunchTemplateName: aws.String("foo"),
+				LaunchTemplateData: &ec2.ResponseLaunchTemplateData{
+					ImageId: aws.String("foo-image"),
+					MaintenanceOptions: &ec2.LaunchTemplateInstanceMaintenanceOptions{
+						AutoRecovery: aws.String("disabled"),
+					},
+					DisableApiTermination:             aws.Bool(true),
+					InstanceInitiatedShutdownBehavior: aws.String("terminate"),
+					UserData:                          aws.String(base64.StdEncoding.EncodeToString([]byte(testUserData))),
+				},
+				VersionNumber: aws.Int64(1),
+			},
+			wantLT: &expinfrav1.AWSLaunchTemplate{
+				Name: "foo",
+				AMI: infrav1.AMIReference{
+					ID: aws.String("foo-image"),
+				},
+				VersionNumber: aws.Int64(1),
+				MaintenanceOptions: &infrav1.InstanceMaintenanceOptions{
+					AutoRecovery:                      infrav1.AutoRecoveryStateDisabled,
+					DisableAPITermination:             aws.Bool(true),
+					InstanceInitiatedShutdownBehavior: infrav1.ShutdownBehaviorTerminate,
+				},
+			},
+			wantHash: testUserDataHash,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -441,6 +531,76 @@ func TestServiceLaunchTemplateNeedsUpdate(t *testing.T) {
 			},
 			want: true,
 		},
+		{
+			name: "Should return true if incoming CapacityReservation is not same as existing CapacityReservation",
+			incoming: &expinfrav1.AWSLaunchTemplate{
+				CapacityReservation: &infrav1.CapacityReservationSpecification{
+					Target: &infrav1.CapacityReservationTarget{ID: aws.String("cr-12345")},
+				},
+			},
+			existing: &expinfrav1.AWSLaunchTemplate{
+				CapacityReservation: &infrav1.CapacityReservationSpecification{
+					Preference: infrav1.CapacityReservationPreferenceOpen,
+				},
+			},
+			want: true,
+		},
+		{
+			name: "Should return true if incoming PrivateDNSName is not same as existing PrivateDNSName",
+			incoming: &expinfrav1.AWSLaunchTemplate{
+				PrivateDNSName: &infrav1.PrivateDNSName{
+					HostnameType:                    infrav1.HostnameTypeResourceName,
+					EnableResourceNameDNSAAAARecord: aws.Bool(true),
+				},
+			},
+			existing: &expinfrav1.AWSLaunchTemplate{
+				PrivateDNSName: &infrav1.PrivateDNSName{
+					HostnameType: infrav1.HostnameTypeIPName,
+				},
+			},
+			want: true,
+		},
+		{
+			name: "Should return true if incoming MaintenanceOptions is not same as existing MaintenanceOptions",
+			incoming: &expinfrav1.AWSLaunchTemplate{
+				MaintenanceOptions: &infrav1.InstanceMaintenanceOptions{
+					DisableAPITermination: aws.Bool(true),
+				},
+			},
+			existing: &expinfrav1.AWSLaunchTemplate{},
+			want:     true,
+		},
+		{
+			name: "Should return false if incoming MaintenanceOptions is empty and existing MaintenanceOptions is not set",
+			incoming: &expinfrav1.AWSLaunchTemplate{
+				MaintenanceOptions: &infrav1.InstanceMaintenanceOptions{},
+			},
+			existing: &expinfrav1.AWSLaunchTemplate{
+				AdditionalSecurityGroups: []infrav1.AWSResourceReference{
+					{ID: aws.String("sg-111")},
+					{ID: aws.String("sg-222")},
+				},
+			},
+			want: false,
+		},
+		{
+			name: "Should return false if incoming CapacityReservation is same as existing CapacityReservation",
+			incoming: &expinfrav1.AWSLaunchTemplate{
+				CapacityReservation: &infrav1.CapacityReservationSpecification{
+					Target: &infrav1.CapacityReservationTarget{ID: aws.String("cr-12345")},
+				},
+			},
+			existing: &expinfrav1.AWSLaunchTemplate{
+				AdditionalSecurityGroups: []infrav1.AWSResourceReference{
+					{ID: aws.String("sg-111")},
+					{ID: aws.String("sg-222")},
+				},
+				CapacityReservation: &infrav1.CapacityReservationSpecification{
+					Target: &infrav1.CapacityReservationTarget{ID: aws.String("cr-12345")},
+				},
+			},
+			want: false,
+		},
 		{
 			name: "new additional security group with filters",
 			incoming: &expinfrav1.AWSLaunchTemplate{
@@ -356,7 +356,7 @@ func makeEksLogging(loggingSpec *ekscontrolplanev1.ControlPlaneLoggingSpec) *eks
 func (s *Service) createCluster(eksClusterName string) (*eks.Cluster, error) {
 	logging := makeEksLogging(s.scope.ControlPlane.Spec.Logging)
 	encryptionConfigs := makeEksEncryptionConfigs(s.scope.ControlPlane.Spec.EncryptionConfig)
-	vpcConfig, err := makeVpcConfig(s.scope.Subnets(), s.scope.ControlPlane.Spec.EndpointAccess, s.scope.SecurityGroups())
+	vpcConfig, err := makeVpcConfig(s.scope.Subnets().FilterNonEdge(), s.scope.ControlPlane.Spec.EndpointAccess, s.scope.SecurityGroups())
 	if err != nil {
 		return nil, errors.Wrap(err, "couldn't create vpc config for cluster")
 	}
@@ -511,7 +511,7 @@ func publicAccessCIDRsEqual(as []*string, bs []*string) bool {
 
 func (s *Service) reconcileVpcConfig(vpcConfig *eks.VpcConfigResponse) (*eks.VpcConfigRequest, error) {
 	endpointAccess := s.scope.ControlPlane.Spec.EndpointAccess
-	updatedVpcConfig, err := makeVpcConfig(s.scope.Subnets(), endpointAccess, s.scope.SecurityGroups())
+	updatedVpcConfig, err := makeVpcConfig(s.scope.Subnets().FilterNonEdge(), endpointAccess, s.scope.SecurityGroups())
 	if err != nil {
 		return nil, err
 	}
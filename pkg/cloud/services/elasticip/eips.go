@@ -1,5 +1,5 @@
 /*
-Copyright 2018 The Kubernetes Authors.
+Copyright 2023 The Kubernetes Authors.
 
 Licensed under the Apache License, Version 2.0 (the "License");
 you may not use this file except in compliance with the License.
@@ -14,7 +14,7 @@ See the License for the specific language governing permissions and
 limitations under the License.
 */
 
-package network
+package elasticip
 
 import (
 	"fmt"
@@ -31,7 +31,21 @@ import (
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/record"
 )
 
-func (s *Service) getOrAllocateAddresses(num int, role string) (eips []string, err error) {
+// GetOrAllocateAddresses returns num unassociated Elastic IP addresses for the given role. Pre-allocated addresses
+// of the Elastic IP pool are consumed first, in the order they are listed, then unassociated addresses of the
+// cluster with the same role, and the rest is allocated, from the public IPv4 pool of the Elastic IP pool if set.
+// When an Elastic IP pool without public IPv4 pool is set, addresses are never allocated.
+func (s *Service) GetOrAllocateAddresses(num int, role string) (eips []string, err error) {
+	pool := s.scope.ElasticIPPool()
+
+	if pool != nil && len(pool.AllocationIDs) > 0 {
+		eips, err = s.getAvailableBYOAddresses(pool.AllocationIDs)
+		if err != nil {
+			record.Eventf(s.scope.InfraCluster(), "FailedDescribeAddresses", "Failed to query Elastic IP pool addresses: %v", err)
+			return nil, errors.Wrap(err, "failed to query Elastic IP pool addresses")
+		}
+	}
+
 	out, err := s.describeAddresses(role)
 	if err != nil {
 		record.Eventf(s.scope.InfraCluster(), "FailedDescribeAddresses", "Failed to query addresses for role %q: %v", role, err)
@@ -39,12 +53,17 @@ func (s *Service) getOrAllocateAddresses(num int, role string) (eips []string, e
 	}
 
 	for _, address := range out.Addresses {
-		if address.AssociationId == nil {
+		if address.AssociationId == nil && !pool.HasAllocationID(aws.StringValue(address.AllocationId)) {
 			eips = append(eips, aws.StringValue(address.AllocationId))
 		}
 	}
 
 	for len(eips) < num {
+		// Without a public IPv4 pool to fall back to, addresses must come from the pre-allocated list.
+		if pool != nil && pool.PublicIpv4Pool == nil {
+			record.Warnf(s.scope.InfraCluster(), "FailedAllocateEIP", "Not enough unassociated addresses in the Elastic IP pool for %q: need %d, found %d", role, num, len(eips))
+			return nil, errors.Errorf("not enough unassociated addresses in the Elastic IP pool: need %d, found %d", num, len(eips))
+		}
 		ip, err := s.allocateAddress(role)
 		if err != nil {
 			return nil, err
@@ -57,12 +76,17 @@ func (s *Service) getOrAllocateAddresses(num int, role string) (eips []string, e
 
 func (s *Service) allocateAddress(role string) (string, error) {
 	tagSpecifications := tags.BuildParamsToTagSpecification(ec2.ResourceTypeElasticIp, s.getEIPTagParams(role))
-	out, err := s.EC2Client.AllocateAddress(&ec2.AllocateAddressInput{
+	input := &ec2.AllocateAddressInput{
 		Domain: aws.String("vpc"),
 		TagSpecifications: []*ec2.TagSpecification{
 			tagSpecifications,
 		},
-	})
+	}
+	if pool := s.scope.ElasticIPPool(); pool != nil && pool.PublicIpv4Pool != nil {
+		input.PublicIpv4Pool = pool.PublicIpv4Pool
+	}
+
+	out, err := s.EC2Client.AllocateAddress(input)
 	if err != nil {
 		record.Warnf(s.scope.InfraCluster(), "FailedAllocateEIP", "Failed to allocate Elastic IP for %q: %v", role, err)
 		return "", errors.Wrap(err, "failed to allocate Elastic IP")
@@ -71,6 +95,32 @@ func (s *Service) allocateAddress(role string) (string, error) {
 	return aws.StringValue(out.AllocationId), nil
 }
 
+// getAvailableBYOAddresses returns the unassociated addresses among the given allocation ids,
+// preserving their order.
+func (s *Service) getAvailableBYOAddresses(allocationIDs []string) ([]string, error) {
+	out, err := s.EC2Client.DescribeAddresses(&ec2.DescribeAddressesInput{
+		AllocationIds: aws.StringSlice(allocationIDs),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	available := make(map[string]bool, len(out.Addresses))
+	for _, address := range out.Addresses {
+		if address.AssociationId == nil {
+			available[aws.StringValue(address.AllocationId)] = true
+		}
+	}
+
+	eips := []string{}
+	for _, id := range allocationIDs {
+		if available[id] {
+			eips = append(eips, id)
+		}
+	}
+	return eips, nil
+}
+
 func (s *Service) describeAddresses(role string) (*ec2.DescribeAddressesOutput, error) {
 	x := []*ec2.Filter{filter.EC2.Cluster(s.scope.Name())}
 	if role != "" {
@@ -102,7 +152,9 @@ func (s *Service) disassociateAddress(ip *ec2.Address) error {
 	return nil
 }
 
-func (s *Service) releaseAddresses() error {
+// ReleaseAddresses releases the Elastic IP addresses of the cluster, whatever their role. The pre-allocated
+// addresses of the Elastic IP pool are not owned by the cluster and are left untouched.
+func (s *Service) ReleaseAddresses() error {
 	out, err := s.EC2Client.DescribeAddresses(&ec2.DescribeAddressesInput{
 		Filters: []*ec2.Filter{filter.EC2.Cluster(s.scope.Name())},
 	})
@@ -112,8 +164,13 @@ func (s *Service) releaseAddresses() error {
 	if out == nil {
 		return nil
 	}
+	pool := s.scope.ElasticIPPool()
 	for i := range out.Addresses {
 		ip := out.Addresses[i]
+		// Pre-allocated addresses are not owned by the cluster.
+		if pool.HasAllocationID(aws.StringValue(ip.AllocationId)) {
+			continue
+		}
 		if ip.AssociationId != nil {
 			if _, err := s.EC2Client.DisassociateAddress(&ec2.DisassociateAddressInput{
 				AssociationId: ip.AssociationId,
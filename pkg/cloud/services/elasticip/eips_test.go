@@ -14,7 +14,7 @@ See the License for the specific language governing permissions and
 limitations under the License.
 */
 
-package network
+package elasticip
 
 import (
 	"testing"
@@ -41,6 +41,7 @@ func TestServiceReleaseAddresses(t *testing.T) {
 
 	tests := []struct {
 		name    string
+		pool    *infrav1.ElasticIPPool
 		expect  func(m *mocks.MockEC2APIMockRecorder)
 		wantErr bool
 	}{
@@ -123,6 +124,26 @@ func TestServiceReleaseAddresses(t *testing.T) {
 				m.ReleaseAddress(gomock.AssignableToTypeOf(&ec2.ReleaseAddressInput{})).Return(nil, nil)
 			},
 		},
+		{
+			name: "Should not release pre-allocated addresses of the Elastic IP pool",
+			pool: &infrav1.ElasticIPPool{AllocationIDs: []string{"eipalloc-byo"}},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeAddresses(gomock.AssignableToTypeOf(&ec2.DescribeAddressesInput{})).Return(&ec2.DescribeAddressesOutput{
+					Addresses: []*ec2.Address{
+						{
+							AssociationId: aws.String("association-id-1"),
+							PublicIp:      aws.String("public-ip-1"),
+							AllocationId:  aws.String("eipalloc-byo"),
+						},
+						{
+							PublicIp:     aws.String("public-ip-2"),
+							AllocationId: aws.String("eipalloc-owned"),
+						},
+					},
+				}, nil)
+				m.ReleaseAddress(&ec2.ReleaseAddressInput{AllocationId: aws.String("eipalloc-owned")}).Return(nil, nil)
+			},
+		},
 		{
 			name: "Should not retry if unable to release the IP address due to dependency failure",
 			expect: func(m *mocks.MockEC2APIMockRecorder) {
@@ -153,22 +174,149 @@ func TestServiceReleaseAddresses(t *testing.T) {
 			ec2Mock := mocks.NewMockEC2API(mockCtrl)
 
 			cs, err := scope.NewClusterScope(scope.ClusterScopeParams{
-				Client:     client,
-				Cluster:    &clusterv1.Cluster{},
-				AWSCluster: &infrav1.AWSCluster{},
+				Client:  client,
+				Cluster: &clusterv1.Cluster{},
+				AWSCluster: &infrav1.AWSCluster{
+					Spec: infrav1.AWSClusterSpec{
+						NetworkSpec: infrav1.NetworkSpec{
+							ElasticIPPool: tt.pool,
+						},
+					},
+				},
 			})
 			g.Expect(err).NotTo(HaveOccurred())
 
-			s := NewService(cs)
-			s.EC2Client = ec2Mock
+			s := NewService(cs, ec2Mock)
 
 			if tt.expect != nil {
 				tt.expect(ec2Mock.EXPECT())
 			}
 
-			if err := s.releaseAddresses(); (err != nil) != tt.wantErr {
-				t.Errorf("releaseAddresses() error = %v, wantErr %v", err, tt.wantErr)
+			if err := s.ReleaseAddresses(); (err != nil) != tt.wantErr {
+				t.Errorf("ReleaseAddresses() error = %v, wantErr %v", err, tt.wantErr)
 			}
 		})
 	}
 }
+
+func TestServiceGetOrAllocateAddresses(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	tests := []struct {
+		name    string
+		pool    *infrav1.ElasticIPPool
+		num     int
+		expect  func(m *mocks.MockEC2APIMockRecorder)
+		want    []string
+		wantErr bool
+	}{
+		{
+			name: "Should reuse unassociated addresses and allocate the missing ones",
+			num:  2,
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeAddresses(gomock.AssignableToTypeOf(&ec2.DescribeAddressesInput{})).Return(&ec2.DescribeAddressesOutput{
+					Addresses: []*ec2.Address{
+						{AllocationId: aws.String("eipalloc-free")},
+						{AllocationId: aws.String("eipalloc-used"), AssociationId: aws.String("eipassoc-1")},
+					},
+				}, nil)
+				m.AllocateAddress(gomock.AssignableToTypeOf(&ec2.AllocateAddressInput{})).DoAndReturn(func(input *ec2.AllocateAddressInput) (*ec2.AllocateAddressOutput, error) {
+					if input.PublicIpv4Pool != nil {
+						t.Fatalf("unexpected public IPv4 pool %q", *input.PublicIpv4Pool)
+					}
+					return &ec2.AllocateAddressOutput{AllocationId: aws.String("eipalloc-new")}, nil
+				})
+			},
+			want: []string{"eipalloc-free", "eipalloc-new"},
+		},
+		{
+			name: "Should consume pre-allocated addresses first, in order",
+			pool: &infrav1.ElasticIPPool{AllocationIDs: []string{"eipalloc-byo-1", "eipalloc-byo-2", "eipalloc-byo-3"}},
+			num:  2,
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeAddresses(&ec2.DescribeAddressesInput{
+					AllocationIds: aws.StringSlice([]string{"eipalloc-byo-1", "eipalloc-byo-2", "eipalloc-byo-3"}),
+				}).Return(&ec2.DescribeAddressesOutput{
+					Addresses: []*ec2.Address{
+						{AllocationId: aws.String("eipalloc-byo-3")},
+						{AllocationId: aws.String("eipalloc-byo-2"), AssociationId: aws.String("eipassoc-1")},
+						{AllocationId: aws.String("eipalloc-byo-1")},
+					},
+				}, nil)
+				m.DescribeAddresses(gomock.AssignableToTypeOf(&ec2.DescribeAddressesInput{})).Return(&ec2.DescribeAddressesOutput{}, nil)
+			},
+			want: []string{"eipalloc-byo-1", "eipalloc-byo-3"},
+		},
+		{
+			name: "Should return an error if the pre-allocated addresses are exhausted and no public IPv4 pool is set",
+			pool: &infrav1.ElasticIPPool{AllocationIDs: []string{"eipalloc-byo-1"}},
+			num:  2,
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeAddresses(&ec2.DescribeAddressesInput{
+					AllocationIds: aws.StringSlice([]string{"eipalloc-byo-1"}),
+				}).Return(&ec2.DescribeAddressesOutput{
+					Addresses: []*ec2.Address{
+						{AllocationId: aws.String("eipalloc-byo-1")},
+					},
+				}, nil)
+				m.DescribeAddresses(gomock.AssignableToTypeOf(&ec2.DescribeAddressesInput{})).Return(&ec2.DescribeAddressesOutput{}, nil)
+			},
+			wantErr: true,
+		},
+		{
+			name: "Should allocate missing addresses from the public IPv4 pool",
+			pool: &infrav1.ElasticIPPool{PublicIpv4Pool: aws.String("ipv4pool-ec2-123")},
+			num:  1,
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeAddresses(gomock.AssignableToTypeOf(&ec2.DescribeAddressesInput{})).Return(&ec2.DescribeAddressesOutput{}, nil)
+				m.AllocateAddress(gomock.AssignableToTypeOf(&ec2.AllocateAddressInput{})).DoAndReturn(func(input *ec2.AllocateAddressInput) (*ec2.AllocateAddressOutput, error) {
+					if aws.StringValue(input.PublicIpv4Pool) != "ipv4pool-ec2-123" {
+						t.Fatalf("expected public IPv4 pool ipv4pool-ec2-123, got %q", aws.StringValue(input.PublicIpv4Pool))
+					}
+					return &ec2.AllocateAddressOutput{AllocationId: aws.String("eipalloc-pool")}, nil
+				})
+			},
+			want: []string{"eipalloc-pool"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			scheme := runtime.NewScheme()
+			err := infrav1.AddToScheme(scheme)
+			g.Expect(err).NotTo(HaveOccurred())
+			client := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+			ec2Mock := mocks.NewMockEC2API(mockCtrl)
+
+			cs, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Client:  client,
+				Cluster: &clusterv1.Cluster{},
+				AWSCluster: &infrav1.AWSCluster{
+					Spec: infrav1.AWSClusterSpec{
+						NetworkSpec: infrav1.NetworkSpec{
+							ElasticIPPool: tt.pool,
+						},
+					},
+				},
+			})
+			g.Expect(err).NotTo(HaveOccurred())
+
+			s := NewService(cs, ec2Mock)
+
+			if tt.expect != nil {
+				tt.expect(ec2Mock.EXPECT())
+			}
+
+			eips, err := s.GetOrAllocateAddresses(tt.num, infrav1.APIServerRoleTagValue)
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(eips).To(Equal(tt.want))
+		})
+	}
+}
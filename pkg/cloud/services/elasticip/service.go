@@ -0,0 +1,38 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package elasticip
+
+import (
+	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
+
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+)
+
+// Service allocates and releases the Elastic IP addresses of the cluster network, which are used by the
+// NAT gateways and the network load balancers alike.
+type Service struct {
+	scope     scope.ElasticIPScope
+	EC2Client ec2iface.EC2API
+}
+
+// NewService returns a new service given the scope and the ec2 api client of the calling service.
+func NewService(elasticIPScope scope.ElasticIPScope, ec2Client ec2iface.EC2API) *Service {
+	return &Service{
+		scope:     elasticIPScope,
+		EC2Client: ec2Client,
+	}
+}
@@ -0,0 +1,322 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package elb
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/elb"
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/pkg/errors"
+	"k8s.io/utils/strings/slices"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/awserrors"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/record"
+)
+
+// reconcileControlPlaneDNS makes sure the Route53 record of the control plane endpoint, if any,
+// is an alias of the primary control plane load balancer.
+func (s *Service) reconcileControlPlaneDNS() error {
+	dns := s.scope.ControlPlaneDNS()
+	if dns == nil {
+		return nil
+	}
+
+	s.scope.Debug("Reconciling control plane DNS record", "hosted-zone-id", dns.HostedZoneID, "name", dns.FQDN())
+
+	target, err := s.describeAPIServerLBAliasTarget()
+	if err != nil {
+		return err
+	}
+
+	if err := s.associateVPCWithHostedZone(dns); err != nil {
+		return err
+	}
+
+	existing, err := s.describeControlPlaneDNSRecord(dns)
+	if err != nil {
+		return err
+	}
+	if existing != nil && existing.AliasTarget != nil &&
+		normalizeDNSName(aws.StringValue(existing.AliasTarget.DNSName)) == normalizeDNSName(aws.StringValue(target.DNSName)) &&
+		aws.StringValue(existing.AliasTarget.HostedZoneId) == aws.StringValue(target.HostedZoneId) {
+		return nil
+	}
+
+	if _, err := s.Route53Client.ChangeResourceRecordSets(&route53.ChangeResourceRecordSetsInput{
+		HostedZoneId: aws.String(dns.HostedZoneID),
+		ChangeBatch: &route53.ChangeBatch{
+			Comment: aws.String(fmt.Sprintf("Control plane endpoint of cluster %s", s.scope.Name())),
+			Changes: []*route53.Change{
+				{
+					Action: aws.String(route53.ChangeActionUpsert),
+					ResourceRecordSet: &route53.ResourceRecordSet{
+						Name:        aws.String(dns.FQDN()),
+						Type:        aws.String(route53.RRTypeA),
+						AliasTarget: target,
+					},
+				},
+			},
+		},
+	}); err != nil {
+		record.Warnf(s.scope.InfraCluster(), "FailedUpsertControlPlaneDNSRecord", "Failed to upsert control plane DNS record %q: %v", dns.FQDN(), err)
+		return errors.Wrapf(err, "failed to upsert control plane dns record %q in hosted zone %q", dns.FQDN(), dns.HostedZoneID)
+	}
+
+	record.Eventf(s.scope.InfraCluster(), "SuccessfulUpsertControlPlaneDNSRecord", "Upserted control plane DNS record %q pointing to %q", dns.FQDN(), aws.StringValue(target.DNSName))
+	s.scope.Info("Upserted control plane DNS record", "name", dns.FQDN(), "hosted-zone-id", dns.HostedZoneID, "alias", aws.StringValue(target.DNSName))
+
+	return nil
+}
+
+// deleteControlPlaneDNS deletes the Route53 record of the control plane endpoint. Only a record
+// pointing to the control plane load balancer of the cluster is deleted.
+func (s *Service) deleteControlPlaneDNS() error {
+	dns := s.scope.ControlPlaneDNS()
+	if dns == nil {
+		return nil
+	}
+
+	lbDNSName := s.scope.Network().APIServerELB.DNSName
+	if lbDNSName != "" {
+		existing, err := s.describeControlPlaneDNSRecord(dns)
+		if err != nil {
+			return err
+		}
+
+		if existing != nil && existing.AliasTarget != nil &&
+			normalizeDNSName(aws.StringValue(existing.AliasTarget.DNSName)) == normalizeDNSName(lbDNSName) {
+			if _, err := s.Route53Client.ChangeResourceRecordSets(&route53.ChangeResourceRecordSetsInput{
+				HostedZoneId: aws.String(dns.HostedZoneID),
+				ChangeBatch: &route53.ChangeBatch{
+					Changes: []*route53.Change{
+						{
+							Action:            aws.String(route53.ChangeActionDelete),
+							ResourceRecordSet: existing,
+						},
+					},
+				},
+			}); err != nil {
+				record.Warnf(s.scope.InfraCluster(), "FailedDeleteControlPlaneDNSRecord", "Failed to delete control plane DNS record %q: %v", dns.FQDN(), err)
+				return errors.Wrapf(err, "failed to delete control plane dns record %q in hosted zone %q", dns.FQDN(), dns.HostedZoneID)
+			}
+
+			record.Eventf(s.scope.InfraCluster(), "SuccessfulDeleteControlPlaneDNSRecord", "Deleted control plane DNS record %q", dns.FQDN())
+			s.scope.Info("Deleted control plane DNS record", "name", dns.FQDN(), "hosted-zone-id", dns.HostedZoneID)
+		}
+	}
+
+	return s.disassociateVPCFromHostedZone(dns)
+}
+
+// describeControlPlaneDNSRecord returns the A record of the control plane endpoint, or nil if it does not exist.
+func (s *Service) describeControlPlaneDNSRecord(dns *infrav1.ControlPlaneDNS) (*route53.ResourceRecordSet, error) {
+	out, err := s.Route53Client.ListResourceRecordSets(&route53.ListResourceRecordSetsInput{
+		HostedZoneId:    aws.String(dns.HostedZoneID),
+		StartRecordName: aws.String(dns.FQDN()),
+		StartRecordType: aws.String(route53.RRTypeA),
+		MaxItems:        aws.String("1"),
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list dns records of hosted zone %q", dns.HostedZoneID)
+	}
+
+	for _, rs := range out.ResourceRecordSets {
+		if normalizeDNSName(aws.StringValue(rs.Name)) == dns.FQDN() && aws.StringValue(rs.Type) == route53.RRTypeA {
+			return rs, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// describeAPIServerLBAliasTarget returns the alias target of the primary control plane load balancer.
+func (s *Service) describeAPIServerLBAliasTarget() (*route53.AliasTarget, error) {
+	lbSpec := s.scope.ControlPlaneLoadBalancer()
+
+	if lbSpec == nil || lbSpec.LoadBalancerType == infrav1.LoadBalancerTypeClassic || lbSpec.LoadBalancerType == "" {
+		name, err := ELBName(s.scope)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get control plane load balancer name")
+		}
+		out, err := s.ELBClient.DescribeLoadBalancers(&elb.DescribeLoadBalancersInput{
+			LoadBalancerNames: aws.StringSlice([]string{name}),
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to describe classic load balancer %q", name)
+		}
+		if len(out.LoadBalancerDescriptions) == 0 {
+			return nil, NewNotFound(fmt.Sprintf("no classic load balancer found with name %q", name))
+		}
+		return &route53.AliasTarget{
+			DNSName:              out.LoadBalancerDescriptions[0].DNSName,
+			HostedZoneId:         out.LoadBalancerDescriptions[0].CanonicalHostedZoneNameID,
+			EvaluateTargetHealth: aws.Bool(false),
+		}, nil
+	}
+
+	name, err := LBName(s.scope, lbSpec)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get control plane load balancer name")
+	}
+	out, err := s.ELBV2Client.DescribeLoadBalancers(&elbv2.DescribeLoadBalancersInput{
+		Names: aws.StringSlice([]string{name}),
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to describe load balancer %q", name)
+	}
+	if len(out.LoadBalancers) == 0 {
+		return nil, NewNotFound(fmt.Sprintf("no load balancer found with name %q", name))
+	}
+	return &route53.AliasTarget{
+		DNSName:              out.LoadBalancers[0].DNSName,
+		HostedZoneId:         out.LoadBalancers[0].CanonicalHostedZoneId,
+		EvaluateTargetHealth: aws.Bool(false),
+	}, nil
+}
+
+// associateVPCWithHostedZone associates the cluster VPC with the hosted zone if it is private,
+// so that the record can be resolved from the cluster instances. The association is recorded in
+// the cluster status, so that it is removed on deletion whether the VPC is managed or not.
+func (s *Service) associateVPCWithHostedZone(dns *infrav1.ControlPlaneDNS) error {
+	zone, err := s.Route53Client.GetHostedZone(&route53.GetHostedZoneInput{
+		Id: aws.String(dns.HostedZoneID),
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to get hosted zone %q", dns.HostedZoneID)
+	}
+
+	if zone.HostedZone.Config == nil || !aws.BoolValue(zone.HostedZone.Config.PrivateZone) {
+		return nil
+	}
+
+	vpcID := s.scope.VPC().ID
+	for _, vpc := range zone.VPCs {
+		if aws.StringValue(vpc.VPCId) == vpcID {
+			return nil
+		}
+	}
+
+	if _, err := s.Route53Client.AssociateVPCWithHostedZone(&route53.AssociateVPCWithHostedZoneInput{
+		HostedZoneId: aws.String(dns.HostedZoneID),
+		VPC: &route53.VPC{
+			VPCId:     aws.String(vpcID),
+			VPCRegion: aws.String(s.scope.Region()),
+		},
+	}); err != nil {
+		record.Warnf(s.scope.InfraCluster(), "FailedAssociateHostedZone", "Failed to associate VPC %q with private hosted zone %q: %v", vpcID, dns.HostedZoneID, err)
+		return errors.Wrapf(err, "failed to associate vpc %q with hosted zone %q", vpcID, dns.HostedZoneID)
+	}
+
+	if !slices.Contains(s.scope.Network().AssociatedHostedZoneIDs, dns.HostedZoneID) {
+		s.scope.Network().AssociatedHostedZoneIDs = append(s.scope.Network().AssociatedHostedZoneIDs, dns.HostedZoneID)
+	}
+	record.Eventf(s.scope.InfraCluster(), "SuccessfulAssociateHostedZone", "Associated VPC %q with private hosted zone %q", vpcID, dns.HostedZoneID)
+	return nil
+}
+
+// disassociateVPCFromHostedZone removes the association of the cluster VPC with a private hosted zone, if it was
+// created by the provider. Associations of managed VPCs created before they were recorded are removed as well.
+func (s *Service) disassociateVPCFromHostedZone(dns *infrav1.ControlPlaneDNS) error {
+	vpcID := s.scope.VPC().ID
+	recorded := slices.Contains(s.scope.Network().AssociatedHostedZoneIDs, dns.HostedZoneID)
+	if vpcID == "" || (!recorded && s.scope.VPC().IsUnmanaged(s.scope.Name())) {
+		return nil
+	}
+
+	zone, err := s.Route53Client.GetHostedZone(&route53.GetHostedZoneInput{
+		Id: aws.String(dns.HostedZoneID),
+	})
+	if err != nil {
+		if code, _ := awserrors.Code(errors.Cause(err)); code == route53.ErrCodeNoSuchHostedZone {
+			s.forgetHostedZoneAssociation(dns.HostedZoneID)
+			return nil
+		}
+		return errors.Wrapf(err, "failed to get hosted zone %q", dns.HostedZoneID)
+	}
+
+	if zone.HostedZone.Config == nil || !aws.BoolValue(zone.HostedZone.Config.PrivateZone) {
+		s.forgetHostedZoneAssociation(dns.HostedZoneID)
+		return nil
+	}
+
+	associated := false
+	for _, vpc := range zone.VPCs {
+		if aws.StringValue(vpc.VPCId) == vpcID {
+			associated = true
+			break
+		}
+	}
+	// A private hosted zone must be associated with at least one VPC, the association
+	// is removed when the VPC is deleted in that case.
+	if !associated || len(zone.VPCs) == 1 {
+		if associated && s.scope.VPC().IsUnmanaged(s.scope.Name()) {
+			s.scope.Info("Cannot remove the last VPC association of the private hosted zone, leaving it in place", "hosted-zone-id", dns.HostedZoneID, "vpc-id", vpcID)
+		}
+		s.forgetHostedZoneAssociation(dns.HostedZoneID)
+		return nil
+	}
+
+	if _, err := s.Route53Client.DisassociateVPCFromHostedZone(&route53.DisassociateVPCFromHostedZoneInput{
+		HostedZoneId: aws.String(dns.HostedZoneID),
+		VPC: &route53.VPC{
+			VPCId:     aws.String(vpcID),
+			VPCRegion: aws.String(s.scope.Region()),
+		},
+	}); err != nil {
+		if code, _ := awserrors.Code(errors.Cause(err)); code != route53.ErrCodeVPCAssociationNotFound && code != route53.ErrCodeLastVPCAssociation {
+			record.Warnf(s.scope.InfraCluster(), "FailedDisassociateHostedZone", "Failed to disassociate VPC %q from private hosted zone %q: %v", vpcID, dns.HostedZoneID, err)
+			return errors.Wrapf(err, "failed to disassociate vpc %q from hosted zone %q", vpcID, dns.HostedZoneID)
+		}
+	}
+
+	s.forgetHostedZoneAssociation(dns.HostedZoneID)
+	record.Eventf(s.scope.InfraCluster(), "SuccessfulDisassociateHostedZone", "Disassociated VPC %q from private hosted zone %q", vpcID, dns.HostedZoneID)
+	return nil
+}
+
+// forgetHostedZoneAssociation removes a hosted zone from the associations recorded in the cluster status.
+func (s *Service) forgetHostedZoneAssociation(hostedZoneID string) {
+	ids := s.scope.Network().AssociatedHostedZoneIDs[:0]
+	for _, id := range s.scope.Network().AssociatedHostedZoneIDs {
+		if id != hostedZoneID {
+			ids = append(ids, id)
+		}
+	}
+	if len(ids) == 0 {
+		ids = nil
+	}
+	s.scope.Network().AssociatedHostedZoneIDs = ids
+}
+
+// controlPlaneEndpointIsDNSRecord returns true if the control plane endpoint is the Route53 record of the cluster,
+// which keeps working when the load balancer is recreated as the record is then upserted to alias the new one.
+func (s *Service) controlPlaneEndpointIsDNSRecord() bool {
+	dns := s.scope.ControlPlaneDNS()
+	return dns != nil && normalizeDNSName(s.scope.ControlPlaneEndpoint().Host) == dns.FQDN()
+}
+
+// normalizeDNSName returns a DNS name in a form that can be compared with the names returned by Route53,
+// which are fully qualified and prefixed with dualstack for load balancer aliases.
+func normalizeDNSName(name string) string {
+	name = strings.ToLower(strings.TrimSuffix(name, "."))
+	return strings.TrimPrefix(name, "dualstack.")
+}
@@ -0,0 +1,475 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package elb
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/test/mocks"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+)
+
+const (
+	dnsTestHostedZoneID = "Z0123456789ABCDEFGHIJ"
+	dnsTestRecordName   = "api.bar.example.com"
+	dnsTestLBName       = "bar-apiserver"
+	dnsTestLBDNSName    = "bar-apiserver-123.elb.us-east-1.amazonaws.com"
+	dnsTestLBZoneID     = "Z26RNL4JYFTOTI"
+)
+
+func getControlPlaneDNSClusterScope(t *testing.T, lbDNSName string, opts ...func(*infrav1.AWSCluster)) *scope.ClusterScope {
+	t.Helper()
+
+	scheme, err := setupScheme()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	awsCluster := &infrav1.AWSCluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "test"},
+		Spec: infrav1.AWSClusterSpec{
+			Region: "us-east-1",
+			ControlPlaneLoadBalancer: &infrav1.AWSLoadBalancerSpec{
+				Name:             aws.String(dnsTestLBName),
+				LoadBalancerType: infrav1.LoadBalancerTypeNLB,
+			},
+			ControlPlaneDNS: &infrav1.ControlPlaneDNS{
+				HostedZoneID: dnsTestHostedZoneID,
+				Name:         dnsTestRecordName + ".",
+			},
+			NetworkSpec: infrav1.NetworkSpec{
+				VPC: infrav1.VPCSpec{
+					ID: "vpc-id",
+					Tags: infrav1.Tags{
+						infrav1.ClusterTagKey("bar"): string(infrav1.ResourceLifecycleOwned),
+					},
+				},
+			},
+		},
+		Status: infrav1.AWSClusterStatus{
+			Network: infrav1.NetworkStatus{
+				APIServerELB: infrav1.LoadBalancer{
+					DNSName: lbDNSName,
+				},
+			},
+		},
+	}
+
+	for _, opt := range opts {
+		opt(awsCluster)
+	}
+
+	client := fake.NewClientBuilder().WithScheme(scheme).Build()
+	if err := client.Create(context.TODO(), awsCluster); err != nil {
+		t.Fatal(err)
+	}
+
+	clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+		Cluster: &clusterv1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "foo",
+				Name:      "bar",
+			},
+		},
+		AWSCluster: awsCluster,
+		Client:     client,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return clusterScope
+}
+
+func expectDescribeControlPlaneLB(m *mocks.MockELBV2APIMockRecorder) {
+	m.DescribeLoadBalancers(gomock.Eq(&elbv2.DescribeLoadBalancersInput{
+		Names: aws.StringSlice([]string{dnsTestLBName}),
+	})).Return(&elbv2.DescribeLoadBalancersOutput{
+		LoadBalancers: []*elbv2.LoadBalancer{
+			{
+				DNSName:               aws.String(dnsTestLBDNSName),
+				CanonicalHostedZoneId: aws.String(dnsTestLBZoneID),
+			},
+		},
+	}, nil)
+}
+
+func expectGetHostedZone(m *mocks.MockRoute53APIMockRecorder, private bool, vpcIDs ...string) {
+	out := &route53.GetHostedZoneOutput{
+		HostedZone: &route53.HostedZone{
+			Id: aws.String(dnsTestHostedZoneID),
+			Config: &route53.HostedZoneConfig{
+				PrivateZone: aws.Bool(private),
+			},
+		},
+	}
+	for _, id := range vpcIDs {
+		out.VPCs = append(out.VPCs, &route53.VPC{VPCId: aws.String(id), VPCRegion: aws.String("us-east-1")})
+	}
+	m.GetHostedZone(gomock.Eq(&route53.GetHostedZoneInput{
+		Id: aws.String(dnsTestHostedZoneID),
+	})).Return(out, nil)
+}
+
+func expectListControlPlaneDNSRecord(m *mocks.MockRoute53APIMockRecorder, records ...*route53.ResourceRecordSet) {
+	m.ListResourceRecordSets(gomock.Eq(&route53.ListResourceRecordSetsInput{
+		HostedZoneId:    aws.String(dnsTestHostedZoneID),
+		StartRecordName: aws.String(dnsTestRecordName),
+		StartRecordType: aws.String(route53.RRTypeA),
+		MaxItems:        aws.String("1"),
+	})).Return(&route53.ListResourceRecordSetsOutput{
+		ResourceRecordSets: records,
+	}, nil)
+}
+
+func TestReconcileControlPlaneDNS(t *testing.T) {
+	tests := []struct {
+		name                    string
+		associatedHostedZoneIDs []string
+		elbv2APIMocks           func(m *mocks.MockELBV2APIMockRecorder)
+		route53Mocks            func(m *mocks.MockRoute53APIMockRecorder)
+	}{
+		{
+			name:          "creates the alias record in a public hosted zone",
+			elbv2APIMocks: expectDescribeControlPlaneLB,
+			route53Mocks: func(m *mocks.MockRoute53APIMockRecorder) {
+				expectGetHostedZone(m, false)
+				expectListControlPlaneDNSRecord(m)
+				m.ChangeResourceRecordSets(gomock.AssignableToTypeOf(&route53.ChangeResourceRecordSetsInput{})).
+					DoAndReturn(func(input *route53.ChangeResourceRecordSetsInput) (*route53.ChangeResourceRecordSetsOutput, error) {
+						g := NewWithT(t)
+						g.Expect(aws.StringValue(input.HostedZoneId)).To(Equal(dnsTestHostedZoneID))
+						g.Expect(input.ChangeBatch.Changes).To(HaveLen(1))
+						change := input.ChangeBatch.Changes[0]
+						g.Expect(aws.StringValue(change.Action)).To(Equal(route53.ChangeActionUpsert))
+						g.Expect(aws.StringValue(change.ResourceRecordSet.Name)).To(Equal(dnsTestRecordName))
+						g.Expect(aws.StringValue(change.ResourceRecordSet.Type)).To(Equal(route53.RRTypeA))
+						g.Expect(aws.StringValue(change.ResourceRecordSet.AliasTarget.DNSName)).To(Equal(dnsTestLBDNSName))
+						g.Expect(aws.StringValue(change.ResourceRecordSet.AliasTarget.HostedZoneId)).To(Equal(dnsTestLBZoneID))
+						return &route53.ChangeResourceRecordSetsOutput{}, nil
+					})
+			},
+		},
+		{
+			name:                    "associates the vpc with a private hosted zone",
+			associatedHostedZoneIDs: []string{dnsTestHostedZoneID},
+			elbv2APIMocks:           expectDescribeControlPlaneLB,
+			route53Mocks: func(m *mocks.MockRoute53APIMockRecorder) {
+				expectGetHostedZone(m, true, "vpc-other")
+				m.AssociateVPCWithHostedZone(gomock.Eq(&route53.AssociateVPCWithHostedZoneInput{
+					HostedZoneId: aws.String(dnsTestHostedZoneID),
+					VPC: &route53.VPC{
+						VPCId:     aws.String("vpc-id"),
+						VPCRegion: aws.String("us-east-1"),
+					},
+				})).Return(&route53.AssociateVPCWithHostedZoneOutput{}, nil)
+				expectListControlPlaneDNSRecord(m)
+				m.ChangeResourceRecordSets(gomock.AssignableToTypeOf(&route53.ChangeResourceRecordSetsInput{})).
+					Return(&route53.ChangeResourceRecordSetsOutput{}, nil)
+			},
+		},
+		{
+			name:          "does nothing if the record is up to date",
+			elbv2APIMocks: expectDescribeControlPlaneLB,
+			route53Mocks: func(m *mocks.MockRoute53APIMockRecorder) {
+				expectGetHostedZone(m, true, "vpc-id")
+				expectListControlPlaneDNSRecord(m, &route53.ResourceRecordSet{
+					Name: aws.String(dnsTestRecordName + "."),
+					Type: aws.String(route53.RRTypeA),
+					AliasTarget: &route53.AliasTarget{
+						DNSName:      aws.String("dualstack." + dnsTestLBDNSName + "."),
+						HostedZoneId: aws.String(dnsTestLBZoneID),
+					},
+				})
+			},
+		},
+		{
+			name:          "updates the record if it points to another load balancer",
+			elbv2APIMocks: expectDescribeControlPlaneLB,
+			route53Mocks: func(m *mocks.MockRoute53APIMockRecorder) {
+				expectGetHostedZone(m, false)
+				expectListControlPlaneDNSRecord(m, &route53.ResourceRecordSet{
+					Name: aws.String(dnsTestRecordName + "."),
+					Type: aws.String(route53.RRTypeA),
+					AliasTarget: &route53.AliasTarget{
+						DNSName:      aws.String("dualstack.old-apiserver-456.elb.us-east-1.amazonaws.com."),
+						HostedZoneId: aws.String(dnsTestLBZoneID),
+					},
+				})
+				m.ChangeResourceRecordSets(gomock.AssignableToTypeOf(&route53.ChangeResourceRecordSetsInput{})).
+					Return(&route53.ChangeResourceRecordSetsOutput{}, nil)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			elbv2APIMocks := mocks.NewMockELBV2API(mockCtrl)
+			route53Mocks := mocks.NewMockRoute53API(mockCtrl)
+
+			tc.elbv2APIMocks(elbv2APIMocks.EXPECT())
+			tc.route53Mocks(route53Mocks.EXPECT())
+
+			s := &Service{
+				scope:         getControlPlaneDNSClusterScope(t, dnsTestLBDNSName),
+				ELBV2Client:   elbv2APIMocks,
+				Route53Client: route53Mocks,
+			}
+
+			g.Expect(s.reconcileControlPlaneDNS()).To(Succeed())
+			g.Expect(s.scope.Network().AssociatedHostedZoneIDs).To(Equal(tc.associatedHostedZoneIDs))
+		})
+	}
+}
+
+func TestReconcileLoadbalancersRecreatesLBBehindControlPlaneDNS(t *testing.T) {
+	tests := []struct {
+		name          string
+		endpointHost  string
+		elbv2APIMocks func(m *mocks.MockELBV2APIMockRecorder)
+		route53Mocks  func(m *mocks.MockRoute53APIMockRecorder)
+		wantErr       bool
+	}{
+		{
+			name:         "recreates the load balancer and points the record to it when the endpoint is the record",
+			endpointHost: dnsTestRecordName,
+			elbv2APIMocks: func(m *mocks.MockELBV2APIMockRecorder) {
+				m.DescribeLoadBalancers(gomock.Eq(&elbv2.DescribeLoadBalancersInput{
+					Names: aws.StringSlice([]string{dnsTestLBName}),
+				})).Return(&elbv2.DescribeLoadBalancersOutput{}, nil)
+				m.CreateLoadBalancer(gomock.AssignableToTypeOf(&elbv2.CreateLoadBalancerInput{})).
+					DoAndReturn(func(input *elbv2.CreateLoadBalancerInput) (*elbv2.CreateLoadBalancerOutput, error) {
+						g := NewWithT(t)
+						g.Expect(aws.StringValue(input.Name)).To(Equal(dnsTestLBName))
+						return &elbv2.CreateLoadBalancerOutput{
+							LoadBalancers: []*elbv2.LoadBalancer{
+								{
+									LoadBalancerArn:  aws.String("apiserver::arn"),
+									LoadBalancerName: aws.String(dnsTestLBName),
+									DNSName:          aws.String(dnsTestLBDNSName),
+								},
+							},
+						}, nil
+					})
+				m.CreateTargetGroup(gomock.AssignableToTypeOf(&elbv2.CreateTargetGroupInput{})).
+					Return(&elbv2.CreateTargetGroupOutput{
+						TargetGroups: []*elbv2.TargetGroup{{TargetGroupArn: aws.String("target-group::arn")}},
+					}, nil)
+				m.ModifyTargetGroupAttributes(gomock.AssignableToTypeOf(&elbv2.ModifyTargetGroupAttributesInput{})).
+					Return(&elbv2.ModifyTargetGroupAttributesOutput{}, nil)
+				m.CreateListener(gomock.AssignableToTypeOf(&elbv2.CreateListenerInput{})).
+					Return(&elbv2.CreateListenerOutput{
+						Listeners: []*elbv2.Listener{{ListenerArn: aws.String("listener::arn")}},
+					}, nil)
+				expectDescribeControlPlaneLB(m)
+			},
+			route53Mocks: func(m *mocks.MockRoute53APIMockRecorder) {
+				expectGetHostedZone(m, false)
+				expectListControlPlaneDNSRecord(m, &route53.ResourceRecordSet{
+					Name: aws.String(dnsTestRecordName + "."),
+					Type: aws.String(route53.RRTypeA),
+					AliasTarget: &route53.AliasTarget{
+						DNSName:      aws.String("dualstack.old-apiserver-456.elb.us-east-1.amazonaws.com."),
+						HostedZoneId: aws.String(dnsTestLBZoneID),
+					},
+				})
+				m.ChangeResourceRecordSets(gomock.AssignableToTypeOf(&route53.ChangeResourceRecordSetsInput{})).
+					DoAndReturn(func(input *route53.ChangeResourceRecordSetsInput) (*route53.ChangeResourceRecordSetsOutput, error) {
+						g := NewWithT(t)
+						g.Expect(input.ChangeBatch.Changes).To(HaveLen(1))
+						change := input.ChangeBatch.Changes[0]
+						g.Expect(aws.StringValue(change.Action)).To(Equal(route53.ChangeActionUpsert))
+						g.Expect(aws.StringValue(change.ResourceRecordSet.AliasTarget.DNSName)).To(Equal(dnsTestLBDNSName))
+						return &route53.ChangeResourceRecordSetsOutput{}, nil
+					})
+			},
+		},
+		{
+			name:         "does not recreate the load balancer when the endpoint is the name of the deleted load balancer",
+			endpointHost: "old-apiserver-456.elb.us-east-1.amazonaws.com",
+			elbv2APIMocks: func(m *mocks.MockELBV2APIMockRecorder) {
+				m.DescribeLoadBalancers(gomock.Eq(&elbv2.DescribeLoadBalancersInput{
+					Names: aws.StringSlice([]string{dnsTestLBName}),
+				})).Return(&elbv2.DescribeLoadBalancersOutput{}, nil)
+			},
+			route53Mocks: func(m *mocks.MockRoute53APIMockRecorder) {},
+			wantErr:      true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			elbv2APIMocks := mocks.NewMockELBV2API(mockCtrl)
+			route53Mocks := mocks.NewMockRoute53API(mockCtrl)
+
+			tc.elbv2APIMocks(elbv2APIMocks.EXPECT())
+			tc.route53Mocks(route53Mocks.EXPECT())
+
+			s := &Service{
+				scope: getControlPlaneDNSClusterScope(t, "", func(awsCluster *infrav1.AWSCluster) {
+					awsCluster.Spec.ControlPlaneEndpoint = clusterv1.APIEndpoint{
+						Host: tc.endpointHost,
+						Port: infrav1.DefaultAPIServerPort,
+					}
+				}),
+				ELBV2Client:   elbv2APIMocks,
+				Route53Client: route53Mocks,
+			}
+
+			err := s.ReconcileLoadbalancers()
+			if tc.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(s.scope.Network().APIServerELB.DNSName).To(Equal(dnsTestLBDNSName))
+		})
+	}
+}
+
+func TestDeleteControlPlaneDNS(t *testing.T) {
+	unmanagedVPC := func(awsCluster *infrav1.AWSCluster) {
+		awsCluster.Spec.NetworkSpec.VPC.Tags = nil
+	}
+
+	tests := []struct {
+		name         string
+		lbDNSName    string
+		awsCluster   func(*infrav1.AWSCluster)
+		route53Mocks func(m *mocks.MockRoute53APIMockRecorder)
+	}{
+		{
+			name:      "deletes the record pointing to the control plane load balancer",
+			lbDNSName: dnsTestLBDNSName,
+			route53Mocks: func(m *mocks.MockRoute53APIMockRecorder) {
+				existing := &route53.ResourceRecordSet{
+					Name: aws.String(dnsTestRecordName + "."),
+					Type: aws.String(route53.RRTypeA),
+					AliasTarget: &route53.AliasTarget{
+						DNSName:      aws.String("dualstack." + dnsTestLBDNSName + "."),
+						HostedZoneId: aws.String(dnsTestLBZoneID),
+					},
+				}
+				expectListControlPlaneDNSRecord(m, existing)
+				m.ChangeResourceRecordSets(gomock.Eq(&route53.ChangeResourceRecordSetsInput{
+					HostedZoneId: aws.String(dnsTestHostedZoneID),
+					ChangeBatch: &route53.ChangeBatch{
+						Changes: []*route53.Change{
+							{
+								Action:            aws.String(route53.ChangeActionDelete),
+								ResourceRecordSet: existing,
+							},
+						},
+					},
+				})).Return(&route53.ChangeResourceRecordSetsOutput{}, nil)
+				expectGetHostedZone(m, true, "vpc-id", "vpc-other")
+				m.DisassociateVPCFromHostedZone(gomock.Eq(&route53.DisassociateVPCFromHostedZoneInput{
+					HostedZoneId: aws.String(dnsTestHostedZoneID),
+					VPC: &route53.VPC{
+						VPCId:     aws.String("vpc-id"),
+						VPCRegion: aws.String("us-east-1"),
+					},
+				})).Return(&route53.DisassociateVPCFromHostedZoneOutput{}, nil)
+			},
+		},
+		{
+			name:      "does not delete a record pointing to another target",
+			lbDNSName: dnsTestLBDNSName,
+			route53Mocks: func(m *mocks.MockRoute53APIMockRecorder) {
+				expectListControlPlaneDNSRecord(m, &route53.ResourceRecordSet{
+					Name: aws.String(dnsTestRecordName + "."),
+					Type: aws.String(route53.RRTypeA),
+					AliasTarget: &route53.AliasTarget{
+						DNSName:      aws.String("other-lb-789.elb.us-east-1.amazonaws.com."),
+						HostedZoneId: aws.String(dnsTestLBZoneID),
+					},
+				})
+				expectGetHostedZone(m, false)
+			},
+		},
+		{
+			name:      "does not disassociate the last vpc of a private hosted zone",
+			lbDNSName: "",
+			route53Mocks: func(m *mocks.MockRoute53APIMockRecorder) {
+				expectGetHostedZone(m, true, "vpc-id")
+			},
+		},
+		{
+			name:      "disassociates an unmanaged vpc the provider associated with the hosted zone",
+			lbDNSName: "",
+			awsCluster: func(awsCluster *infrav1.AWSCluster) {
+				unmanagedVPC(awsCluster)
+				awsCluster.Status.Network.AssociatedHostedZoneIDs = []string{dnsTestHostedZoneID}
+			},
+			route53Mocks: func(m *mocks.MockRoute53APIMockRecorder) {
+				expectGetHostedZone(m, true, "vpc-id", "vpc-other")
+				m.DisassociateVPCFromHostedZone(gomock.Eq(&route53.DisassociateVPCFromHostedZoneInput{
+					HostedZoneId: aws.String(dnsTestHostedZoneID),
+					VPC: &route53.VPC{
+						VPCId:     aws.String("vpc-id"),
+						VPCRegion: aws.String("us-east-1"),
+					},
+				})).Return(&route53.DisassociateVPCFromHostedZoneOutput{}, nil)
+			},
+		},
+		{
+			name:         "does not disassociate an unmanaged vpc the provider did not associate with the hosted zone",
+			lbDNSName:    "",
+			awsCluster:   unmanagedVPC,
+			route53Mocks: func(m *mocks.MockRoute53APIMockRecorder) {},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			route53Mocks := mocks.NewMockRoute53API(mockCtrl)
+
+			tc.route53Mocks(route53Mocks.EXPECT())
+
+			var opts []func(*infrav1.AWSCluster)
+			if tc.awsCluster != nil {
+				opts = append(opts, tc.awsCluster)
+			}
+			s := &Service{
+				scope:         getControlPlaneDNSClusterScope(t, tc.lbDNSName, opts...),
+				Route53Client: route53Mocks,
+			}
+
+			g.Expect(s.deleteControlPlaneDNS()).To(Succeed())
+			g.Expect(s.scope.Network().AssociatedHostedZoneIDs).To(BeEmpty())
+		})
+	}
+}
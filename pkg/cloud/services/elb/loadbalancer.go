@@ -36,6 +36,7 @@ import (
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/awserrors"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/converters"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/elasticip"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/wait"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/hash"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/record"
@@ -55,37 +56,53 @@ const maxELBsDescribeTagsRequest = 20
 func (s *Service) ReconcileLoadbalancers() error {
 	s.scope.Debug("Reconciling load balancers")
 
-	// do a switch and reconcile different load-balancer types
-	switch s.scope.ControlPlaneLoadBalancer().LoadBalancerType {
-	case infrav1.LoadBalancerTypeClassic:
-		return s.reconcileClassicLoadBalancer()
-	case infrav1.LoadBalancerTypeNLB, infrav1.LoadBalancerTypeALB, infrav1.LoadBalancerTypeELB:
-		return s.reconcileV2LB()
-	default:
-		return fmt.Errorf("unknown or unsupported load balancer type: %s", s.scope.ControlPlaneLoadBalancer().LoadBalancerType)
+	for i, lbSpec := range s.scope.ControlPlaneLoadBalancers() {
+		// The primary load balancer comes first, followed by the secondary one if it is defined.
+		secondary := i > 0
+		// do a switch and reconcile different load-balancer types
+		switch lbSpec.LoadBalancerType {
+		case infrav1.LoadBalancerTypeClassic:
+			if err := s.reconcileClassicLoadBalancer(); err != nil {
+				return err
+			}
+		case infrav1.LoadBalancerTypeNLB, infrav1.LoadBalancerTypeALB, infrav1.LoadBalancerTypeELB:
+			if err := s.reconcileV2LB(lbSpec, secondary); err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("unknown or unsupported load balancer type: %s", lbSpec.LoadBalancerType)
+		}
 	}
+
+	if err := s.reconcileControlPlaneDNS(); err != nil {
+		return errors.Wrap(err, "failed to reconcile control plane dns record")
+	}
+
+	return nil
 }
 
 // reconcileV2LB creates a load balancer. It also takes care of generating unique names across
-// namespaces by appending the namespace to the name.
-func (s *Service) reconcileV2LB() error {
-	name, err := LBName(s.scope)
+// namespaces by appending the namespace to the name. secondary is true for the secondary control
+// plane load balancer, whose status is stored separately and which does not serve the control plane endpoint.
+func (s *Service) reconcileV2LB(lbSpec *infrav1.AWSLoadBalancerSpec, secondary bool) error {
+	name, err := LBName(s.scope, lbSpec)
 	if err != nil {
 		return errors.Wrap(err, "failed to get control plane load balancer name")
 	}
 
 	// Get default api server spec.
-	spec, err := s.getAPIServerLBSpec(name)
+	spec, err := s.getAPIServerLBSpec(name, lbSpec)
 	if err != nil {
 		return err
 	}
-	lb, err := s.describeLB(name)
+	lb, err := s.describeLB(name, lbSpec)
 	switch {
-	case IsNotFound(err) && s.scope.ControlPlaneEndpoint().IsValid():
-		// if elb is not found and owner cluster ControlPlaneEndpoint is already populated, then we should not recreate the elb.
+	case IsNotFound(err) && s.scope.ControlPlaneEndpoint().IsValid() && !secondary && !s.controlPlaneEndpointIsDNSRecord():
+		// if elb is not found and owner cluster ControlPlaneEndpoint is already populated, then we should not recreate the elb,
+		// unless the endpoint is the Route53 record of the cluster, which is pointed to the new load balancer.
 		return errors.Wrapf(err, "no loadbalancer exists for the AWSCluster %s, the cluster has become unrecoverable and should be deleted manually", s.scope.InfraClusterName())
 	case IsNotFound(err):
-		lb, err = s.createLB(spec)
+		lb, err = s.createLB(spec, lbSpec)
 		if err != nil {
 			s.scope.Error(err, "failed to create LB")
 			return err
@@ -98,7 +115,7 @@ func (s *Service) reconcileV2LB() error {
 	}
 
 	// set up the type for later processing
-	lb.LoadBalancerType = s.scope.ControlPlaneLoadBalancer().LoadBalancerType
+	lb.LoadBalancerType = lbSpec.LoadBalancerType
 	if lb.IsManaged(s.scope.Name()) {
 		if !cmp.Equal(spec.ELBAttributes, lb.ELBAttributes) {
 			if err := s.configureLBAttributes(lb.ARN, spec.ELBAttributes); err != nil {
@@ -126,7 +143,7 @@ func (s *Service) reconcileV2LB() error {
 		}
 
 		// Reconcile the security groups from the spec and the ones currently attached to the load balancer
-		if s.scope.ControlPlaneLoadBalancer().LoadBalancerType != infrav1.LoadBalancerTypeNLB && !sets.NewString(lb.SecurityGroupIDs...).Equal(sets.NewString(spec.SecurityGroupIDs...)) {
+		if lbSpec.LoadBalancerType != infrav1.LoadBalancerTypeNLB && !sets.NewString(lb.SecurityGroupIDs...).Equal(sets.NewString(spec.SecurityGroupIDs...)) {
 			_, err := s.ELBV2Client.SetSecurityGroups(&elbv2.SetSecurityGroupsInput{
 				LoadBalancerArn: &lb.ARN,
 				SecurityGroups:  aws.StringSlice(spec.SecurityGroupIDs),
@@ -138,21 +155,33 @@ func (s *Service) reconcileV2LB() error {
 	} else {
 		s.scope.Trace("Unmanaged control plane load balancer, skipping load balancer configuration", "api-server-elb", lb)
 	}
-	lb.DeepCopyInto(&s.scope.Network().APIServerELB)
+	if secondary {
+		lb.DeepCopyInto(&s.scope.Network().SecondaryAPIServerELB)
+	} else {
+		lb.DeepCopyInto(&s.scope.Network().APIServerELB)
+	}
 	return nil
 }
 
-func (s *Service) getAPIServerLBSpec(elbName string) (*infrav1.LoadBalancer, error) {
+// loadBalancerScheme returns the scheme of the given control plane load balancer, defaulting to internet-facing.
+func loadBalancerScheme(lbSpec *infrav1.AWSLoadBalancerSpec) infrav1.ELBScheme {
+	if lbSpec != nil && lbSpec.Scheme != nil {
+		return *lbSpec.Scheme
+	}
+	return infrav1.ELBSchemeInternetFacing
+}
+
+func (s *Service) getAPIServerLBSpec(elbName string, lbSpec *infrav1.AWSLoadBalancerSpec) (*infrav1.LoadBalancer, error) {
 	var securityGroupIDs []string
-	controlPlaneLoadBalancer := s.scope.ControlPlaneLoadBalancer()
-	if controlPlaneLoadBalancer != nil && controlPlaneLoadBalancer.LoadBalancerType != infrav1.LoadBalancerTypeNLB {
-		securityGroupIDs = append(securityGroupIDs, controlPlaneLoadBalancer.AdditionalSecurityGroups...)
+	if lbSpec != nil && lbSpec.LoadBalancerType != infrav1.LoadBalancerTypeNLB {
+		securityGroupIDs = append(securityGroupIDs, lbSpec.AdditionalSecurityGroups...)
 		securityGroupIDs = append(securityGroupIDs, s.scope.SecurityGroups()[infrav1.SecurityGroupAPIServerLB].ID)
 	}
 
+	scheme := loadBalancerScheme(lbSpec)
 	res := &infrav1.LoadBalancer{
 		Name:          elbName,
-		Scheme:        s.scope.ControlPlaneLoadBalancerScheme(),
+		Scheme:        scheme,
 		ELBAttributes: make(map[string]*string),
 		ELBListeners: []infrav1.Listener{
 			{
@@ -173,12 +202,12 @@ func (s *Service) getAPIServerLBSpec(elbName string) (*infrav1.LoadBalancer, err
 		SecurityGroupIDs: securityGroupIDs,
 	}
 
-	if s.scope.ControlPlaneLoadBalancer() != nil && s.scope.ControlPlaneLoadBalancer().LoadBalancerType != infrav1.LoadBalancerTypeNLB {
+	if lbSpec != nil && lbSpec.LoadBalancerType != infrav1.LoadBalancerTypeNLB {
 		res.ELBAttributes[infrav1.LoadBalancerAttributeIdleTimeTimeoutSeconds] = aws.String(infrav1.LoadBalancerAttributeIdleTimeDefaultTimeoutSecondsInSeconds)
 	}
 
-	if s.scope.ControlPlaneLoadBalancer() != nil {
-		res.ELBAttributes[infrav1.LoadBalancerAttributeEnableLoadBalancingCrossZone] = aws.String(fmt.Sprintf("%t", s.scope.ControlPlaneLoadBalancer().CrossZoneLoadBalancing))
+	if lbSpec != nil {
+		res.ELBAttributes[infrav1.LoadBalancerAttributeEnableLoadBalancingCrossZone] = aws.String(fmt.Sprintf("%t", lbSpec.CrossZoneLoadBalancing))
 	}
 
 	res.Tags = infrav1.Build(infrav1.BuildParams{
@@ -190,11 +219,11 @@ func (s *Service) getAPIServerLBSpec(elbName string) (*infrav1.LoadBalancer, err
 	})
 
 	// If subnet IDs have been specified for this load balancer
-	if s.scope.ControlPlaneLoadBalancer() != nil && len(s.scope.ControlPlaneLoadBalancer().Subnets) > 0 {
+	if lbSpec != nil && len(lbSpec.Subnets) > 0 {
 		// This set of subnets may not match the subnets specified on the Cluster, so we may not have already discovered them
 		// We need to call out to AWS to describe them just in case
 		input := &ec2.DescribeSubnetsInput{
-			SubnetIds: aws.StringSlice(s.scope.ControlPlaneLoadBalancer().Subnets),
+			SubnetIds: aws.StringSlice(lbSpec.Subnets),
 		}
 		out, err := s.EC2Client.DescribeSubnets(input)
 		if err != nil {
@@ -208,7 +237,7 @@ func (s *Service) getAPIServerLBSpec(elbName string) (*infrav1.LoadBalancer, err
 		// The load balancer APIs require us to only attach one subnet for each AZ.
 		subnets := s.scope.Subnets().FilterPrivate()
 
-		if s.scope.ControlPlaneLoadBalancerScheme() == infrav1.ELBSchemeInternetFacing {
+		if scheme == infrav1.ELBSchemeInternetFacing {
 			subnets = s.scope.Subnets().FilterPublic()
 		}
 
@@ -229,9 +258,9 @@ func (s *Service) getAPIServerLBSpec(elbName string) (*infrav1.LoadBalancer, err
 	return res, nil
 }
 
-func (s *Service) createLB(spec *infrav1.LoadBalancer) (*infrav1.LoadBalancer, error) {
+func (s *Service) createLB(spec *infrav1.LoadBalancer, lbSpec *infrav1.AWSLoadBalancerSpec) (*infrav1.LoadBalancer, error) {
 	var t *string
-	switch s.scope.ControlPlaneLoadBalancer().LoadBalancerType {
+	switch lbSpec.LoadBalancerType {
 	case infrav1.LoadBalancerTypeNLB:
 		t = aws.String(elbv2.LoadBalancerTypeEnumNetwork)
 	case infrav1.LoadBalancerTypeALB:
@@ -246,10 +275,25 @@ func (s *Service) createLB(spec *infrav1.LoadBalancer) (*infrav1.LoadBalancer, e
 		Scheme:  aws.String(string(spec.Scheme)),
 		Type:    t,
 	}
-	if s.scope.ControlPlaneLoadBalancer().LoadBalancerType != infrav1.LoadBalancerTypeNLB {
+	if lbSpec.LoadBalancerType != infrav1.LoadBalancerTypeNLB {
 		input.SecurityGroups = aws.StringSlice(spec.SecurityGroupIDs)
 	}
 
+	// Internet-facing network load balancers get their public addresses from the Elastic IP pool when one is set.
+	if lbSpec.LoadBalancerType == infrav1.LoadBalancerTypeNLB && spec.Scheme == infrav1.ELBSchemeInternetFacing && s.scope.ElasticIPPool() != nil {
+		eips, err := elasticip.NewService(s.scope, s.EC2Client).GetOrAllocateAddresses(len(spec.SubnetIDs), infrav1.LoadBalancerRoleTagValue)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get Elastic IP addresses for load balancer %q", spec.Name)
+		}
+		input.Subnets = nil
+		for i, subnetID := range spec.SubnetIDs {
+			input.SubnetMappings = append(input.SubnetMappings, &elbv2.SubnetMapping{
+				SubnetId:     aws.String(subnetID),
+				AllocationId: aws.String(eips[i]),
+			})
+		}
+	}
+
 	if s.scope.VPC().IsIPv6Enabled() {
 		input.IpAddressType = aws.String("dualstack")
 	}
@@ -290,7 +334,7 @@ func (s *Service) createLB(spec *infrav1.LoadBalancer) (*infrav1.LoadBalancer, e
 			return nil, errors.New("no target group was created; the returned list is empty")
 		}
 
-		if !s.scope.ControlPlaneLoadBalancer().PreserveClientIP {
+		if !lbSpec.PreserveClientIP {
 			targetGroupAttributeInput := &elbv2.ModifyTargetGroupAttributesInput{
 				TargetGroupArn: group.TargetGroups[0].TargetGroupArn,
 				Attributes: []*elbv2.TargetGroupAttribute{
@@ -335,7 +379,7 @@ func (s *Service) createLB(spec *infrav1.LoadBalancer) (*infrav1.LoadBalancer, e
 	return res, nil
 }
 
-func (s *Service) describeLB(name string) (*infrav1.LoadBalancer, error) {
+func (s *Service) describeLB(name string, lbSpec *infrav1.AWSLoadBalancerSpec) (*infrav1.LoadBalancer, error) {
 	input := &elbv2.DescribeLoadBalancersInput{
 		Names: aws.StringSlice([]string{name}),
 	}
@@ -366,9 +410,9 @@ func (s *Service) describeLB(name string) (*infrav1.LoadBalancer, error) {
 			name, *out.LoadBalancers[0].VpcId)
 	}
 
-	if s.scope.ControlPlaneLoadBalancer() != nil &&
-		s.scope.ControlPlaneLoadBalancer().Scheme != nil &&
-		string(*s.scope.ControlPlaneLoadBalancer().Scheme) != aws.StringValue(out.LoadBalancers[0].Scheme) {
+	if lbSpec != nil &&
+		lbSpec.Scheme != nil &&
+		string(*lbSpec.Scheme) != aws.StringValue(out.LoadBalancers[0].Scheme) {
 		return nil, errors.Errorf(
 			"Load balancer names must be unique within a region: %q Load balancer already exists in this region with a different scheme %q",
 			name, *out.LoadBalancers[0].Scheme)
@@ -406,8 +450,9 @@ func (s *Service) reconcileClassicLoadBalancer() error {
 
 	apiELB, err := s.describeClassicELB(spec.Name)
 	switch {
-	case IsNotFound(err) && s.scope.ControlPlaneEndpoint().IsValid():
-		// if elb is not found and owner cluster ControlPlaneEndpoint is already populated, then we should not recreate the elb.
+	case IsNotFound(err) && s.scope.ControlPlaneEndpoint().IsValid() && !s.controlPlaneEndpointIsDNSRecord():
+		// if elb is not found and owner cluster ControlPlaneEndpoint is already populated, then we should not recreate the elb,
+		// unless the endpoint is the Route53 record of the cluster, which is pointed to the new load balancer.
 		return errors.Wrapf(err, "no loadbalancer exists for the AWSCluster %s, the cluster has become unrecoverable and should be deleted manually", s.scope.InfraClusterName())
 	case IsNotFound(err):
 		apiELB, err = s.createClassicELB(spec)
@@ -555,6 +600,10 @@ func (s *Service) deleteAWSCloudProviderELBs() error {
 func (s *Service) DeleteLoadbalancers() error {
 	s.scope.Debug("Deleting load balancers")
 
+	if err := s.deleteControlPlaneDNS(); err != nil {
+		return errors.Wrap(err, "failed to delete control plane dns record")
+	}
+
 	if err := s.deleteAPIServerELB(); err != nil {
 		return errors.Wrap(err, "failed to delete control plane load balancer")
 	}
@@ -571,7 +620,16 @@ func (s *Service) DeleteLoadbalancers() error {
 }
 
 func (s *Service) deleteExistingNLBs() error {
-	name, err := LBName(s.scope)
+	for _, lbSpec := range s.scope.ControlPlaneLoadBalancers() {
+		if err := s.deleteExistingNLB(lbSpec); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *Service) deleteExistingNLB(lbSpec *infrav1.AWSLoadBalancerSpec) error {
+	name, err := LBName(s.scope, lbSpec)
 	if err != nil {
 		return errors.Wrap(err, "failed to get control plane load balancer name")
 	}
@@ -580,7 +638,7 @@ func (s *Service) deleteExistingNLBs() error {
 		return err
 	}
 
-	lb, err := s.describeLB(name)
+	lb, err := s.describeLB(name, lbSpec)
 	if IsNotFound(err) {
 		return nil
 	}
@@ -599,7 +657,7 @@ func (s *Service) deleteExistingNLBs() error {
 	}
 
 	if err := wait.WaitForWithRetryable(wait.NewBackoff(), func() (done bool, err error) {
-		_, err = s.describeLB(name)
+		_, err = s.describeLB(name, lbSpec)
 		done = IsNotFound(err)
 		return done, nil
 	}); err != nil {
@@ -641,8 +699,8 @@ func (s *Service) IsInstanceRegisteredWithAPIServerELB(i *infrav1.Instance) (boo
 }
 
 // IsInstanceRegisteredWithAPIServerLB returns true if the instance is already registered with the APIServer LB.
-func (s *Service) IsInstanceRegisteredWithAPIServerLB(i *infrav1.Instance) (string, bool, error) {
-	name, err := LBName(s.scope)
+func (s *Service) IsInstanceRegisteredWithAPIServerLB(i *infrav1.Instance, lbSpec *infrav1.AWSLoadBalancerSpec) (string, bool, error) {
+	name, err := LBName(s.scope, lbSpec)
 	if err != nil {
 		return "", false, errors.Wrap(err, "failed to get control plane load balancer name")
 	}
@@ -735,12 +793,12 @@ func (s *Service) RegisterInstanceWithAPIServerELB(i *infrav1.Instance) error {
 }
 
 // RegisterInstanceWithAPIServerLB registers an instance with a LB.
-func (s *Service) RegisterInstanceWithAPIServerLB(instance *infrav1.Instance) error {
-	name, err := LBName(s.scope)
+func (s *Service) RegisterInstanceWithAPIServerLB(instance *infrav1.Instance, lbSpec *infrav1.AWSLoadBalancerSpec) error {
+	name, err := LBName(s.scope, lbSpec)
 	if err != nil {
 		return errors.Wrap(err, "failed to get control plane load balancer name")
 	}
-	out, err := s.describeLB(name)
+	out, err := s.describeLB(name, lbSpec)
 	if err != nil {
 		return err
 	}
@@ -866,11 +924,11 @@ func ELBName(s scope.ELBScope) (string, error) {
 	return name, nil
 }
 
-// LBName returns the user-defined API Server ELB name, or a generated default if the user has not defined the ELB
+// LBName returns the user-defined API Server LB name, or a generated default if the user has not defined the LB
 // name.
-func LBName(s scope.ELBScope) (string, error) {
-	if userDefinedName := s.ControlPlaneLoadBalancerName(); userDefinedName != nil {
-		return *userDefinedName, nil
+func LBName(s scope.ELBScope, lbSpec *infrav1.AWSLoadBalancerSpec) (string, error) {
+	if lbSpec != nil && lbSpec.Name != nil {
+		return *lbSpec.Name, nil
 	}
 	name, err := GenerateELBName(fmt.Sprintf("%s-%s", s.Namespace(), s.Name()))
 	if err != nil {
@@ -106,6 +106,81 @@ func TestELBName(t *testing.T) {
 	}
 }
 
+func TestLBName(t *testing.T) {
+	tests := []struct {
+		name       string
+		awsCluster infrav1.AWSCluster
+		secondary  bool
+		expected   string
+	}{
+		{
+			name: "primary name is not defined by user, so generate the default",
+			awsCluster: infrav1.AWSCluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "example",
+					Namespace: metav1.NamespaceDefault,
+				},
+				Spec: infrav1.AWSClusterSpec{
+					ControlPlaneLoadBalancer: &infrav1.AWSLoadBalancerSpec{},
+				},
+			},
+			expected: "default-example-apiserver",
+		},
+		{
+			name: "secondary name is defined by user, so use it",
+			awsCluster: infrav1.AWSCluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "example",
+					Namespace: metav1.NamespaceDefault,
+				},
+				Spec: infrav1.AWSClusterSpec{
+					ControlPlaneLoadBalancer: &infrav1.AWSLoadBalancerSpec{
+						Name: pointer.String("myapiserver"),
+					},
+					SecondaryControlPlaneLoadBalancer: &infrav1.AWSLoadBalancerSpec{
+						Name: pointer.String("myinternalapiserver"),
+					},
+				},
+			},
+			secondary: true,
+			expected:  "myinternalapiserver",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			scheme := runtime.NewScheme()
+			_ = infrav1.AddToScheme(scheme)
+			client := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+			scope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Client: client,
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      tt.awsCluster.Name,
+						Namespace: tt.awsCluster.Namespace,
+					},
+				},
+				AWSCluster: &tt.awsCluster,
+			})
+			if err != nil {
+				t.Fatalf("failed to create scope: %s", err)
+			}
+
+			lbSpec := tt.awsCluster.Spec.ControlPlaneLoadBalancer
+			if tt.secondary {
+				lbSpec = tt.awsCluster.Spec.SecondaryControlPlaneLoadBalancer
+			}
+			lbName, err := LBName(scope, lbSpec)
+			if err != nil {
+				t.Fatalf("unable to get LB name: %v", err)
+			}
+			if lbName != tt.expected {
+				t.Fatalf("expected LB name: %v, got name: %v", tt.expected, lbName)
+			}
+		})
+	}
+}
+
 func TestGenerateELBName(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -444,7 +519,7 @@ func TestGetAPIServerV2ELBSpecControlPlaneLoadBalancer(t *testing.T) {
 				EC2Client: ec2Mock,
 			}
 
-			spec, err := s.getAPIServerLBSpec(clusterScope.Name())
+			spec, err := s.getAPIServerLBSpec(clusterScope.Name(), clusterScope.ControlPlaneLoadBalancer())
 			if err != nil {
 				t.Fatal(err)
 			}
@@ -985,7 +1060,7 @@ func TestRegisterInstanceWithAPIServerNLB(t *testing.T) {
 				ELBV2Client: elbV2APIMocks,
 			}
 
-			err = s.RegisterInstanceWithAPIServerLB(instance)
+			err = s.RegisterInstanceWithAPIServerLB(instance, clusterScope.ControlPlaneLoadBalancer())
 			tc.check(t, err)
 		})
 	}
@@ -1005,6 +1080,7 @@ func TestCreateNLB(t *testing.T) {
 	tests := []struct {
 		name          string
 		elbV2APIMocks func(m *mocks.MockELBV2APIMockRecorder)
+		ec2Mocks      func(m *mocks.MockEC2APIMockRecorder)
 		check         func(t *testing.T, lb *infrav1.LoadBalancer, err error)
 		awsCluster    func(acl infrav1.AWSCluster) infrav1.AWSCluster
 		spec          func(spec infrav1.LoadBalancer) infrav1.LoadBalancer
@@ -1501,6 +1577,76 @@ func TestCreateNLB(t *testing.T) {
 				}
 			},
 		},
+		{
+			name: "internet-facing with Elastic IP pool uses subnet mappings",
+			spec: func(spec infrav1.LoadBalancer) infrav1.LoadBalancer {
+				spec.ELBListeners = nil
+				spec.SubnetIDs = []string{clusterSubnetID, "subnet-2"}
+				return spec
+			},
+			awsCluster: func(acl infrav1.AWSCluster) infrav1.AWSCluster {
+				acl.Spec.NetworkSpec.ElasticIPPool = &infrav1.ElasticIPPool{
+					PublicIpv4Pool: aws.String("ipv4pool-ec2-123"),
+					AllocationIDs:  []string{"eipalloc-byo"},
+				}
+				return acl
+			},
+			ec2Mocks: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeAddresses(&ec2.DescribeAddressesInput{
+					AllocationIds: aws.StringSlice([]string{"eipalloc-byo"}),
+				}).Return(&ec2.DescribeAddressesOutput{
+					Addresses: []*ec2.Address{{AllocationId: aws.String("eipalloc-byo")}},
+				}, nil)
+				m.DescribeAddresses(gomock.AssignableToTypeOf(&ec2.DescribeAddressesInput{})).Return(&ec2.DescribeAddressesOutput{}, nil)
+				m.AllocateAddress(gomock.AssignableToTypeOf(&ec2.AllocateAddressInput{})).DoAndReturn(func(input *ec2.AllocateAddressInput) (*ec2.AllocateAddressOutput, error) {
+					if aws.StringValue(input.PublicIpv4Pool) != "ipv4pool-ec2-123" {
+						t.Fatalf("expected public IPv4 pool ipv4pool-ec2-123, got %q", aws.StringValue(input.PublicIpv4Pool))
+					}
+					return &ec2.AllocateAddressOutput{AllocationId: aws.String("eipalloc-pool")}, nil
+				})
+			},
+			elbV2APIMocks: func(m *mocks.MockELBV2APIMockRecorder) {
+				m.CreateLoadBalancer(gomock.Eq(&elbv2.CreateLoadBalancerInput{
+					Name:   aws.String(elbName),
+					Scheme: aws.String("internet-facing"),
+					Type:   aws.String("network"),
+					SubnetMappings: []*elbv2.SubnetMapping{
+						{
+							SubnetId:     aws.String(clusterSubnetID),
+							AllocationId: aws.String("eipalloc-byo"),
+						},
+						{
+							SubnetId:     aws.String("subnet-2"),
+							AllocationId: aws.String("eipalloc-pool"),
+						},
+					},
+					Tags: []*elbv2.Tag{
+						{
+							Key:   aws.String("test"),
+							Value: aws.String("tag"),
+						},
+					},
+				})).Return(&elbv2.CreateLoadBalancerOutput{
+					LoadBalancers: []*elbv2.LoadBalancer{
+						{
+							LoadBalancerArn:  aws.String(elbArn),
+							LoadBalancerName: aws.String(elbName),
+							Scheme:           aws.String(string(infrav1.ELBSchemeInternetFacing)),
+							DNSName:          aws.String(dns),
+						},
+					},
+				}, nil)
+			},
+			check: func(t *testing.T, lb *infrav1.LoadBalancer, err error) {
+				t.Helper()
+				if err != nil {
+					t.Fatalf("did not expect error: %v", err)
+				}
+				if lb.DNSName != dns {
+					t.Fatalf("DNSName did not equal expected value; was: '%s'", lb.DNSName)
+				}
+			},
+		},
 	}
 
 	for _, tc := range tests {
@@ -1508,6 +1654,7 @@ func TestCreateNLB(t *testing.T) {
 			mockCtrl := gomock.NewController(t)
 			defer mockCtrl.Finish()
 			elbV2APIMocks := mocks.NewMockELBV2API(mockCtrl)
+			ec2Mock := mocks.NewMockEC2API(mockCtrl)
 
 			scheme, err := setupScheme()
 			if err != nil {
@@ -1544,9 +1691,13 @@ func TestCreateNLB(t *testing.T) {
 			}
 
 			tc.elbV2APIMocks(elbV2APIMocks.EXPECT())
+			if tc.ec2Mocks != nil {
+				tc.ec2Mocks(ec2Mock.EXPECT())
+			}
 
 			s := &Service{
 				scope:       clusterScope,
+				EC2Client:   ec2Mock,
 				ELBV2Client: elbV2APIMocks,
 			}
 
@@ -1578,7 +1729,7 @@ func TestCreateNLB(t *testing.T) {
 			}
 
 			spec := tc.spec(*loadBalancerSpec)
-			lb, err := s.createLB(&spec)
+			lb, err := s.createLB(&spec, clusterScope.ControlPlaneLoadBalancer())
 			tc.check(t, lb, err)
 		})
 	}
@@ -1969,6 +2120,273 @@ func TestDeleteNLB(t *testing.T) {
 	}
 }
 
+func TestDeleteNLBsWithSecondaryLoadBalancer(t *testing.T) {
+	const (
+		clusterName       = "bar"
+		elbName           = "bar-apiserver"
+		elbArn            = "apiserver::arn"
+		secondaryElbName  = "bar-apiserver-internal"
+		secondaryElbArn   = "apiserver-internal::arn"
+		listenerArnSuffix = "::listener"
+		groupArnSuffix    = "::target-group"
+	)
+
+	expectDeletion := func(m *mocks.MockELBV2APIMockRecorder, name, arn string) {
+		m.DescribeLoadBalancers(&elbv2.DescribeLoadBalancersInput{Names: []*string{aws.String(name)}}).Return(
+			&elbv2.DescribeLoadBalancersOutput{
+				LoadBalancers: []*elbv2.LoadBalancer{
+					{
+						LoadBalancerArn:  aws.String(arn),
+						LoadBalancerName: aws.String(name),
+						Scheme:           aws.String(string(infrav1.ELBSchemeInternetFacing)),
+					},
+				},
+			},
+			nil,
+		)
+		m.DescribeLoadBalancerAttributes(&elbv2.DescribeLoadBalancerAttributesInput{LoadBalancerArn: aws.String(arn)}).Return(
+			&elbv2.DescribeLoadBalancerAttributesOutput{}, nil)
+		m.DescribeTags(&elbv2.DescribeTagsInput{ResourceArns: []*string{aws.String(arn)}}).Return(
+			&elbv2.DescribeTagsOutput{
+				TagDescriptions: []*elbv2.TagDescription{
+					{
+						ResourceArn: aws.String(arn),
+						Tags: []*elbv2.Tag{{
+							Key:   aws.String(infrav1.ClusterTagKey(clusterName)),
+							Value: aws.String(string(infrav1.ResourceLifecycleOwned)),
+						}},
+					},
+				},
+			},
+			nil,
+		)
+		m.DescribeListeners(&elbv2.DescribeListenersInput{LoadBalancerArn: aws.String(arn)}).Return(&elbv2.DescribeListenersOutput{
+			Listeners: []*elbv2.Listener{{ListenerArn: aws.String(arn + listenerArnSuffix)}},
+		}, nil)
+		m.DeleteListener(&elbv2.DeleteListenerInput{ListenerArn: aws.String(arn + listenerArnSuffix)}).Return(&elbv2.DeleteListenerOutput{}, nil)
+		m.DescribeTargetGroups(&elbv2.DescribeTargetGroupsInput{LoadBalancerArn: aws.String(arn)}).Return(&elbv2.DescribeTargetGroupsOutput{
+			TargetGroups: []*elbv2.TargetGroup{{TargetGroupArn: aws.String(arn + groupArnSuffix)}},
+		}, nil)
+		m.DeleteTargetGroup(&elbv2.DeleteTargetGroupInput{TargetGroupArn: aws.String(arn + groupArnSuffix)}).Return(&elbv2.DeleteTargetGroupOutput{}, nil)
+		m.DeleteLoadBalancer(&elbv2.DeleteLoadBalancerInput{LoadBalancerArn: aws.String(arn)}).Return(&elbv2.DeleteLoadBalancerOutput{}, nil)
+		m.DescribeLoadBalancers(&elbv2.DescribeLoadBalancersInput{Names: []*string{aws.String(name)}}).Return(
+			&elbv2.DescribeLoadBalancersOutput{LoadBalancers: []*elbv2.LoadBalancer{}}, nil)
+	}
+
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	elbv2ApiMock := mocks.NewMockELBV2API(mockCtrl)
+
+	scheme, err := setupScheme()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	awsCluster := &infrav1.AWSCluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "test"},
+		Spec: infrav1.AWSClusterSpec{
+			ControlPlaneLoadBalancer: &infrav1.AWSLoadBalancerSpec{
+				Name:             aws.String(elbName),
+				LoadBalancerType: infrav1.LoadBalancerTypeNLB,
+			},
+			SecondaryControlPlaneLoadBalancer: &infrav1.AWSLoadBalancerSpec{
+				Name:             aws.String(secondaryElbName),
+				LoadBalancerType: infrav1.LoadBalancerTypeNLB,
+			},
+		},
+	}
+
+	client := fake.NewClientBuilder().WithScheme(scheme).Build()
+	ctx := context.TODO()
+	client.Create(ctx, awsCluster)
+
+	clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+		Cluster: &clusterv1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "foo",
+				Name:      clusterName,
+			},
+		},
+		AWSCluster: awsCluster,
+		Client:     client,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expectDeletion(elbv2ApiMock.EXPECT(), elbName, elbArn)
+	expectDeletion(elbv2ApiMock.EXPECT(), secondaryElbName, secondaryElbArn)
+
+	s := &Service{
+		scope:       clusterScope,
+		ELBV2Client: elbv2ApiMock,
+	}
+
+	if err := s.deleteExistingNLBs(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestReconcileLoadbalancersWithSecondaryNLB(t *testing.T) {
+	const (
+		namespace        = "foo"
+		clusterName      = "bar"
+		clusterSubnetID  = "subnet-1"
+		vpcID            = "vpc-id"
+		elbName          = "bar-apiserver"
+		elbArn           = "apiserver::arn"
+		secondaryElbName = "bar-apiserver-internal"
+		secondaryElbArn  = "apiserver-internal::arn"
+		secondaryDNS     = "bar-apiserver-internal.elb.amazonaws.com"
+	)
+
+	g := NewWithT(t)
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	elbV2APIMocks := mocks.NewMockELBV2API(mockCtrl)
+
+	scheme, err := setupScheme()
+	g.Expect(err).NotTo(HaveOccurred())
+
+	awsCluster := &infrav1.AWSCluster{
+		ObjectMeta: metav1.ObjectMeta{Name: clusterName},
+		Spec: infrav1.AWSClusterSpec{
+			ControlPlaneEndpoint: clusterv1.APIEndpoint{
+				Host: "bar-apiserver.elb.amazonaws.com",
+				Port: infrav1.DefaultAPIServerPort,
+			},
+			ControlPlaneLoadBalancer: &infrav1.AWSLoadBalancerSpec{
+				Name:             aws.String(elbName),
+				LoadBalancerType: infrav1.LoadBalancerTypeNLB,
+			},
+			SecondaryControlPlaneLoadBalancer: &infrav1.AWSLoadBalancerSpec{
+				Name:             aws.String(secondaryElbName),
+				LoadBalancerType: infrav1.LoadBalancerTypeNLB,
+			},
+			NetworkSpec: infrav1.NetworkSpec{
+				VPC: infrav1.VPCSpec{
+					ID: vpcID,
+				},
+				Subnets: infrav1.Subnets{{
+					ID:               clusterSubnetID,
+					AvailabilityZone: "us-east-1a",
+					IsPublic:         true,
+				}},
+			},
+		},
+	}
+	client := fake.NewClientBuilder().WithScheme(scheme).Build()
+	clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+		Client: client,
+		Cluster: &clusterv1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: namespace,
+				Name:      clusterName,
+			},
+		},
+		AWSCluster: awsCluster,
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	tags := func(name string) []*elbv2.Tag {
+		return []*elbv2.Tag{
+			{
+				Key:   aws.String("Name"),
+				Value: aws.String(name),
+			},
+			{
+				Key:   aws.String(infrav1.ClusterTagKey(clusterName)),
+				Value: aws.String(string(infrav1.ResourceLifecycleOwned)),
+			},
+			{
+				Key:   aws.String(infrav1.NameAWSClusterAPIRole),
+				Value: aws.String(infrav1.APIServerRoleTagValue),
+			},
+		}
+	}
+
+	m := elbV2APIMocks.EXPECT()
+	// The primary load balancer already exists and is up to date.
+	m.DescribeLoadBalancers(gomock.Eq(&elbv2.DescribeLoadBalancersInput{Names: aws.StringSlice([]string{elbName})})).
+		Return(&elbv2.DescribeLoadBalancersOutput{
+			LoadBalancers: []*elbv2.LoadBalancer{
+				{
+					LoadBalancerArn:   aws.String(elbArn),
+					LoadBalancerName:  aws.String(elbName),
+					Scheme:            aws.String(string(infrav1.ELBSchemeInternetFacing)),
+					AvailabilityZones: []*elbv2.AvailabilityZone{{SubnetId: aws.String(clusterSubnetID), ZoneName: aws.String("us-east-1a")}},
+					VpcId:             aws.String(vpcID),
+					DNSName:           aws.String("bar-apiserver.elb.amazonaws.com"),
+				},
+			},
+		}, nil)
+	m.DescribeLoadBalancerAttributes(gomock.Eq(&elbv2.DescribeLoadBalancerAttributesInput{LoadBalancerArn: aws.String(elbArn)})).
+		Return(&elbv2.DescribeLoadBalancerAttributesOutput{
+			Attributes: []*elbv2.LoadBalancerAttribute{
+				{
+					Key:   aws.String(infrav1.LoadBalancerAttributeEnableLoadBalancingCrossZone),
+					Value: aws.String("false"),
+				},
+			},
+		}, nil)
+	m.DescribeTags(gomock.Eq(&elbv2.DescribeTagsInput{ResourceArns: aws.StringSlice([]string{elbArn})})).
+		Return(&elbv2.DescribeTagsOutput{
+			TagDescriptions: []*elbv2.TagDescription{
+				{
+					ResourceArn: aws.String(elbArn),
+					Tags:        tags(elbName),
+				},
+			},
+		}, nil)
+	// The secondary load balancer does not exist yet and is created even though the control plane endpoint is set.
+	m.DescribeLoadBalancers(gomock.Eq(&elbv2.DescribeLoadBalancersInput{Names: aws.StringSlice([]string{secondaryElbName})})).
+		Return(&elbv2.DescribeLoadBalancersOutput{}, nil)
+	m.CreateLoadBalancer(gomock.Any()).DoAndReturn(func(input *elbv2.CreateLoadBalancerInput) (*elbv2.CreateLoadBalancerOutput, error) {
+		g.Expect(input.Name).To(Equal(aws.String(secondaryElbName)))
+		g.Expect(input.Type).To(Equal(aws.String(elbv2.LoadBalancerTypeEnumNetwork)))
+		g.Expect(input.Subnets).To(Equal(aws.StringSlice([]string{clusterSubnetID})))
+		g.Expect(input.Tags).To(ConsistOf(tags(secondaryElbName)))
+		return &elbv2.CreateLoadBalancerOutput{
+			LoadBalancers: []*elbv2.LoadBalancer{
+				{
+					LoadBalancerArn:  aws.String(secondaryElbArn),
+					LoadBalancerName: aws.String(secondaryElbName),
+					Scheme:           aws.String(string(infrav1.ELBSchemeInternetFacing)),
+					DNSName:          aws.String(secondaryDNS),
+				},
+			},
+		}, nil
+	})
+	m.CreateTargetGroup(gomock.Any()).Return(&elbv2.CreateTargetGroupOutput{
+		TargetGroups: []*elbv2.TargetGroup{
+			{
+				TargetGroupArn: aws.String("target-group::arn"),
+				VpcId:          aws.String(vpcID),
+			},
+		},
+	}, nil)
+	m.ModifyTargetGroupAttributes(gomock.Any()).Return(nil, nil)
+	m.CreateListener(gomock.Any()).Return(&elbv2.CreateListenerOutput{
+		Listeners: []*elbv2.Listener{
+			{
+				ListenerArn: aws.String("listener::arn"),
+			},
+		},
+	}, nil)
+
+	s := &Service{
+		scope:       clusterScope,
+		ELBV2Client: elbV2APIMocks,
+	}
+
+	g.Expect(s.ReconcileLoadbalancers()).To(Succeed())
+	g.Expect(awsCluster.Status.Network.APIServerELB.Name).To(Equal(elbName))
+	g.Expect(awsCluster.Status.Network.APIServerELB.ARN).To(Equal(elbArn))
+	g.Expect(awsCluster.Status.Network.SecondaryAPIServerELB.Name).To(Equal(secondaryElbName))
+	g.Expect(awsCluster.Status.Network.SecondaryAPIServerELB.DNSName).To(Equal(secondaryDNS))
+	g.Expect(awsCluster.Status.Network.SecondaryAPIServerELB.LoadBalancerType).To(Equal(infrav1.LoadBalancerTypeNLB))
+}
+
 func TestDeleteAWSCloudProviderELBs(t *testing.T) {
 	clusterName := "bar"
 	tests := []struct {
@@ -2277,7 +2695,7 @@ func TestDescribeV2Loadbalancers(t *testing.T) {
 				ELBV2Client:           elbV2ApiMock,
 			}
 
-			_, err = s.describeLB(tc.lbName)
+			_, err = s.describeLB(tc.lbName, clusterScope.ControlPlaneLoadBalancer())
 			if err == nil {
 				t.Fatal(err)
 			}
@@ -21,6 +21,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/elb/elbiface"
 	"github.com/aws/aws-sdk-go/service/elbv2/elbv2iface"
 	"github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi/resourcegroupstaggingapiiface"
+	"github.com/aws/aws-sdk-go/service/route53/route53iface"
 
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
 )
@@ -34,6 +35,7 @@ type Service struct {
 	ELBClient             elbiface.ELBAPI
 	ELBV2Client           elbv2iface.ELBV2API
 	ResourceTaggingClient resourcegroupstaggingapiiface.ResourceGroupsTaggingAPIAPI
+	Route53Client         route53iface.Route53API
 }
 
 // NewService returns a new service given the api clients.
@@ -44,5 +46,6 @@ func NewService(elbScope scope.ELBScope) *Service {
 		ELBClient:             scope.NewELBClient(elbScope, elbScope, elbScope, elbScope.InfraCluster()),
 		ELBV2Client:           scope.NewELBv2Client(elbScope, elbScope, elbScope, elbScope.InfraCluster()),
 		ResourceTaggingClient: scope.NewResourgeTaggingClient(elbScope, elbScope, elbScope, elbScope.InfraCluster()),
+		Route53Client:         scope.NewRoute53Client(elbScope, elbScope, elbScope, elbScope.InfraCluster()),
 	}
 }
@@ -61,6 +61,9 @@ type EC2Interface interface {
 	UpdateInstanceSecurityGroups(id string, securityGroups []string) error
 	UpdateResourceTags(resourceID *string, create, remove map[string]string) error
 	ModifyInstanceMetadataOptions(instanceID string, options *infrav1.InstanceMetadataOptions) error
+	ModifyInstanceMaintenanceOptions(instance *infrav1.Instance, options *infrav1.InstanceMaintenanceOptions) error
+	ReconcileElasticIP(scope *scope.MachineScope, instanceID string) (string, error)
+	ReleaseElasticIP(scope *scope.MachineScope) error
 
 	TerminateInstanceAndWait(instanceID string) error
 	DetachSecurityGroupsFromNetworkInterface(groups []string, interfaceID string) error
@@ -95,11 +98,11 @@ type ELBInterface interface {
 	DeleteLoadbalancers() error
 	ReconcileLoadbalancers() error
 	IsInstanceRegisteredWithAPIServerELB(i *infrav1.Instance) (bool, error)
-	IsInstanceRegisteredWithAPIServerLB(i *infrav1.Instance) (string, bool, error)
+	IsInstanceRegisteredWithAPIServerLB(i *infrav1.Instance, lb *infrav1.AWSLoadBalancerSpec) (string, bool, error)
 	DeregisterInstanceFromAPIServerELB(i *infrav1.Instance) error
 	DeregisterInstanceFromAPIServerLB(targetGroupArn string, i *infrav1.Instance) error
 	RegisterInstanceWithAPIServerELB(i *infrav1.Instance) error
-	RegisterInstanceWithAPIServerLB(i *infrav1.Instance) error
+	RegisterInstanceWithAPIServerLB(i *infrav1.Instance, lb *infrav1.AWSLoadBalancerSpec) error
 }
 
 // NetworkInterface encapsulates the methods exposed to the cluster
@@ -116,6 +119,13 @@ type SecurityGroupInterface interface {
 	ReconcileSecurityGroups() error
 }
 
+// PlacementGroupInterface encapsulates the methods exposed to the cluster
+// controller.
+type PlacementGroupInterface interface {
+	DeletePlacementGroups() error
+	ReconcilePlacementGroups() error
+}
+
 // ObjectStoreInterface encapsulates the methods exposed to the machine actuator.
 type ObjectStoreInterface interface {
 	DeleteBucket() error
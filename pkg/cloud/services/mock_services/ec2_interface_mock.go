@@ -289,6 +289,20 @@ func (mr *MockEC2InterfaceMockRecorder) LaunchTemplateNeedsUpdate(arg0, arg1, ar
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LaunchTemplateNeedsUpdate", reflect.TypeOf((*MockEC2Interface)(nil).LaunchTemplateNeedsUpdate), arg0, arg1, arg2)
 }
 
+// ModifyInstanceMaintenanceOptions mocks base method.
+func (m *MockEC2Interface) ModifyInstanceMaintenanceOptions(arg0 *v1beta2.Instance, arg1 *v1beta2.InstanceMaintenanceOptions) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ModifyInstanceMaintenanceOptions", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ModifyInstanceMaintenanceOptions indicates an expected call of ModifyInstanceMaintenanceOptions.
+func (mr *MockEC2InterfaceMockRecorder) ModifyInstanceMaintenanceOptions(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModifyInstanceMaintenanceOptions", reflect.TypeOf((*MockEC2Interface)(nil).ModifyInstanceMaintenanceOptions), arg0, arg1)
+}
+
 // ModifyInstanceMetadataOptions mocks base method.
 func (m *MockEC2Interface) ModifyInstanceMetadataOptions(arg0 string, arg1 *v1beta2.InstanceMetadataOptions) error {
 	m.ctrl.T.Helper()
@@ -331,6 +345,21 @@ func (mr *MockEC2InterfaceMockRecorder) ReconcileBastion() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReconcileBastion", reflect.TypeOf((*MockEC2Interface)(nil).ReconcileBastion))
 }
 
+// ReconcileElasticIP mocks base method.
+func (m *MockEC2Interface) ReconcileElasticIP(arg0 *scope.MachineScope, arg1 string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReconcileElasticIP", arg0, arg1)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReconcileElasticIP indicates an expected call of ReconcileElasticIP.
+func (mr *MockEC2InterfaceMockRecorder) ReconcileElasticIP(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReconcileElasticIP", reflect.TypeOf((*MockEC2Interface)(nil).ReconcileElasticIP), arg0, arg1)
+}
+
 // ReconcileLaunchTemplate mocks base method.
 func (m *MockEC2Interface) ReconcileLaunchTemplate(arg0 scope.LaunchTemplateScope, arg1 func() (bool, error), arg2 func() error) error {
 	m.ctrl.T.Helper()
@@ -359,6 +388,20 @@ func (mr *MockEC2InterfaceMockRecorder) ReconcileTags(arg0, arg1 interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReconcileTags", reflect.TypeOf((*MockEC2Interface)(nil).ReconcileTags), arg0, arg1)
 }
 
+// ReleaseElasticIP mocks base method.
+func (m *MockEC2Interface) ReleaseElasticIP(arg0 *scope.MachineScope) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseElasticIP", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReleaseElasticIP indicates an expected call of ReleaseElasticIP.
+func (mr *MockEC2InterfaceMockRecorder) ReleaseElasticIP(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseElasticIP", reflect.TypeOf((*MockEC2Interface)(nil).ReleaseElasticIP), arg0)
+}
+
 // TerminateInstance mocks base method.
 func (m *MockEC2Interface) TerminateInstance(arg0 string) error {
 	m.ctrl.T.Helper()
@@ -17,15 +17,23 @@ limitations under the License.
 package network
 
 import (
+	"regexp"
 	"sort"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/pkg/errors"
 
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/filter"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/record"
 )
 
+// availabilityZoneNameRegexp matches the names of the standard availability zones, which are the name of
+// their region followed by a letter. Local Zones and Wavelength Zones have longer names, like
+// us-east-1-bos-1a or us-east-1-wl1-bos-wlz-1.
+var availabilityZoneNameRegexp = regexp.MustCompile(`^[a-z]{2}(-[a-z]+)+-\d+[a-z]$`)
+
 func (s *Service) getAvailableZones() ([]string, error) {
 	out, err := s.EC2Client.DescribeAvailabilityZones(&ec2.DescribeAvailabilityZonesInput{
 		Filters: []*ec2.Filter{
@@ -40,9 +48,60 @@ func (s *Service) getAvailableZones() ([]string, error) {
 
 	zones := make([]string, 0, len(out.AvailabilityZones))
 	for _, zone := range out.AvailabilityZones {
+		// Default subnets are only created in standard availability zones.
+		if zone.ZoneType != nil && *zone.ZoneType != string(infrav1.ZoneTypeAvailabilityZone) {
+			continue
+		}
 		zones = append(zones, *zone.ZoneName)
 	}
 
 	sort.Strings(zones)
 	return zones, nil
 }
+
+// reconcileZoneInfo sets the zone type and parent zone of the subnets that don't have them yet.
+// Only the zones that are not standard availability zones are described.
+func (s *Service) reconcileZoneInfo(subnets infrav1.Subnets) error {
+	edgeZones := []string{}
+	for i := range subnets {
+		sn := &subnets[i]
+		if sn.ZoneType != nil || sn.AvailabilityZone == "" {
+			continue
+		}
+		if availabilityZoneNameRegexp.MatchString(sn.AvailabilityZone) {
+			zoneType := infrav1.ZoneTypeAvailabilityZone
+			sn.ZoneType = &zoneType
+			continue
+		}
+		edgeZones = append(edgeZones, sn.AvailabilityZone)
+	}
+	if len(edgeZones) == 0 {
+		return nil
+	}
+
+	out, err := s.EC2Client.DescribeAvailabilityZones(&ec2.DescribeAvailabilityZonesInput{
+		AllAvailabilityZones: aws.Bool(true),
+		ZoneNames:            aws.StringSlice(edgeZones),
+	})
+	if err != nil {
+		record.Eventf(s.scope.InfraCluster(), "FailedDescribeAvailableZone", "Failed getting zones %v: %v", edgeZones, err)
+		return errors.Wrapf(err, "failed to describe zones %v", edgeZones)
+	}
+
+	zones := make(map[string]*ec2.AvailabilityZone, len(out.AvailabilityZones))
+	for _, zone := range out.AvailabilityZones {
+		zones[aws.StringValue(zone.ZoneName)] = zone
+	}
+	for i := range subnets {
+		sn := &subnets[i]
+		zone, ok := zones[sn.AvailabilityZone]
+		if sn.ZoneType != nil || !ok {
+			continue
+		}
+		zoneType := infrav1.ZoneType(aws.StringValue(zone.ZoneType))
+		sn.ZoneType = &zoneType
+		sn.ParentZoneName = zone.ParentZoneName
+	}
+
+	return nil
+}
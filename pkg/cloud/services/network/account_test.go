@@ -0,0 +1,82 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/test/mocks"
+)
+
+func TestReconcileZoneInfo(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	g := NewWithT(t)
+	ec2Mock := mocks.NewMockEC2API(mockCtrl)
+
+	scope, err := getVPCEndpointsClusterScope(&infrav1.NetworkSpec{
+		VPC: infrav1.VPCSpec{
+			ID: "vpc-zones",
+		},
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	s := NewService(scope)
+	s.EC2Client = ec2Mock
+
+	ec2Mock.EXPECT().DescribeAvailabilityZones(gomock.Eq(&ec2.DescribeAvailabilityZonesInput{
+		AllAvailabilityZones: aws.Bool(true),
+		ZoneNames:            aws.StringSlice([]string{"us-east-1-bos-1a", "us-east-1-wl1-bos-wlz-1"}),
+	})).Return(&ec2.DescribeAvailabilityZonesOutput{
+		AvailabilityZones: []*ec2.AvailabilityZone{
+			{
+				ZoneName:       aws.String("us-east-1-bos-1a"),
+				ZoneType:       aws.String("local-zone"),
+				ParentZoneName: aws.String("us-east-1a"),
+			},
+			{
+				ZoneName:       aws.String("us-east-1-wl1-bos-wlz-1"),
+				ZoneType:       aws.String("wavelength-zone"),
+				ParentZoneName: aws.String("us-east-1a"),
+			},
+		},
+	}, nil)
+
+	subnets := infrav1.Subnets{
+		{ID: "subnet-az", AvailabilityZone: "us-east-1a"},
+		{ID: "subnet-lz", AvailabilityZone: "us-east-1-bos-1a"},
+		{ID: "subnet-wlz", AvailabilityZone: "us-east-1-wl1-bos-wlz-1", IsPublic: true},
+	}
+	g.Expect(s.reconcileZoneInfo(subnets)).To(Succeed())
+
+	g.Expect(*subnets[0].ZoneType).To(Equal(infrav1.ZoneTypeAvailabilityZone))
+	g.Expect(subnets[0].ParentZoneName).To(BeNil())
+	g.Expect(*subnets[1].ZoneType).To(Equal(infrav1.ZoneTypeLocalZone))
+	g.Expect(*subnets[1].ParentZoneName).To(Equal("us-east-1a"))
+	g.Expect(subnets[2].IsEdgeWavelength()).To(BeTrue())
+
+	g.Expect(subnets.FilterPrivate().IDs()).To(Equal([]string{"subnet-az"}))
+	g.Expect(subnets.FilterPublic()).To(BeEmpty())
+	g.Expect(subnets.FilterEdge().IDs()).To(Equal([]string{"subnet-lz", "subnet-wlz"}))
+}
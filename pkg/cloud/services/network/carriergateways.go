@@ -0,0 +1,164 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/pkg/errors"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/awserrors"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/converters"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/filter"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/wait"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/tags"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/record"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+// reconcileCarrierGateways creates the carrier gateway of a managed VPC with subnets in Wavelength Zones.
+func (s *Service) reconcileCarrierGateways() error {
+	if s.scope.VPC().IsUnmanaged(s.scope.Name()) {
+		s.scope.Trace("Skipping carrier gateway reconcile in unmanaged mode")
+		return nil
+	}
+
+	hasWavelengthSubnets := false
+	for _, sn := range s.scope.Subnets().FilterEdge() {
+		if sn.IsEdgeWavelength() {
+			hasWavelengthSubnets = true
+			break
+		}
+	}
+	if !hasWavelengthSubnets {
+		s.scope.Trace("Skipping carrier gateway reconcile, no subnets in Wavelength Zones")
+		return nil
+	}
+
+	s.scope.Debug("Reconciling carrier gateway")
+
+	cagws, err := s.describeVpcCarrierGateways()
+	if awserrors.IsNotFound(err) {
+		cagw, err := s.createCarrierGateway()
+		if err != nil {
+			return err
+		}
+		cagws = []*ec2.CarrierGateway{cagw}
+	} else if err != nil {
+		return err
+	}
+
+	gateway := cagws[0]
+	s.scope.VPC().CarrierGatewayID = gateway.CarrierGatewayId
+
+	// Make sure tags are up-to-date.
+	if err := wait.WaitForWithRetryable(wait.NewBackoff(), func() (bool, error) {
+		buildParams := s.getCarrierGatewayTagParams(*gateway.CarrierGatewayId)
+		tagsBuilder := tags.New(&buildParams, tags.WithEC2(s.EC2Client))
+		if err := tagsBuilder.Ensure(converters.TagsToMap(gateway.Tags)); err != nil {
+			return false, err
+		}
+		return true, nil
+	}, awserrors.CarrierGatewayNotFound); err != nil {
+		record.Warnf(s.scope.InfraCluster(), "FailedTagCarrierGateway", "Failed to tag managed Carrier Gateway %q: %v", *gateway.CarrierGatewayId, err)
+		return errors.Wrapf(err, "failed to tag carrier gateway %q", *gateway.CarrierGatewayId)
+	}
+	conditions.MarkTrue(s.scope.InfraCluster(), infrav1.CarrierGatewayReadyCondition)
+	return nil
+}
+
+// deleteCarrierGateways deletes the carrier gateways of a managed VPC.
+func (s *Service) deleteCarrierGateways() error {
+	if s.scope.VPC().IsUnmanaged(s.scope.Name()) {
+		s.scope.Trace("Skipping carrier gateway deletion in unmanaged mode")
+		return nil
+	}
+
+	cagws, err := s.describeVpcCarrierGateways()
+	if awserrors.IsNotFound(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	for _, cagw := range cagws {
+		if _, err := s.EC2Client.DeleteCarrierGateway(&ec2.DeleteCarrierGatewayInput{
+			CarrierGatewayId: cagw.CarrierGatewayId,
+		}); err != nil {
+			record.Warnf(s.scope.InfraCluster(), "FailedDeleteCarrierGateway", "Failed to delete Carrier Gateway %q previously attached to VPC %q: %v", *cagw.CarrierGatewayId, s.scope.VPC().ID, err)
+			return errors.Wrapf(err, "failed to delete carrier gateway %q", *cagw.CarrierGatewayId)
+		}
+
+		record.Eventf(s.scope.InfraCluster(), "SuccessfulDeleteCarrierGateway", "Deleted Carrier Gateway %q previously attached to VPC %q", *cagw.CarrierGatewayId, s.scope.VPC().ID)
+		s.scope.Info("Deleted Carrier gateway in VPC", "carrier-gateway-id", *cagw.CarrierGatewayId, "vpc-id", s.scope.VPC().ID)
+	}
+
+	return nil
+}
+
+func (s *Service) createCarrierGateway() (*ec2.CarrierGateway, error) {
+	out, err := s.EC2Client.CreateCarrierGateway(&ec2.CreateCarrierGatewayInput{
+		VpcId: aws.String(s.scope.VPC().ID),
+		TagSpecifications: []*ec2.TagSpecification{
+			tags.BuildParamsToTagSpecification(ec2.ResourceTypeCarrierGateway, s.getCarrierGatewayTagParams(services.TemporaryResourceID)),
+		},
+	})
+	if err != nil {
+		record.Warnf(s.scope.InfraCluster(), "FailedCreateCarrierGateway", "Failed to create new managed Carrier Gateway: %v", err)
+		return nil, errors.Wrap(err, "failed to create carrier gateway")
+	}
+	record.Eventf(s.scope.InfraCluster(), "SuccessfulCreateCarrierGateway", "Created new managed Carrier Gateway %q", *out.CarrierGateway.CarrierGatewayId)
+	s.scope.Info("Created Carrier gateway for VPC", "carrier-gateway-id", *out.CarrierGateway.CarrierGatewayId, "vpc-id", s.scope.VPC().ID)
+
+	return out.CarrierGateway, nil
+}
+
+func (s *Service) describeVpcCarrierGateways() ([]*ec2.CarrierGateway, error) {
+	out, err := s.EC2Client.DescribeCarrierGateways(&ec2.DescribeCarrierGatewaysInput{
+		Filters: []*ec2.Filter{
+			filter.EC2.VPC(s.scope.VPC().ID),
+			filter.EC2.CarrierGatewayStates(ec2.CarrierGatewayStatePending, ec2.CarrierGatewayStateAvailable),
+		},
+	})
+	if err != nil {
+		record.Eventf(s.scope.InfraCluster(), "FailedDescribeCarrierGateway", "Failed to describe carrier gateways in vpc %q: %v", s.scope.VPC().ID, err)
+		return nil, errors.Wrapf(err, "failed to describe carrier gateways in vpc %q", s.scope.VPC().ID)
+	}
+
+	if len(out.CarrierGateways) == 0 {
+		return nil, awserrors.NewNotFound(fmt.Sprintf("no carrier gateways found in vpc %q", s.scope.VPC().ID))
+	}
+
+	return out.CarrierGateways, nil
+}
+
+func (s *Service) getCarrierGatewayTagParams(id string) infrav1.BuildParams {
+	name := fmt.Sprintf("%s-cagw", s.scope.Name())
+
+	return infrav1.BuildParams{
+		ClusterName: s.scope.Name(),
+		ResourceID:  id,
+		Lifecycle:   infrav1.ResourceLifecycleOwned,
+		Name:        aws.String(name),
+		Role:        aws.String(infrav1.CommonRoleTagValue),
+		Additional:  s.scope.AdditionalTags(),
+	}
+}
@@ -0,0 +1,178 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/test/mocks"
+)
+
+func TestReconcileCarrierGateways(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	wavelengthZone := infrav1.ZoneTypeWavelengthZone
+	localZone := infrav1.ZoneTypeLocalZone
+
+	testCases := []struct {
+		name      string
+		subnets   infrav1.Subnets
+		expect    func(m *mocks.MockEC2APIMockRecorder)
+		wantID    *string
+		wantError bool
+	}{
+		{
+			name: "no wavelength subnets, do nothing",
+			subnets: infrav1.Subnets{
+				{
+					ID:               "subnet-local-zone",
+					AvailabilityZone: "us-east-1-bos-1a",
+					ZoneType:         &localZone,
+				},
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {},
+		},
+		{
+			name: "wavelength subnet, carrier gateway is created",
+			subnets: infrav1.Subnets{
+				{
+					ID:               "subnet-wavelength",
+					AvailabilityZone: "us-east-1-wl1-bos-wlz-1",
+					IsPublic:         true,
+					ZoneType:         &wavelengthZone,
+				},
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeCarrierGateways(gomock.AssignableToTypeOf(&ec2.DescribeCarrierGatewaysInput{})).
+					Return(&ec2.DescribeCarrierGatewaysOutput{}, nil)
+				m.CreateCarrierGateway(gomock.AssignableToTypeOf(&ec2.CreateCarrierGatewayInput{})).
+					DoAndReturn(func(input *ec2.CreateCarrierGatewayInput) (*ec2.CreateCarrierGatewayOutput, error) {
+						if aws.StringValue(input.VpcId) != "vpc-cagw" {
+							t.Fatalf("expected carrier gateway in vpc-cagw, got %q", aws.StringValue(input.VpcId))
+						}
+						return &ec2.CreateCarrierGatewayOutput{
+							CarrierGateway: &ec2.CarrierGateway{
+								CarrierGatewayId: aws.String("cagw-new"),
+								VpcId:            input.VpcId,
+								Tags:             input.TagSpecifications[0].Tags,
+							},
+						}, nil
+					})
+				m.CreateTags(gomock.AssignableToTypeOf(&ec2.CreateTagsInput{})).Return(&ec2.CreateTagsOutput{}, nil).AnyTimes()
+			},
+			wantID: aws.String("cagw-new"),
+		},
+		{
+			name: "wavelength subnet, existing carrier gateway is reused",
+			subnets: infrav1.Subnets{
+				{
+					ID:               "subnet-wavelength",
+					AvailabilityZone: "us-east-1-wl1-bos-wlz-1",
+					ZoneType:         &wavelengthZone,
+				},
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeCarrierGateways(gomock.AssignableToTypeOf(&ec2.DescribeCarrierGatewaysInput{})).
+					Return(&ec2.DescribeCarrierGatewaysOutput{
+						CarrierGateways: []*ec2.CarrierGateway{
+							{
+								CarrierGatewayId: aws.String("cagw-existing"),
+								VpcId:            aws.String("vpc-cagw"),
+							},
+						},
+					}, nil)
+				m.CreateTags(gomock.AssignableToTypeOf(&ec2.CreateTagsInput{})).Return(&ec2.CreateTagsOutput{}, nil)
+			},
+			wantID: aws.String("cagw-existing"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			ec2Mock := mocks.NewMockEC2API(mockCtrl)
+
+			scope, err := getVPCEndpointsClusterScope(&infrav1.NetworkSpec{
+				VPC: infrav1.VPCSpec{
+					ID: "vpc-cagw",
+					Tags: infrav1.Tags{
+						infrav1.ClusterTagKey("test-cluster"): "owned",
+					},
+				},
+				Subnets: tc.subnets,
+			})
+			g.Expect(err).NotTo(HaveOccurred())
+
+			tc.expect(ec2Mock.EXPECT())
+
+			s := NewService(scope)
+			s.EC2Client = ec2Mock
+
+			err = s.reconcileCarrierGateways()
+			if tc.wantError {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(s.scope.VPC().CarrierGatewayID).To(Equal(tc.wantID))
+		})
+	}
+}
+
+func TestDeleteCarrierGateways(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	g := NewWithT(t)
+	ec2Mock := mocks.NewMockEC2API(mockCtrl)
+
+	scope, err := getVPCEndpointsClusterScope(&infrav1.NetworkSpec{
+		VPC: infrav1.VPCSpec{
+			ID:               "vpc-cagw",
+			CarrierGatewayID: aws.String("cagw-1"),
+			Tags: infrav1.Tags{
+				infrav1.ClusterTagKey("test-cluster"): "owned",
+			},
+		},
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	ec2Mock.EXPECT().DescribeCarrierGateways(gomock.AssignableToTypeOf(&ec2.DescribeCarrierGatewaysInput{})).
+		Return(&ec2.DescribeCarrierGatewaysOutput{
+			CarrierGateways: []*ec2.CarrierGateway{
+				{
+					CarrierGatewayId: aws.String("cagw-1"),
+					VpcId:            aws.String("vpc-cagw"),
+				},
+			},
+		}, nil)
+	ec2Mock.EXPECT().DeleteCarrierGateway(gomock.Eq(&ec2.DeleteCarrierGatewayInput{
+		CarrierGatewayId: aws.String("cagw-1"),
+	})).Return(&ec2.DeleteCarrierGatewayOutput{}, nil)
+
+	s := NewService(scope)
+	s.EC2Client = ec2Mock
+
+	g.Expect(s.deleteCarrierGateways()).To(Succeed())
+}
@@ -0,0 +1,213 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/pkg/errors"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/filter"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/tags"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/record"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+// reconcileFlowLogs creates the flow logs configured for a managed VPC and deletes the ones
+// that are no longer configured. As flow logs cannot be modified, changed flow logs are recreated.
+func (s *Service) reconcileFlowLogs() error {
+	if s.scope.VPC().IsUnmanaged(s.scope.Name()) {
+		s.scope.Trace("Skipping VPC flow logs reconcile in unmanaged mode")
+		return nil
+	}
+
+	specFlowLogs := s.scope.VPC().FlowLogs
+	if len(specFlowLogs) == 0 && len(s.scope.Network().FlowLogIDs) == 0 {
+		s.scope.Trace("Skipping VPC flow logs reconcile, no flow logs configured")
+		return nil
+	}
+
+	s.scope.Debug("Reconciling VPC flow logs")
+
+	existing, err := s.describeVpcFlowLogs()
+	if err != nil {
+		return err
+	}
+
+	var (
+		ids      []string
+		toDelete []*ec2.FlowLog
+		matched  = make([]bool, len(specFlowLogs))
+	)
+	for _, fl := range existing {
+		found := false
+		for i := range specFlowLogs {
+			if !matched[i] && flowLogMatches(&specFlowLogs[i], fl) {
+				matched[i] = true
+				found = true
+				break
+			}
+		}
+		if found {
+			ids = append(ids, aws.StringValue(fl.FlowLogId))
+			continue
+		}
+		toDelete = append(toDelete, fl)
+	}
+
+	if err := s.deleteFlowLogs(toDelete); err != nil {
+		return err
+	}
+
+	for i := range specFlowLogs {
+		if matched[i] {
+			continue
+		}
+		id, err := s.createFlowLog(&specFlowLogs[i])
+		if err != nil {
+			return err
+		}
+		ids = append(ids, id)
+	}
+
+	s.scope.Network().FlowLogIDs = ids
+
+	if len(specFlowLogs) > 0 {
+		conditions.MarkTrue(s.scope.InfraCluster(), infrav1.VpcFlowLogsReadyCondition)
+	}
+	return nil
+}
+
+// deleteVPCFlowLogs deletes all the flow logs of a managed VPC.
+func (s *Service) deleteVPCFlowLogs() error {
+	if s.scope.VPC().IsUnmanaged(s.scope.Name()) {
+		s.scope.Trace("Skipping VPC flow logs deletion in unmanaged mode")
+		return nil
+	}
+
+	existing, err := s.describeVpcFlowLogs()
+	if err != nil {
+		return err
+	}
+
+	if err := s.deleteFlowLogs(existing); err != nil {
+		return err
+	}
+
+	s.scope.Network().FlowLogIDs = nil
+	return nil
+}
+
+func (s *Service) createFlowLog(fl *infrav1.VPCFlowLog) (string, error) {
+	input := &ec2.CreateFlowLogsInput{
+		ResourceIds:        aws.StringSlice([]string{s.scope.VPC().ID}),
+		ResourceType:       aws.String(ec2.FlowLogsResourceTypeVpc),
+		TrafficType:        aws.String(string(fl.GetTrafficType())),
+		LogDestinationType: aws.String(string(fl.DestinationType)),
+		LogDestination:     aws.String(fl.DestinationARN),
+		TagSpecifications: []*ec2.TagSpecification{
+			tags.BuildParamsToTagSpecification(ec2.ResourceTypeVpcFlowLog, s.getFlowLogTagParams(services.TemporaryResourceID, fl.DestinationType)),
+		},
+	}
+	if fl.IAMRoleARN != "" {
+		input.DeliverLogsPermissionArn = aws.String(fl.IAMRoleARN)
+	}
+
+	out, err := s.EC2Client.CreateFlowLogs(input)
+	if err == nil && len(out.Unsuccessful) > 0 && out.Unsuccessful[0].Error != nil {
+		err = errors.Errorf("%s: %s", aws.StringValue(out.Unsuccessful[0].Error.Code), aws.StringValue(out.Unsuccessful[0].Error.Message))
+	}
+	if err == nil && len(out.FlowLogIds) == 0 {
+		err = errors.New("no flow log created")
+	}
+	if err != nil {
+		record.Warnf(s.scope.InfraCluster(), "FailedCreateFlowLog", "Failed to create managed VPC Flow Log to %q: %v", fl.DestinationARN, err)
+		return "", errors.Wrapf(err, "failed to create flow log to %q for vpc %q", fl.DestinationARN, s.scope.VPC().ID)
+	}
+
+	id := aws.StringValue(out.FlowLogIds[0])
+	record.Eventf(s.scope.InfraCluster(), "SuccessfulCreateFlowLog", "Created managed VPC Flow Log %q to %q", id, fl.DestinationARN)
+	s.scope.Info("Created VPC flow log", "flow-log-id", id, "destination", fl.DestinationARN, "vpc-id", s.scope.VPC().ID)
+
+	return id, nil
+}
+
+func (s *Service) deleteFlowLogs(flowLogs []*ec2.FlowLog) error {
+	if len(flowLogs) == 0 {
+		return nil
+	}
+
+	ids := make([]*string, 0, len(flowLogs))
+	for _, fl := range flowLogs {
+		ids = append(ids, fl.FlowLogId)
+	}
+
+	out, err := s.EC2Client.DeleteFlowLogs(&ec2.DeleteFlowLogsInput{
+		FlowLogIds: ids,
+	})
+	if err == nil && len(out.Unsuccessful) > 0 && out.Unsuccessful[0].Error != nil {
+		err = errors.Errorf("%s: %s", aws.StringValue(out.Unsuccessful[0].Error.Code), aws.StringValue(out.Unsuccessful[0].Error.Message))
+	}
+	if err != nil {
+		record.Warnf(s.scope.InfraCluster(), "FailedDeleteFlowLogs", "Failed to delete managed VPC Flow Logs %v: %v", aws.StringValueSlice(ids), err)
+		return errors.Wrapf(err, "failed to delete flow logs %v", aws.StringValueSlice(ids))
+	}
+
+	record.Eventf(s.scope.InfraCluster(), "SuccessfulDeleteFlowLogs", "Deleted managed VPC Flow Logs %v", aws.StringValueSlice(ids))
+	s.scope.Info("Deleted VPC flow logs", "flow-log-ids", aws.StringValueSlice(ids), "vpc-id", s.scope.VPC().ID)
+	return nil
+}
+
+func (s *Service) describeVpcFlowLogs() ([]*ec2.FlowLog, error) {
+	out, err := s.EC2Client.DescribeFlowLogs(&ec2.DescribeFlowLogsInput{
+		Filter: []*ec2.Filter{
+			filter.EC2.ResourceID(s.scope.VPC().ID),
+			filter.EC2.ClusterOwned(s.scope.Name()),
+		},
+	})
+	if err != nil {
+		record.Eventf(s.scope.InfraCluster(), "FailedDescribeFlowLogs", "Failed to describe flow logs of vpc %q: %v", s.scope.VPC().ID, err)
+		return nil, errors.Wrapf(err, "failed to describe flow logs of vpc %q", s.scope.VPC().ID)
+	}
+
+	return out.FlowLogs, nil
+}
+
+func (s *Service) getFlowLogTagParams(id string, destinationType infrav1.FlowLogDestinationType) infrav1.BuildParams {
+	name := fmt.Sprintf("%s-flowlog-%s", s.scope.Name(), destinationType)
+
+	return infrav1.BuildParams{
+		ClusterName: s.scope.Name(),
+		ResourceID:  id,
+		Lifecycle:   infrav1.ResourceLifecycleOwned,
+		Name:        aws.String(name),
+		Role:        aws.String(infrav1.CommonRoleTagValue),
+		Additional:  s.scope.AdditionalTags(),
+	}
+}
+
+// flowLogMatches returns true if the flow log publishes the traffic described by the spec to its destination.
+func flowLogMatches(spec *infrav1.VPCFlowLog, fl *ec2.FlowLog) bool {
+	return aws.StringValue(fl.LogDestinationType) == string(spec.DestinationType) &&
+		aws.StringValue(fl.LogDestination) == spec.DestinationARN &&
+		aws.StringValue(fl.TrafficType) == string(spec.GetTrafficType()) &&
+		aws.StringValue(fl.DeliverLogsPermissionArn) == spec.IAMRoleARN
+}
@@ -0,0 +1,204 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/test/mocks"
+)
+
+func TestReconcileFlowLogs(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	cloudWatchFlowLog := infrav1.VPCFlowLog{
+		DestinationType: infrav1.FlowLogDestinationTypeCloudWatchLogs,
+		DestinationARN:  "arn:aws:logs:us-east-1:123456789012:log-group:flow-logs",
+		IAMRoleARN:      "arn:aws:iam::123456789012:role/flow-logs",
+	}
+	s3FlowLog := infrav1.VPCFlowLog{
+		DestinationType: infrav1.FlowLogDestinationTypeS3,
+		DestinationARN:  "arn:aws:s3:::flow-logs-bucket",
+		TrafficType:     infrav1.FlowLogTrafficTypeReject,
+	}
+
+	testCases := []struct {
+		name       string
+		flowLogs   []infrav1.VPCFlowLog
+		flowLogIDs []string
+		expect     func(m *mocks.MockEC2APIMockRecorder)
+		wantIDs    []string
+	}{
+		{
+			name:   "no flow logs configured, do nothing",
+			expect: func(m *mocks.MockEC2APIMockRecorder) {},
+		},
+		{
+			name:     "missing flow logs are created",
+			flowLogs: []infrav1.VPCFlowLog{cloudWatchFlowLog, s3FlowLog},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeFlowLogs(gomock.AssignableToTypeOf(&ec2.DescribeFlowLogsInput{})).
+					Return(&ec2.DescribeFlowLogsOutput{}, nil)
+				m.CreateFlowLogs(gomock.AssignableToTypeOf(&ec2.CreateFlowLogsInput{})).
+					DoAndReturn(func(input *ec2.CreateFlowLogsInput) (*ec2.CreateFlowLogsOutput, error) {
+						g := NewWithT(t)
+						g.Expect(aws.StringValueSlice(input.ResourceIds)).To(Equal([]string{"vpc-flowlogs"}))
+						g.Expect(aws.StringValue(input.ResourceType)).To(Equal("VPC"))
+						g.Expect(aws.StringValue(input.LogDestinationType)).To(Equal("cloud-watch-logs"))
+						g.Expect(aws.StringValue(input.LogDestination)).To(Equal(cloudWatchFlowLog.DestinationARN))
+						g.Expect(aws.StringValue(input.TrafficType)).To(Equal("ALL"))
+						g.Expect(aws.StringValue(input.DeliverLogsPermissionArn)).To(Equal(cloudWatchFlowLog.IAMRoleARN))
+						return &ec2.CreateFlowLogsOutput{FlowLogIds: aws.StringSlice([]string{"fl-cloudwatch"})}, nil
+					})
+				m.CreateFlowLogs(gomock.AssignableToTypeOf(&ec2.CreateFlowLogsInput{})).
+					DoAndReturn(func(input *ec2.CreateFlowLogsInput) (*ec2.CreateFlowLogsOutput, error) {
+						g := NewWithT(t)
+						g.Expect(aws.StringValue(input.LogDestinationType)).To(Equal("s3"))
+						g.Expect(aws.StringValue(input.TrafficType)).To(Equal("REJECT"))
+						g.Expect(input.DeliverLogsPermissionArn).To(BeNil())
+						return &ec2.CreateFlowLogsOutput{FlowLogIds: aws.StringSlice([]string{"fl-s3"})}, nil
+					})
+			},
+			wantIDs: []string{"fl-cloudwatch", "fl-s3"},
+		},
+		{
+			name:       "matching flow logs are kept and changed flow logs are recreated",
+			flowLogs:   []infrav1.VPCFlowLog{cloudWatchFlowLog, s3FlowLog},
+			flowLogIDs: []string{"fl-cloudwatch", "fl-s3-all"},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeFlowLogs(gomock.AssignableToTypeOf(&ec2.DescribeFlowLogsInput{})).
+					Return(&ec2.DescribeFlowLogsOutput{FlowLogs: []*ec2.FlowLog{
+						{
+							FlowLogId:                aws.String("fl-cloudwatch"),
+							LogDestinationType:       aws.String("cloud-watch-logs"),
+							LogDestination:           aws.String(cloudWatchFlowLog.DestinationARN),
+							TrafficType:              aws.String("ALL"),
+							DeliverLogsPermissionArn: aws.String(cloudWatchFlowLog.IAMRoleARN),
+						},
+						{
+							FlowLogId:          aws.String("fl-s3-all"),
+							LogDestinationType: aws.String("s3"),
+							LogDestination:     aws.String(s3FlowLog.DestinationARN),
+							TrafficType:        aws.String("ALL"),
+						},
+					}}, nil)
+				m.DeleteFlowLogs(gomock.Eq(&ec2.DeleteFlowLogsInput{
+					FlowLogIds: aws.StringSlice([]string{"fl-s3-all"}),
+				})).Return(&ec2.DeleteFlowLogsOutput{}, nil)
+				m.CreateFlowLogs(gomock.AssignableToTypeOf(&ec2.CreateFlowLogsInput{})).
+					Return(&ec2.CreateFlowLogsOutput{FlowLogIds: aws.StringSlice([]string{"fl-s3-reject"})}, nil)
+			},
+			wantIDs: []string{"fl-cloudwatch", "fl-s3-reject"},
+		},
+		{
+			name:       "flow logs that are no longer configured are deleted",
+			flowLogIDs: []string{"fl-cloudwatch"},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeFlowLogs(gomock.AssignableToTypeOf(&ec2.DescribeFlowLogsInput{})).
+					Return(&ec2.DescribeFlowLogsOutput{FlowLogs: []*ec2.FlowLog{
+						{
+							FlowLogId:          aws.String("fl-cloudwatch"),
+							LogDestinationType: aws.String("cloud-watch-logs"),
+						},
+					}}, nil)
+				m.DeleteFlowLogs(gomock.Eq(&ec2.DeleteFlowLogsInput{
+					FlowLogIds: aws.StringSlice([]string{"fl-cloudwatch"}),
+				})).Return(&ec2.DeleteFlowLogsOutput{}, nil)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			ec2Mock := mocks.NewMockEC2API(mockCtrl)
+
+			scope, err := getVPCEndpointsClusterScope(&infrav1.NetworkSpec{
+				VPC: infrav1.VPCSpec{
+					ID: "vpc-flowlogs",
+					Tags: infrav1.Tags{
+						infrav1.ClusterTagKey("test-cluster"): "owned",
+					},
+					FlowLogs: tc.flowLogs,
+				},
+			})
+			g.Expect(err).NotTo(HaveOccurred())
+			scope.Network().FlowLogIDs = tc.flowLogIDs
+
+			tc.expect(ec2Mock.EXPECT())
+
+			s := NewService(scope)
+			s.EC2Client = ec2Mock
+
+			g.Expect(s.reconcileFlowLogs()).To(Succeed())
+			g.Expect(scope.Network().FlowLogIDs).To(Equal(tc.wantIDs))
+		})
+	}
+}
+
+func TestReconcileFlowLogsUnsuccessful(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	g := NewWithT(t)
+	ec2Mock := mocks.NewMockEC2API(mockCtrl)
+
+	scope, err := getVPCEndpointsClusterScope(&infrav1.NetworkSpec{
+		VPC: infrav1.VPCSpec{
+			ID: "vpc-flowlogs",
+			Tags: infrav1.Tags{
+				infrav1.ClusterTagKey("test-cluster"): "owned",
+			},
+			FlowLogs: []infrav1.VPCFlowLog{
+				{
+					DestinationType: infrav1.FlowLogDestinationTypeS3,
+					DestinationARN:  "arn:aws:s3:::missing-bucket",
+				},
+			},
+		},
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	ec2Mock.EXPECT().DescribeFlowLogs(gomock.AssignableToTypeOf(&ec2.DescribeFlowLogsInput{})).
+		Return(&ec2.DescribeFlowLogsOutput{}, nil)
+	ec2Mock.EXPECT().CreateFlowLogs(gomock.AssignableToTypeOf(&ec2.CreateFlowLogsInput{})).
+		Return(&ec2.CreateFlowLogsOutput{
+			Unsuccessful: []*ec2.UnsuccessfulItem{
+				{
+					ResourceId: aws.String("vpc-flowlogs"),
+					Error: &ec2.UnsuccessfulItemError{
+						Code:    aws.String("400"),
+						Message: aws.String("LogDestination: missing-bucket does not exist"),
+					},
+				},
+			},
+		}, nil)
+
+	s := NewService(scope)
+	s.EC2Client = ec2Mock
+
+	err = s.reconcileFlowLogs()
+	g.Expect(err).To(HaveOccurred())
+	g.Expect(err.Error()).To(ContainSubstring("missing-bucket does not exist"))
+}
@@ -29,6 +29,7 @@ import (
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/converters"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/filter"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/elasticip"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/wait"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/tags"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/record"
@@ -210,7 +211,7 @@ func (s *Service) getNatGatewayTagParams(id string) infrav1.BuildParams {
 }
 
 func (s *Service) createNatGateways(subnetIDs []string) (natgateways []*ec2.NatGateway, err error) {
-	eips, err := s.getOrAllocateAddresses(len(subnetIDs), infrav1.APIServerRoleTagValue)
+	eips, err := elasticip.NewService(s.scope, s.EC2Client).GetOrAllocateAddresses(len(subnetIDs), infrav1.APIServerRoleTagValue)
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to create one or more IP addresses for NAT gateways")
 	}
@@ -324,9 +325,15 @@ func (s *Service) getNatGatewayForSubnet(sn *infrav1.SubnetSpec) (string, error)
 		azGateways[psn.AvailabilityZone] = append(azGateways[psn.AvailabilityZone], *psn.NatGatewayID)
 	}
 
-	if gws, ok := azGateways[sn.AvailabilityZone]; ok && len(gws) > 0 {
+	// Private subnets in Local Zones are routed through the NAT gateway of their parent zone.
+	zone := sn.AvailabilityZone
+	if sn.IsEdge() && sn.ParentZoneName != nil {
+		zone = *sn.ParentZoneName
+	}
+
+	if gws, ok := azGateways[zone]; ok && len(gws) > 0 {
 		return gws[0], nil
 	}
 
-	return "", errors.Errorf("no nat gateways available in %q for private subnet %q, current state: %+v", sn.AvailabilityZone, sn.ID, azGateways)
+	return "", errors.Errorf("no nat gateways available in %q for private subnet %q, current state: %+v", zone, sn.ID, azGateways)
 }
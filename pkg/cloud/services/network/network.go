@@ -21,6 +21,7 @@ import (
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/awserrors"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/elasticip"
 	infrautilconditions "sigs.k8s.io/cluster-api-provider-aws/v2/util/conditions"
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
 	"sigs.k8s.io/cluster-api/util/conditions"
@@ -37,6 +38,12 @@ func (s *Service) ReconcileNetwork() (err error) {
 	}
 	conditions.MarkTrue(s.scope.InfraCluster(), infrav1.VpcReadyCondition)
 
+	// VPC Flow Logs.
+	if err := s.reconcileFlowLogs(); err != nil {
+		conditions.MarkFalse(s.scope.InfraCluster(), infrav1.VpcFlowLogsReadyCondition, infrav1.VpcFlowLogsReconciliationFailedReason, infrautilconditions.ErrorConditionAfterInit(s.scope.ClusterObj()), err.Error())
+		return err
+	}
+
 	// Secondary CIDR
 	if err := s.associateSecondaryCidr(); err != nil {
 		conditions.MarkFalse(s.scope.InfraCluster(), infrav1.SecondaryCidrsReadyCondition, infrav1.SecondaryCidrReconciliationFailedReason, infrautilconditions.ErrorConditionAfterInit(s.scope.ClusterObj()), err.Error())
@@ -49,12 +56,24 @@ func (s *Service) ReconcileNetwork() (err error) {
 		return err
 	}
 
+	// Network ACLs.
+	if err := s.reconcileNetworkACLs(); err != nil {
+		conditions.MarkFalse(s.scope.InfraCluster(), infrav1.NetworkACLsReadyCondition, infrav1.NetworkACLsReconciliationFailedReason, infrautilconditions.ErrorConditionAfterInit(s.scope.ClusterObj()), err.Error())
+		return err
+	}
+
 	// Internet Gateways.
 	if err := s.reconcileInternetGateways(); err != nil {
 		conditions.MarkFalse(s.scope.InfraCluster(), infrav1.InternetGatewayReadyCondition, infrav1.InternetGatewayFailedReason, infrautilconditions.ErrorConditionAfterInit(s.scope.ClusterObj()), err.Error())
 		return err
 	}
 
+	// Carrier Gateway.
+	if err := s.reconcileCarrierGateways(); err != nil {
+		conditions.MarkFalse(s.scope.InfraCluster(), infrav1.CarrierGatewayReadyCondition, infrav1.CarrierGatewayFailedReason, infrautilconditions.ErrorConditionAfterInit(s.scope.ClusterObj()), err.Error())
+		return err
+	}
+
 	// Egress Only Internet Gateways.
 	if err := s.reconcileEgressOnlyInternetGateways(); err != nil {
 		conditions.MarkFalse(s.scope.InfraCluster(), infrav1.EgressOnlyInternetGatewayReadyCondition, infrav1.EgressOnlyInternetGatewayFailedReason, infrautilconditions.ErrorConditionAfterInit(s.scope.ClusterObj()), err.Error())
@@ -67,12 +86,24 @@ func (s *Service) ReconcileNetwork() (err error) {
 		return err
 	}
 
+	// Transit Gateway attachment.
+	if err := s.reconcileTransitGatewayAttachment(); err != nil {
+		conditions.MarkFalse(s.scope.InfraCluster(), infrav1.TransitGatewayAttachmentReadyCondition, infrav1.TransitGatewayAttachmentFailedReason, infrautilconditions.ErrorConditionAfterInit(s.scope.ClusterObj()), err.Error())
+		return err
+	}
+
 	// Routing tables.
 	if err := s.reconcileRouteTables(); err != nil {
 		conditions.MarkFalse(s.scope.InfraCluster(), infrav1.RouteTablesReadyCondition, infrav1.RouteTableReconciliationFailedReason, infrautilconditions.ErrorConditionAfterInit(s.scope.ClusterObj()), err.Error())
 		return err
 	}
 
+	// VPC Endpoints.
+	if err := s.reconcileVPCEndpoints(); err != nil {
+		conditions.MarkFalse(s.scope.InfraCluster(), infrav1.VpcEndpointsReadyCondition, infrav1.VpcEndpointsReconciliationFailedReason, infrautilconditions.ErrorConditionAfterInit(s.scope.ClusterObj()), err.Error())
+		return err
+	}
+
 	s.scope.Debug("Reconcile network completed successfully")
 	return nil
 }
@@ -97,8 +128,26 @@ func (s *Service) DeleteNetwork() (err error) {
 		s.scope.Error(err, "non-fatal: VPC ID is missing, ")
 	}
 
+	// VPC endpoints, flow logs and the carrier gateway are not part of the described VPC, keep them so that they can be cleaned up.
+	vpc.Endpoints = s.scope.VPC().Endpoints
+	vpc.FlowLogs = s.scope.VPC().FlowLogs
+	vpc.CarrierGatewayID = s.scope.VPC().CarrierGatewayID
+	// The IPAM pool is immutable, the cluster could not be patched anymore without it.
+	vpc.IPAMPool = s.scope.VPC().IPAMPool
 	vpc.DeepCopyInto(s.scope.VPC())
 
+	// VPC Endpoints.
+	conditions.MarkFalse(s.scope.InfraCluster(), infrav1.VpcEndpointsReadyCondition, clusterv1.DeletingReason, clusterv1.ConditionSeverityInfo, "")
+	if err := s.scope.PatchObject(); err != nil {
+		return err
+	}
+
+	if err := s.deleteVPCEndpoints(); err != nil {
+		conditions.MarkFalse(s.scope.InfraCluster(), infrav1.VpcEndpointsReadyCondition, "DeletingFailed", clusterv1.ConditionSeverityWarning, err.Error())
+		return err
+	}
+	conditions.MarkFalse(s.scope.InfraCluster(), infrav1.VpcEndpointsReadyCondition, clusterv1.DeletedReason, clusterv1.ConditionSeverityInfo, "")
+
 	// Routing tables.
 	conditions.MarkFalse(s.scope.InfraCluster(), infrav1.RouteTablesReadyCondition, clusterv1.DeletingReason, clusterv1.ConditionSeverityInfo, "")
 	if err := s.scope.PatchObject(); err != nil {
@@ -111,6 +160,18 @@ func (s *Service) DeleteNetwork() (err error) {
 	}
 	conditions.MarkFalse(s.scope.InfraCluster(), infrav1.RouteTablesReadyCondition, clusterv1.DeletedReason, clusterv1.ConditionSeverityInfo, "")
 
+	// Transit Gateway attachment.
+	conditions.MarkFalse(s.scope.InfraCluster(), infrav1.TransitGatewayAttachmentReadyCondition, clusterv1.DeletingReason, clusterv1.ConditionSeverityInfo, "")
+	if err := s.scope.PatchObject(); err != nil {
+		return err
+	}
+
+	if err := s.deleteTransitGatewayAttachments(); err != nil {
+		conditions.MarkFalse(s.scope.InfraCluster(), infrav1.TransitGatewayAttachmentReadyCondition, "DeletingFailed", clusterv1.ConditionSeverityWarning, err.Error())
+		return err
+	}
+	conditions.MarkFalse(s.scope.InfraCluster(), infrav1.TransitGatewayAttachmentReadyCondition, clusterv1.DeletedReason, clusterv1.ConditionSeverityInfo, "")
+
 	// NAT Gateways.
 	conditions.MarkFalse(s.scope.InfraCluster(), infrav1.NatGatewaysReadyCondition, clusterv1.DeletingReason, clusterv1.ConditionSeverityInfo, "")
 	if err := s.scope.PatchObject(); err != nil {
@@ -124,7 +185,7 @@ func (s *Service) DeleteNetwork() (err error) {
 	conditions.MarkFalse(s.scope.InfraCluster(), infrav1.NatGatewaysReadyCondition, clusterv1.DeletedReason, clusterv1.ConditionSeverityInfo, "")
 
 	// EIPs.
-	if err := s.releaseAddresses(); err != nil {
+	if err := elasticip.NewService(s.scope, s.EC2Client).ReleaseAddresses(); err != nil {
 		return err
 	}
 
@@ -140,6 +201,20 @@ func (s *Service) DeleteNetwork() (err error) {
 	}
 	conditions.MarkFalse(s.scope.InfraCluster(), infrav1.InternetGatewayReadyCondition, clusterv1.DeletedReason, clusterv1.ConditionSeverityInfo, "")
 
+	// Carrier Gateway.
+	if s.scope.VPC().CarrierGatewayID != nil {
+		conditions.MarkFalse(s.scope.InfraCluster(), infrav1.CarrierGatewayReadyCondition, clusterv1.DeletingReason, clusterv1.ConditionSeverityInfo, "")
+		if err := s.scope.PatchObject(); err != nil {
+			return err
+		}
+
+		if err := s.deleteCarrierGateways(); err != nil {
+			conditions.MarkFalse(s.scope.InfraCluster(), infrav1.CarrierGatewayReadyCondition, "DeletingFailed", clusterv1.ConditionSeverityWarning, err.Error())
+			return err
+		}
+		conditions.MarkFalse(s.scope.InfraCluster(), infrav1.CarrierGatewayReadyCondition, clusterv1.DeletedReason, clusterv1.ConditionSeverityInfo, "")
+	}
+
 	// Egress Only Internet Gateways.
 	conditions.MarkFalse(s.scope.InfraCluster(), infrav1.EgressOnlyInternetGatewayReadyCondition, clusterv1.DeletingReason, clusterv1.ConditionSeverityInfo, "")
 	if err := s.scope.PatchObject(); err != nil {
@@ -164,6 +239,20 @@ func (s *Service) DeleteNetwork() (err error) {
 	}
 	conditions.MarkFalse(s.scope.InfraCluster(), infrav1.SubnetsReadyCondition, clusterv1.DeletedReason, clusterv1.ConditionSeverityInfo, "")
 
+	// Network ACLs, once the subnets they are associated with are deleted.
+	if s.scope.NetworkACLs() != nil {
+		conditions.MarkFalse(s.scope.InfraCluster(), infrav1.NetworkACLsReadyCondition, clusterv1.DeletingReason, clusterv1.ConditionSeverityInfo, "")
+		if err := s.scope.PatchObject(); err != nil {
+			return err
+		}
+
+		if err := s.deleteNetworkACLs(); err != nil {
+			conditions.MarkFalse(s.scope.InfraCluster(), infrav1.NetworkACLsReadyCondition, "DeletingFailed", clusterv1.ConditionSeverityWarning, err.Error())
+			return err
+		}
+		conditions.MarkFalse(s.scope.InfraCluster(), infrav1.NetworkACLsReadyCondition, clusterv1.DeletedReason, clusterv1.ConditionSeverityInfo, "")
+	}
+
 	// Secondary CIDR.
 	conditions.MarkFalse(s.scope.InfraCluster(), infrav1.SecondaryCidrsReadyCondition, clusterv1.DeletingReason, clusterv1.ConditionSeverityInfo, "")
 	if err := s.disassociateSecondaryCidr(); err != nil {
@@ -171,6 +260,20 @@ func (s *Service) DeleteNetwork() (err error) {
 		return err
 	}
 
+	// VPC Flow Logs.
+	if len(s.scope.VPC().FlowLogs) > 0 || len(s.scope.Network().FlowLogIDs) > 0 {
+		conditions.MarkFalse(s.scope.InfraCluster(), infrav1.VpcFlowLogsReadyCondition, clusterv1.DeletingReason, clusterv1.ConditionSeverityInfo, "")
+		if err := s.scope.PatchObject(); err != nil {
+			return err
+		}
+
+		if err := s.deleteVPCFlowLogs(); err != nil {
+			conditions.MarkFalse(s.scope.InfraCluster(), infrav1.VpcFlowLogsReadyCondition, "DeletingFailed", clusterv1.ConditionSeverityWarning, err.Error())
+			return err
+		}
+		conditions.MarkFalse(s.scope.InfraCluster(), infrav1.VpcFlowLogsReadyCondition, clusterv1.DeletedReason, clusterv1.ConditionSeverityInfo, "")
+	}
+
 	// VPC.
 	conditions.MarkFalse(s.scope.InfraCluster(), infrav1.VpcReadyCondition, clusterv1.DeletingReason, clusterv1.ConditionSeverityInfo, "")
 	if err := s.scope.PatchObject(); err != nil {
@@ -0,0 +1,102 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/test/mocks"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+)
+
+func TestDeleteNetworkKeepsIPAMPool(t *testing.T) {
+	g := NewWithT(t)
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	ec2Mock := mocks.NewMockEC2API(mockCtrl)
+
+	awsCluster := &infrav1.AWSCluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: metav1.NamespaceDefault},
+		Spec: infrav1.AWSClusterSpec{
+			Region: "us-east-1",
+			NetworkSpec: infrav1.NetworkSpec{
+				VPC: infrav1.VPCSpec{
+					ID:        "vpc-ipam",
+					CidrBlock: "10.0.0.0/16",
+					IPAMPool: &infrav1.IPAMPool{
+						ID:            "ipam-pool-1",
+						NetmaskLength: 16,
+					},
+					Endpoints: []infrav1.VPCEndpointSpec{
+						{ServiceName: "com.amazonaws.us-east-1.s3"},
+					},
+				},
+			},
+		},
+	}
+	original := awsCluster.DeepCopy()
+
+	scheme := runtime.NewScheme()
+	_ = infrav1.AddToScheme(scheme)
+	k8sClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(awsCluster).Build()
+	clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+		Client: k8sClient,
+		Cluster: &clusterv1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-cluster", Namespace: metav1.NamespaceDefault},
+		},
+		AWSCluster: awsCluster,
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	ec2Mock.EXPECT().DescribeVpcs(gomock.Any()).Return(&ec2.DescribeVpcsOutput{
+		Vpcs: []*ec2.Vpc{
+			{
+				VpcId:     aws.String("vpc-ipam"),
+				CidrBlock: aws.String("10.0.0.0/16"),
+				State:     aws.String(ec2.VpcStateAvailable),
+				Tags: []*ec2.Tag{
+					{Key: aws.String("sigs.k8s.io/cluster-api-provider-aws/cluster/test-cluster"), Value: aws.String("owned")},
+				},
+			},
+		},
+	}, nil)
+	// Stop the deletion once the cluster has been patched.
+	ec2Mock.EXPECT().DescribeVpcEndpointsPages(gomock.Any(), gomock.Any()).Return(errors.New("stop"))
+
+	s := NewService(clusterScope)
+	s.EC2Client = ec2Mock
+	g.Expect(s.DeleteNetwork()).NotTo(Succeed())
+
+	g.Expect(clusterScope.VPC().IPAMPool).To(Equal(original.Spec.NetworkSpec.VPC.IPAMPool))
+	patched := &infrav1.AWSCluster{}
+	g.Expect(k8sClient.Get(context.TODO(), client.ObjectKeyFromObject(awsCluster), patched)).To(Succeed())
+	g.Expect(patched.Spec.NetworkSpec.VPC.IPAMPool).To(Equal(original.Spec.NetworkSpec.VPC.IPAMPool))
+	g.Expect(patched.ValidateUpdate(original)).To(Succeed())
+}
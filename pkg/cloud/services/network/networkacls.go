@@ -0,0 +1,428 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/pkg/errors"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/converters"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/filter"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/tags"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/record"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+const (
+	// maxNetworkACLRuleNumber is the highest rule number that can be used by the rules of a network ACL.
+	// Higher numbers belong to the catch-all deny rules of every network ACL, 32767 for IPv4 and 32768
+	// for IPv6, which cannot be modified or deleted.
+	maxNetworkACLRuleNumber = 32766
+)
+
+// networkACLProtocolNumbers maps the protocols of the network ACL rules to the protocol numbers used by EC2.
+var networkACLProtocolNumbers = map[infrav1.NetworkACLProtocol]string{
+	infrav1.NetworkACLProtocolAll:    "-1",
+	infrav1.NetworkACLProtocolTCP:    "6",
+	infrav1.NetworkACLProtocolUDP:    "17",
+	infrav1.NetworkACLProtocolICMP:   "1",
+	infrav1.NetworkACLProtocolICMPv6: "58",
+}
+
+// reconcileNetworkACLs creates the network ACLs of the public and private subnets of a managed VPC,
+// reconciles their rules and associates them with the subnets.
+func (s *Service) reconcileNetworkACLs() error {
+	if s.scope.VPC().IsUnmanaged(s.scope.Name()) {
+		s.scope.Trace("Skipping network ACLs reconcile in unmanaged mode")
+		return nil
+	}
+
+	acls := s.scope.NetworkACLs()
+	if acls == nil {
+		s.scope.Trace("Skipping network ACLs reconcile, no network ACLs configured")
+		return nil
+	}
+
+	s.scope.Debug("Reconciling network ACLs")
+
+	nacls, err := s.describeVpcNetworkACLs()
+	if err != nil {
+		return err
+	}
+
+	for _, tier := range []struct {
+		public bool
+		spec   *infrav1.NetworkACL
+	}{
+		{public: true, spec: acls.Public},
+		{public: false, spec: acls.Private},
+	} {
+		nacl := s.findManagedNetworkACL(nacls, tier.public)
+
+		if tier.spec == nil {
+			if nacl != nil {
+				if err := s.removeNetworkACL(nacl, nacls); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+
+		if nacl == nil {
+			nacl, err = s.createNetworkACL(tier.public)
+			if err != nil {
+				return err
+			}
+		}
+
+		if err := s.reconcileNetworkACLEntries(nacl, tier.spec); err != nil {
+			return err
+		}
+
+		for _, sn := range s.scope.Subnets() {
+			if sn.IsPublic != tier.public || sn.ID == "" {
+				continue
+			}
+			if err := s.associateNetworkACL(nacl, sn.ID, nacls); err != nil {
+				return err
+			}
+		}
+	}
+
+	conditions.MarkTrue(s.scope.InfraCluster(), infrav1.NetworkACLsReadyCondition)
+	return nil
+}
+
+// reconcileNetworkACLEntries creates, replaces and deletes the entries of the network ACL to match the rules of the spec.
+func (s *Service) reconcileNetworkACLEntries(nacl *ec2.NetworkAcl, spec *infrav1.NetworkACL) error {
+	desired := make(map[string]*ec2.NetworkAclEntry)
+	for _, rules := range []struct {
+		egress bool
+		rules  []infrav1.NetworkACLRule
+	}{
+		{egress: false, rules: spec.Ingress},
+		{egress: true, rules: spec.Egress},
+	} {
+		for i := range rules.rules {
+			entry := networkACLEntryFromRule(&rules.rules[i], rules.egress)
+			desired[networkACLEntryKey(entry)] = entry
+		}
+	}
+
+	current := make(map[string]*ec2.NetworkAclEntry)
+	for _, entry := range nacl.Entries {
+		if aws.Int64Value(entry.RuleNumber) > maxNetworkACLRuleNumber {
+			continue
+		}
+		current[networkACLEntryKey(entry)] = entry
+	}
+
+	for key, entry := range current {
+		if _, ok := desired[key]; ok {
+			continue
+		}
+		if _, err := s.EC2Client.DeleteNetworkAclEntry(&ec2.DeleteNetworkAclEntryInput{
+			NetworkAclId: nacl.NetworkAclId,
+			RuleNumber:   entry.RuleNumber,
+			Egress:       entry.Egress,
+		}); err != nil {
+			record.Warnf(s.scope.InfraCluster(), "FailedDeleteNetworkACLEntry", "Failed to delete rule %q of managed NetworkACL %q: %v", key, *nacl.NetworkAclId, err)
+			return errors.Wrapf(err, "failed to delete rule %q of network ACL %q", key, *nacl.NetworkAclId)
+		}
+		s.scope.Debug("Deleted network ACL rule", "network-acl-id", *nacl.NetworkAclId, "rule", key)
+	}
+
+	for key, entry := range desired {
+		existing, ok := current[key]
+		switch {
+		case !ok:
+			if _, err := s.EC2Client.CreateNetworkAclEntry(&ec2.CreateNetworkAclEntryInput{
+				NetworkAclId:  nacl.NetworkAclId,
+				RuleNumber:    entry.RuleNumber,
+				Egress:        entry.Egress,
+				Protocol:      entry.Protocol,
+				RuleAction:    entry.RuleAction,
+				CidrBlock:     entry.CidrBlock,
+				Ipv6CidrBlock: entry.Ipv6CidrBlock,
+				PortRange:     entry.PortRange,
+				IcmpTypeCode:  entry.IcmpTypeCode,
+			}); err != nil {
+				record.Warnf(s.scope.InfraCluster(), "FailedCreateNetworkACLEntry", "Failed to create rule %q of managed NetworkACL %q: %v", key, *nacl.NetworkAclId, err)
+				return errors.Wrapf(err, "failed to create rule %q of network ACL %q", key, *nacl.NetworkAclId)
+			}
+			s.scope.Debug("Created network ACL rule", "network-acl-id", *nacl.NetworkAclId, "rule", key)
+		case !networkACLEntriesEqual(entry, existing):
+			if _, err := s.EC2Client.ReplaceNetworkAclEntry(&ec2.ReplaceNetworkAclEntryInput{
+				NetworkAclId:  nacl.NetworkAclId,
+				RuleNumber:    entry.RuleNumber,
+				Egress:        entry.Egress,
+				Protocol:      entry.Protocol,
+				RuleAction:    entry.RuleAction,
+				CidrBlock:     entry.CidrBlock,
+				Ipv6CidrBlock: entry.Ipv6CidrBlock,
+				PortRange:     entry.PortRange,
+				IcmpTypeCode:  entry.IcmpTypeCode,
+			}); err != nil {
+				record.Warnf(s.scope.InfraCluster(), "FailedReplaceNetworkACLEntry", "Failed to replace rule %q of managed NetworkACL %q: %v", key, *nacl.NetworkAclId, err)
+				return errors.Wrapf(err, "failed to replace rule %q of network ACL %q", key, *nacl.NetworkAclId)
+			}
+			s.scope.Debug("Replaced network ACL rule", "network-acl-id", *nacl.NetworkAclId, "rule", key)
+		}
+	}
+
+	return nil
+}
+
+// associateNetworkACL associates the network ACL with the subnet, replacing its current association.
+func (s *Service) associateNetworkACL(nacl *ec2.NetworkAcl, subnetID string, nacls []*ec2.NetworkAcl) error {
+	association := findNetworkACLAssociation(nacls, subnetID)
+	if association == nil {
+		// Every subnet is associated with a network ACL, the subnet is not visible yet.
+		return errors.Errorf("failed to find the network ACL association of subnet %q", subnetID)
+	}
+	if aws.StringValue(association.NetworkAclId) == aws.StringValue(nacl.NetworkAclId) {
+		return nil
+	}
+
+	if _, err := s.EC2Client.ReplaceNetworkAclAssociation(&ec2.ReplaceNetworkAclAssociationInput{
+		AssociationId: association.NetworkAclAssociationId,
+		NetworkAclId:  nacl.NetworkAclId,
+	}); err != nil {
+		record.Warnf(s.scope.InfraCluster(), "FailedAssociateNetworkACL", "Failed to associate managed NetworkACL %q with subnet %q: %v", *nacl.NetworkAclId, subnetID, err)
+		return errors.Wrapf(err, "failed to associate network ACL %q with subnet %q", *nacl.NetworkAclId, subnetID)
+	}
+
+	record.Eventf(s.scope.InfraCluster(), "SuccessfulAssociateNetworkACL", "Associated managed NetworkACL %q with subnet %q", *nacl.NetworkAclId, subnetID)
+	s.scope.Debug("Associated network ACL with subnet", "network-acl-id", *nacl.NetworkAclId, "subnet-id", subnetID)
+	return nil
+}
+
+// removeNetworkACL moves the subnets associated with a managed network ACL back to the default network ACL
+// of the VPC and deletes it.
+func (s *Service) removeNetworkACL(nacl *ec2.NetworkAcl, nacls []*ec2.NetworkAcl) error {
+	var defaultNACL *ec2.NetworkAcl
+	for _, n := range nacls {
+		if aws.BoolValue(n.IsDefault) {
+			defaultNACL = n
+			break
+		}
+	}
+	if defaultNACL == nil {
+		return errors.Errorf("failed to find the default network ACL of vpc %q", s.scope.VPC().ID)
+	}
+
+	for _, association := range nacl.Associations {
+		if err := s.associateNetworkACL(defaultNACL, aws.StringValue(association.SubnetId), nacls); err != nil {
+			return err
+		}
+	}
+
+	return s.deleteNetworkACL(nacl)
+}
+
+// deleteNetworkACLs deletes the network ACLs of a managed VPC.
+// The subnets, and so the associations of the network ACLs, must have been deleted beforehand.
+func (s *Service) deleteNetworkACLs() error {
+	if s.scope.VPC().IsUnmanaged(s.scope.Name()) {
+		s.scope.Trace("Skipping network ACLs deletion in unmanaged mode")
+		return nil
+	}
+
+	out, err := s.EC2Client.DescribeNetworkAcls(&ec2.DescribeNetworkAclsInput{
+		Filters: []*ec2.Filter{
+			filter.EC2.VPC(s.scope.VPC().ID),
+			filter.EC2.ClusterOwned(s.scope.Name()),
+		},
+	})
+	if err != nil {
+		record.Eventf(s.scope.InfraCluster(), "FailedDescribeNetworkACLs", "Failed to describe network ACLs in vpc %q: %v", s.scope.VPC().ID, err)
+		return errors.Wrapf(err, "failed to describe network ACLs in vpc %q", s.scope.VPC().ID)
+	}
+
+	for _, nacl := range out.NetworkAcls {
+		if aws.BoolValue(nacl.IsDefault) {
+			continue
+		}
+		if err := s.deleteNetworkACL(nacl); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *Service) deleteNetworkACL(nacl *ec2.NetworkAcl) error {
+	if _, err := s.EC2Client.DeleteNetworkAcl(&ec2.DeleteNetworkAclInput{
+		NetworkAclId: nacl.NetworkAclId,
+	}); err != nil {
+		record.Warnf(s.scope.InfraCluster(), "FailedDeleteNetworkACL", "Failed to delete managed NetworkACL %q: %v", *nacl.NetworkAclId, err)
+		return errors.Wrapf(err, "failed to delete network ACL %q", *nacl.NetworkAclId)
+	}
+
+	record.Eventf(s.scope.InfraCluster(), "SuccessfulDeleteNetworkACL", "Deleted managed NetworkACL %q", *nacl.NetworkAclId)
+	s.scope.Info("Deleted network ACL", "network-acl-id", *nacl.NetworkAclId)
+	return nil
+}
+
+func (s *Service) createNetworkACL(public bool) (*ec2.NetworkAcl, error) {
+	out, err := s.EC2Client.CreateNetworkAcl(&ec2.CreateNetworkAclInput{
+		VpcId: aws.String(s.scope.VPC().ID),
+		TagSpecifications: []*ec2.TagSpecification{
+			tags.BuildParamsToTagSpecification(ec2.ResourceTypeNetworkAcl, s.getNetworkACLTagParams(services.TemporaryResourceID, public)),
+		},
+	})
+	if err != nil {
+		record.Warnf(s.scope.InfraCluster(), "FailedCreateNetworkACL", "Failed to create managed NetworkACL: %v", err)
+		return nil, errors.Wrapf(err, "failed to create network ACL in vpc %q", s.scope.VPC().ID)
+	}
+	record.Eventf(s.scope.InfraCluster(), "SuccessfulCreateNetworkACL", "Created managed NetworkACL %q", *out.NetworkAcl.NetworkAclId)
+	s.scope.Info("Created network ACL", "network-acl-id", *out.NetworkAcl.NetworkAclId)
+
+	return out.NetworkAcl, nil
+}
+
+// describeVpcNetworkACLs returns all the network ACLs of the VPC, including the ones not managed by the
+// cluster, as their associations are replaced when subnets are associated with the managed network ACLs.
+func (s *Service) describeVpcNetworkACLs() ([]*ec2.NetworkAcl, error) {
+	out, err := s.EC2Client.DescribeNetworkAcls(&ec2.DescribeNetworkAclsInput{
+		Filters: []*ec2.Filter{
+			filter.EC2.VPC(s.scope.VPC().ID),
+		},
+	})
+	if err != nil {
+		record.Eventf(s.scope.InfraCluster(), "FailedDescribeNetworkACLs", "Failed to describe network ACLs in vpc %q: %v", s.scope.VPC().ID, err)
+		return nil, errors.Wrapf(err, "failed to describe network ACLs in vpc %q", s.scope.VPC().ID)
+	}
+
+	return out.NetworkAcls, nil
+}
+
+// findManagedNetworkACL returns the network ACL of the public or private subnets owned by the cluster, if any.
+func (s *Service) findManagedNetworkACL(nacls []*ec2.NetworkAcl, public bool) *ec2.NetworkAcl {
+	role := infrav1.PrivateRoleTagValue
+	if public {
+		role = infrav1.PublicRoleTagValue
+	}
+
+	for _, nacl := range nacls {
+		naclTags := converters.TagsToMap(nacl.Tags)
+		if naclTags.HasOwned(s.scope.Name()) && naclTags.GetRole() == role {
+			return nacl
+		}
+	}
+	return nil
+}
+
+func (s *Service) getNetworkACLTagParams(id string, public bool) infrav1.BuildParams {
+	role := infrav1.PrivateRoleTagValue
+	if public {
+		role = infrav1.PublicRoleTagValue
+	}
+
+	return infrav1.BuildParams{
+		ClusterName: s.scope.Name(),
+		ResourceID:  id,
+		Lifecycle:   infrav1.ResourceLifecycleOwned,
+		Name:        aws.String(fmt.Sprintf("%s-nacl-%s", s.scope.Name(), role)),
+		Role:        aws.String(role),
+		Additional:  s.scope.AdditionalTags(),
+	}
+}
+
+// findNetworkACLAssociation returns the association of the subnet among the network ACLs of the VPC.
+func findNetworkACLAssociation(nacls []*ec2.NetworkAcl, subnetID string) *ec2.NetworkAclAssociation {
+	for _, nacl := range nacls {
+		for _, association := range nacl.Associations {
+			if aws.StringValue(association.SubnetId) == subnetID {
+				return association
+			}
+		}
+	}
+	return nil
+}
+
+func networkACLEntryFromRule(rule *infrav1.NetworkACLRule, egress bool) *ec2.NetworkAclEntry {
+	entry := &ec2.NetworkAclEntry{
+		RuleNumber: aws.Int64(rule.RuleNumber),
+		Egress:     aws.Bool(egress),
+		Protocol:   aws.String(networkACLProtocolNumbers[rule.Protocol]),
+		RuleAction: aws.String(string(rule.RuleAction)),
+	}
+	if rule.CIDRBlock != "" {
+		entry.CidrBlock = aws.String(rule.CIDRBlock)
+	}
+	if rule.IPv6CIDRBlock != "" {
+		entry.Ipv6CidrBlock = aws.String(rule.IPv6CIDRBlock)
+	}
+
+	switch rule.Protocol {
+	case infrav1.NetworkACLProtocolTCP, infrav1.NetworkACLProtocolUDP:
+		entry.PortRange = &ec2.PortRange{
+			From: rule.FromPort,
+			To:   rule.ToPort,
+		}
+	case infrav1.NetworkACLProtocolICMP, infrav1.NetworkACLProtocolICMPv6:
+		entry.IcmpTypeCode = &ec2.IcmpTypeCode{
+			Type: rule.ICMPType,
+			Code: rule.ICMPCode,
+		}
+	}
+
+	return entry
+}
+
+func networkACLEntryKey(entry *ec2.NetworkAclEntry) string {
+	direction := "ingress"
+	if aws.BoolValue(entry.Egress) {
+		direction = "egress"
+	}
+	return fmt.Sprintf("%s/%d", direction, aws.Int64Value(entry.RuleNumber))
+}
+
+// networkACLEntriesEqual compares the fields of the entries set from the rules of the spec.
+// The port range and the ICMP type and code are only relevant to the protocols using them.
+func networkACLEntriesEqual(desired, current *ec2.NetworkAclEntry) bool {
+	if aws.StringValue(desired.Protocol) != aws.StringValue(current.Protocol) ||
+		aws.StringValue(desired.RuleAction) != aws.StringValue(current.RuleAction) ||
+		aws.StringValue(desired.CidrBlock) != aws.StringValue(current.CidrBlock) ||
+		aws.StringValue(desired.Ipv6CidrBlock) != aws.StringValue(current.Ipv6CidrBlock) {
+		return false
+	}
+
+	if desired.PortRange != nil {
+		if current.PortRange == nil ||
+			aws.Int64Value(desired.PortRange.From) != aws.Int64Value(current.PortRange.From) ||
+			aws.Int64Value(desired.PortRange.To) != aws.Int64Value(current.PortRange.To) {
+			return false
+		}
+	}
+
+	if desired.IcmpTypeCode != nil {
+		if current.IcmpTypeCode == nil ||
+			aws.Int64Value(desired.IcmpTypeCode.Type) != aws.Int64Value(current.IcmpTypeCode.Type) ||
+			aws.Int64Value(desired.IcmpTypeCode.Code) != aws.Int64Value(current.IcmpTypeCode.Code) {
+			return false
+		}
+	}
+
+	return true
+}
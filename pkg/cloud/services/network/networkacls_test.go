@@ -0,0 +1,386 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/test/mocks"
+)
+
+func TestReconcileNetworkACLs(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	subnets := infrav1.Subnets{
+		{
+			ID:               "subnet-public",
+			AvailabilityZone: "us-east-1a",
+			IsPublic:         true,
+		},
+		{
+			ID:               "subnet-private",
+			AvailabilityZone: "us-east-1a",
+		},
+	}
+
+	defaultNACL := func() *ec2.NetworkAcl {
+		return &ec2.NetworkAcl{
+			NetworkAclId: aws.String("acl-default"),
+			IsDefault:    aws.Bool(true),
+			Associations: []*ec2.NetworkAclAssociation{
+				{
+					NetworkAclAssociationId: aws.String("aclassoc-public"),
+					NetworkAclId:            aws.String("acl-default"),
+					SubnetId:                aws.String("subnet-public"),
+				},
+				{
+					NetworkAclAssociationId: aws.String("aclassoc-private"),
+					NetworkAclId:            aws.String("acl-default"),
+					SubnetId:                aws.String("subnet-private"),
+				},
+			},
+		}
+	}
+
+	testCases := []struct {
+		name        string
+		networkACLs *infrav1.NetworkACLs
+		expect      func(m *mocks.MockEC2APIMockRecorder)
+	}{
+		{
+			name:        "no network ACLs configured, do nothing",
+			networkACLs: nil,
+			expect:      func(m *mocks.MockEC2APIMockRecorder) {},
+		},
+		{
+			name: "public network ACL is created, filled and associated with the public subnets",
+			networkACLs: &infrav1.NetworkACLs{
+				Public: &infrav1.NetworkACL{
+					Ingress: []infrav1.NetworkACLRule{
+						{
+							RuleNumber: 100,
+							Protocol:   infrav1.NetworkACLProtocolTCP,
+							RuleAction: infrav1.NetworkACLRuleActionAllow,
+							CIDRBlock:  "0.0.0.0/0",
+							FromPort:   aws.Int64(443),
+							ToPort:     aws.Int64(443),
+						},
+					},
+					Egress: []infrav1.NetworkACLRule{
+						{
+							RuleNumber: 100,
+							Protocol:   infrav1.NetworkACLProtocolAll,
+							RuleAction: infrav1.NetworkACLRuleActionAllow,
+							CIDRBlock:  "0.0.0.0/0",
+						},
+					},
+				},
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeNetworkAcls(gomock.AssignableToTypeOf(&ec2.DescribeNetworkAclsInput{})).
+					Return(&ec2.DescribeNetworkAclsOutput{NetworkAcls: []*ec2.NetworkAcl{defaultNACL()}}, nil)
+				m.CreateNetworkAcl(gomock.AssignableToTypeOf(&ec2.CreateNetworkAclInput{})).
+					Return(&ec2.CreateNetworkAclOutput{NetworkAcl: &ec2.NetworkAcl{NetworkAclId: aws.String("acl-public")}}, nil)
+				m.CreateNetworkAclEntry(gomock.Eq(&ec2.CreateNetworkAclEntryInput{
+					NetworkAclId: aws.String("acl-public"),
+					RuleNumber:   aws.Int64(100),
+					Egress:       aws.Bool(false),
+					Protocol:     aws.String("6"),
+					RuleAction:   aws.String("allow"),
+					CidrBlock:    aws.String("0.0.0.0/0"),
+					PortRange:    &ec2.PortRange{From: aws.Int64(443), To: aws.Int64(443)},
+				})).Return(&ec2.CreateNetworkAclEntryOutput{}, nil)
+				m.CreateNetworkAclEntry(gomock.Eq(&ec2.CreateNetworkAclEntryInput{
+					NetworkAclId: aws.String("acl-public"),
+					RuleNumber:   aws.Int64(100),
+					Egress:       aws.Bool(true),
+					Protocol:     aws.String("-1"),
+					RuleAction:   aws.String("allow"),
+					CidrBlock:    aws.String("0.0.0.0/0"),
+				})).Return(&ec2.CreateNetworkAclEntryOutput{}, nil)
+				m.ReplaceNetworkAclAssociation(gomock.Eq(&ec2.ReplaceNetworkAclAssociationInput{
+					AssociationId: aws.String("aclassoc-public"),
+					NetworkAclId:  aws.String("acl-public"),
+				})).Return(&ec2.ReplaceNetworkAclAssociationOutput{}, nil)
+			},
+		},
+		{
+			name: "drifted rules of an existing network ACL are replaced and unknown rules are deleted",
+			networkACLs: &infrav1.NetworkACLs{
+				Private: &infrav1.NetworkACL{
+					Ingress: []infrav1.NetworkACLRule{
+						{
+							RuleNumber: 100,
+							Protocol:   infrav1.NetworkACLProtocolAll,
+							RuleAction: infrav1.NetworkACLRuleActionAllow,
+							CIDRBlock:  "10.0.0.0/16",
+						},
+					},
+				},
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeNetworkAcls(gomock.AssignableToTypeOf(&ec2.DescribeNetworkAclsInput{})).
+					Return(&ec2.DescribeNetworkAclsOutput{NetworkAcls: []*ec2.NetworkAcl{
+						{
+							NetworkAclId: aws.String("acl-default"),
+							IsDefault:    aws.Bool(true),
+							Associations: []*ec2.NetworkAclAssociation{
+								{
+									NetworkAclAssociationId: aws.String("aclassoc-public"),
+									NetworkAclId:            aws.String("acl-default"),
+									SubnetId:                aws.String("subnet-public"),
+								},
+							},
+						},
+						{
+							NetworkAclId: aws.String("acl-private"),
+							Tags: []*ec2.Tag{
+								{Key: aws.String("sigs.k8s.io/cluster-api-provider-aws/cluster/test-cluster"), Value: aws.String("owned")},
+								{Key: aws.String("sigs.k8s.io/cluster-api-provider-aws/role"), Value: aws.String("private")},
+							},
+							Associations: []*ec2.NetworkAclAssociation{
+								{
+									NetworkAclAssociationId: aws.String("aclassoc-private"),
+									NetworkAclId:            aws.String("acl-private"),
+									SubnetId:                aws.String("subnet-private"),
+								},
+							},
+							Entries: []*ec2.NetworkAclEntry{
+								{
+									RuleNumber: aws.Int64(100),
+									Egress:     aws.Bool(false),
+									Protocol:   aws.String("-1"),
+									RuleAction: aws.String("deny"),
+									CidrBlock:  aws.String("10.0.0.0/16"),
+								},
+								{
+									RuleNumber: aws.Int64(200),
+									Egress:     aws.Bool(false),
+									Protocol:   aws.String("-1"),
+									RuleAction: aws.String("allow"),
+									CidrBlock:  aws.String("0.0.0.0/0"),
+								},
+								{
+									RuleNumber: aws.Int64(32767),
+									Egress:     aws.Bool(false),
+									Protocol:   aws.String("-1"),
+									RuleAction: aws.String("deny"),
+									CidrBlock:  aws.String("0.0.0.0/0"),
+								},
+							},
+						},
+					}}, nil)
+				m.DeleteNetworkAclEntry(gomock.Eq(&ec2.DeleteNetworkAclEntryInput{
+					NetworkAclId: aws.String("acl-private"),
+					RuleNumber:   aws.Int64(200),
+					Egress:       aws.Bool(false),
+				})).Return(&ec2.DeleteNetworkAclEntryOutput{}, nil)
+				m.ReplaceNetworkAclEntry(gomock.Eq(&ec2.ReplaceNetworkAclEntryInput{
+					NetworkAclId: aws.String("acl-private"),
+					RuleNumber:   aws.Int64(100),
+					Egress:       aws.Bool(false),
+					Protocol:     aws.String("-1"),
+					RuleAction:   aws.String("allow"),
+					CidrBlock:    aws.String("10.0.0.0/16"),
+				})).Return(&ec2.ReplaceNetworkAclEntryOutput{}, nil)
+			},
+		},
+		{
+			name: "default deny rules of an IPv6 network ACL are left untouched",
+			networkACLs: &infrav1.NetworkACLs{
+				Private: &infrav1.NetworkACL{
+					Ingress: []infrav1.NetworkACLRule{
+						{
+							RuleNumber:    100,
+							Protocol:      infrav1.NetworkACLProtocolAll,
+							RuleAction:    infrav1.NetworkACLRuleActionAllow,
+							IPv6CIDRBlock: "2001:db8::/56",
+						},
+					},
+				},
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeNetworkAcls(gomock.AssignableToTypeOf(&ec2.DescribeNetworkAclsInput{})).
+					Return(&ec2.DescribeNetworkAclsOutput{NetworkAcls: []*ec2.NetworkAcl{
+						{
+							NetworkAclId: aws.String("acl-default"),
+							IsDefault:    aws.Bool(true),
+							Associations: []*ec2.NetworkAclAssociation{
+								{
+									NetworkAclAssociationId: aws.String("aclassoc-public"),
+									NetworkAclId:            aws.String("acl-default"),
+									SubnetId:                aws.String("subnet-public"),
+								},
+							},
+						},
+						{
+							NetworkAclId: aws.String("acl-private"),
+							Tags: []*ec2.Tag{
+								{Key: aws.String("sigs.k8s.io/cluster-api-provider-aws/cluster/test-cluster"), Value: aws.String("owned")},
+								{Key: aws.String("sigs.k8s.io/cluster-api-provider-aws/role"), Value: aws.String("private")},
+							},
+							Associations: []*ec2.NetworkAclAssociation{
+								{
+									NetworkAclAssociationId: aws.String("aclassoc-private"),
+									NetworkAclId:            aws.String("acl-private"),
+									SubnetId:                aws.String("subnet-private"),
+								},
+							},
+							Entries: []*ec2.NetworkAclEntry{
+								{
+									RuleNumber:    aws.Int64(100),
+									Egress:        aws.Bool(false),
+									Protocol:      aws.String("-1"),
+									RuleAction:    aws.String("allow"),
+									Ipv6CidrBlock: aws.String("2001:db8::/56"),
+								},
+								{
+									RuleNumber: aws.Int64(32767),
+									Egress:     aws.Bool(false),
+									Protocol:   aws.String("-1"),
+									RuleAction: aws.String("deny"),
+									CidrBlock:  aws.String("0.0.0.0/0"),
+								},
+								{
+									RuleNumber:    aws.Int64(32768),
+									Egress:        aws.Bool(false),
+									Protocol:      aws.String("-1"),
+									RuleAction:    aws.String("deny"),
+									Ipv6CidrBlock: aws.String("::/0"),
+								},
+								{
+									RuleNumber: aws.Int64(32767),
+									Egress:     aws.Bool(true),
+									Protocol:   aws.String("-1"),
+									RuleAction: aws.String("deny"),
+									CidrBlock:  aws.String("0.0.0.0/0"),
+								},
+								{
+									RuleNumber:    aws.Int64(32768),
+									Egress:        aws.Bool(true),
+									Protocol:      aws.String("-1"),
+									RuleAction:    aws.String("deny"),
+									Ipv6CidrBlock: aws.String("::/0"),
+								},
+							},
+						},
+					}}, nil)
+			},
+		},
+		{
+			name:        "network ACL of an unset tier is removed",
+			networkACLs: &infrav1.NetworkACLs{},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeNetworkAcls(gomock.AssignableToTypeOf(&ec2.DescribeNetworkAclsInput{})).
+					Return(&ec2.DescribeNetworkAclsOutput{NetworkAcls: []*ec2.NetworkAcl{
+						{
+							NetworkAclId: aws.String("acl-default"),
+							IsDefault:    aws.Bool(true),
+						},
+						{
+							NetworkAclId: aws.String("acl-public"),
+							Tags: []*ec2.Tag{
+								{Key: aws.String("sigs.k8s.io/cluster-api-provider-aws/cluster/test-cluster"), Value: aws.String("owned")},
+								{Key: aws.String("sigs.k8s.io/cluster-api-provider-aws/role"), Value: aws.String("public")},
+							},
+							Associations: []*ec2.NetworkAclAssociation{
+								{
+									NetworkAclAssociationId: aws.String("aclassoc-public"),
+									NetworkAclId:            aws.String("acl-public"),
+									SubnetId:                aws.String("subnet-public"),
+								},
+							},
+						},
+					}}, nil)
+				m.ReplaceNetworkAclAssociation(gomock.Eq(&ec2.ReplaceNetworkAclAssociationInput{
+					AssociationId: aws.String("aclassoc-public"),
+					NetworkAclId:  aws.String("acl-default"),
+				})).Return(&ec2.ReplaceNetworkAclAssociationOutput{}, nil)
+				m.DeleteNetworkAcl(gomock.Eq(&ec2.DeleteNetworkAclInput{
+					NetworkAclId: aws.String("acl-public"),
+				})).Return(&ec2.DeleteNetworkAclOutput{}, nil)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			ec2Mock := mocks.NewMockEC2API(mockCtrl)
+
+			scope, err := getVPCEndpointsClusterScope(&infrav1.NetworkSpec{
+				VPC: infrav1.VPCSpec{
+					ID: "vpc-nacl",
+					Tags: infrav1.Tags{
+						infrav1.ClusterTagKey("test-cluster"): "owned",
+					},
+				},
+				Subnets:     subnets.DeepCopy(),
+				NetworkACLs: tc.networkACLs,
+			})
+			g.Expect(err).NotTo(HaveOccurred())
+
+			tc.expect(ec2Mock.EXPECT())
+
+			s := NewService(scope)
+			s.EC2Client = ec2Mock
+
+			g.Expect(s.reconcileNetworkACLs()).To(Succeed())
+		})
+	}
+}
+
+func TestDeleteNetworkACLs(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	g := NewWithT(t)
+	ec2Mock := mocks.NewMockEC2API(mockCtrl)
+
+	scope, err := getVPCEndpointsClusterScope(&infrav1.NetworkSpec{
+		VPC: infrav1.VPCSpec{
+			ID: "vpc-nacl",
+			Tags: infrav1.Tags{
+				infrav1.ClusterTagKey("test-cluster"): "owned",
+			},
+		},
+		NetworkACLs: &infrav1.NetworkACLs{},
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	ec2Mock.EXPECT().DescribeNetworkAcls(gomock.AssignableToTypeOf(&ec2.DescribeNetworkAclsInput{})).
+		Return(&ec2.DescribeNetworkAclsOutput{NetworkAcls: []*ec2.NetworkAcl{
+			{NetworkAclId: aws.String("acl-public")},
+			{NetworkAclId: aws.String("acl-private")},
+		}}, nil)
+	ec2Mock.EXPECT().DeleteNetworkAcl(gomock.Eq(&ec2.DeleteNetworkAclInput{NetworkAclId: aws.String("acl-public")})).
+		Return(&ec2.DeleteNetworkAclOutput{}, nil)
+	ec2Mock.EXPECT().DeleteNetworkAcl(gomock.Eq(&ec2.DeleteNetworkAclInput{NetworkAclId: aws.String("acl-private")})).
+		Return(&ec2.DeleteNetworkAclOutput{}, nil)
+
+	s := NewService(scope)
+	s.EC2Client = ec2Mock
+
+	g.Expect(s.deleteNetworkACLs()).To(Succeed())
+}
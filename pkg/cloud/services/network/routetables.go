@@ -22,6 +22,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/pkg/errors"
+	"k8s.io/utils/strings/slices"
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/awserrors"
@@ -56,7 +57,16 @@ func (s *Service) reconcileRouteTables() error {
 		sn := subnets[i]
 		// We need to compile the minimum routes for this subnet first, so we can compare it or create them.
 		var routes []*ec2.Route
-		if sn.IsPublic {
+		switch {
+		case sn.IsPublic && sn.IsEdgeWavelength():
+			// Wavelength Zones reach the carrier network through a carrier gateway.
+			if s.scope.VPC().CarrierGatewayID == nil {
+				return errors.Errorf("failed to create routing tables: carrier gateway for %q is nil", s.scope.VPC().ID)
+			}
+			routes = append(routes, s.getCarrierGatewayPublicRoute())
+		case sn.IsEdgeWavelength():
+			// NAT gateways are not available to private subnets in Wavelength Zones.
+		case sn.IsPublic:
 			if s.scope.VPC().InternetGatewayID == nil {
 				return errors.Errorf("failed to create routing tables: internet gateway for %q is nil", s.scope.VPC().ID)
 			}
@@ -64,7 +74,7 @@ func (s *Service) reconcileRouteTables() error {
 			if sn.IsIPv6 {
 				routes = append(routes, s.getGatewayPublicIPv6Route())
 			}
-		} else {
+		default:
 			natGatewayID, err := s.getNatGatewayForSubnet(&sn)
 			if err != nil {
 				return err
@@ -80,6 +90,9 @@ func (s *Service) reconcileRouteTables() error {
 				routes = append(routes, s.getEgressOnlyInternetGateway())
 			}
 		}
+		// Additional routes are kept apart, as they are created in existing route tables when missing.
+		additionalRoutes := s.getAdditionalRoutes(&sn)
+		routes = append(routes, additionalRoutes...)
 
 		if rt, ok := subnetRouteMap[sn.ID]; ok {
 			s.scope.Debug("Subnet is already associated with route table", "subnet-id", sn.ID, "route-table-id", *rt.RouteTableId)
@@ -98,6 +111,11 @@ func (s *Service) reconcileRouteTables() error {
 				}
 			}
 
+			// Additional routes can be added or removed after the route table was created.
+			if err := s.reconcileAdditionalRoutes(additionalRoutes, rt); err != nil {
+				return err
+			}
+
 			// Make sure tags are up-to-date.
 			if err := wait.WaitForWithRetryable(wait.NewBackoff(), func() (bool, error) {
 				buildParams := s.getRouteTableTagParams(*rt.RouteTableId, sn.IsPublic, sn.AvailabilityZone)
@@ -121,6 +139,9 @@ func (s *Service) reconcileRouteTables() error {
 		if err != nil {
 			return err
 		}
+		for _, route := range additionalRoutes {
+			s.recordAdditionalRoute(rt.ID, routeDestination(route))
+		}
 
 		if err := wait.WaitForWithRetryable(wait.NewBackoff(), func() (bool, error) {
 			if err := s.associateRouteTable(rt, sn.ID); err != nil {
@@ -144,21 +165,22 @@ func (s *Service) fixMismatchedRouting(specRoute *ec2.Route, currentRoute *ec2.R
 	if specRoute.DestinationCidrBlock != nil {
 		if (currentRoute.DestinationCidrBlock != nil &&
 			*currentRoute.DestinationCidrBlock == *specRoute.DestinationCidrBlock) &&
-			((currentRoute.GatewayId != nil && *currentRoute.GatewayId != *specRoute.GatewayId) ||
-				(currentRoute.NatGatewayId != nil && *currentRoute.NatGatewayId != *specRoute.NatGatewayId)) {
+			routeTargetMismatch(specRoute, currentRoute) {
 			input = &ec2.ReplaceRouteInput{
-				RouteTableId:         rt.RouteTableId,
-				DestinationCidrBlock: specRoute.DestinationCidrBlock,
-				GatewayId:            specRoute.GatewayId,
-				NatGatewayId:         specRoute.NatGatewayId,
+				RouteTableId:           rt.RouteTableId,
+				DestinationCidrBlock:   specRoute.DestinationCidrBlock,
+				CarrierGatewayId:       specRoute.CarrierGatewayId,
+				GatewayId:              specRoute.GatewayId,
+				NatGatewayId:           specRoute.NatGatewayId,
+				TransitGatewayId:       specRoute.TransitGatewayId,
+				VpcPeeringConnectionId: specRoute.VpcPeeringConnectionId,
 			}
 		}
 	}
 	if specRoute.DestinationIpv6CidrBlock != nil {
 		if (currentRoute.DestinationIpv6CidrBlock != nil &&
 			*currentRoute.DestinationIpv6CidrBlock == *specRoute.DestinationIpv6CidrBlock) &&
-			((currentRoute.GatewayId != nil && *currentRoute.GatewayId != *specRoute.GatewayId) ||
-				(currentRoute.NatGatewayId != nil && *currentRoute.NatGatewayId != *specRoute.NatGatewayId)) {
+			routeTargetMismatch(specRoute, currentRoute) {
 			input = &ec2.ReplaceRouteInput{
 				RouteTableId:                rt.RouteTableId,
 				DestinationIpv6CidrBlock:    specRoute.DestinationIpv6CidrBlock,
@@ -166,6 +188,21 @@ func (s *Service) fixMismatchedRouting(specRoute *ec2.Route, currentRoute *ec2.R
 				GatewayId:                   specRoute.GatewayId,
 				NatGatewayId:                specRoute.NatGatewayId,
 				EgressOnlyInternetGatewayId: specRoute.EgressOnlyInternetGatewayId,
+				TransitGatewayId:            specRoute.TransitGatewayId,
+				VpcPeeringConnectionId:      specRoute.VpcPeeringConnectionId,
+			}
+		}
+	}
+	if specRoute.DestinationPrefixListId != nil {
+		if (currentRoute.DestinationPrefixListId != nil &&
+			*currentRoute.DestinationPrefixListId == *specRoute.DestinationPrefixListId) &&
+			routeTargetMismatch(specRoute, currentRoute) {
+			input = &ec2.ReplaceRouteInput{
+				RouteTableId:            rt.RouteTableId,
+				DestinationPrefixListId: specRoute.DestinationPrefixListId,
+				GatewayId:               specRoute.GatewayId,
+				TransitGatewayId:        specRoute.TransitGatewayId,
+				VpcPeeringConnectionId:  specRoute.VpcPeeringConnectionId,
 			}
 		}
 	}
@@ -183,6 +220,202 @@ func (s *Service) fixMismatchedRouting(specRoute *ec2.Route, currentRoute *ec2.R
 	return nil
 }
 
+// reconcileAdditionalRoutes creates the missing additional routes in an existing route table and records
+// them in the network status, then deletes the recorded routes that are no longer part of the spec.
+// Routes the provider did not create are never deleted. Routes with a mismatched target are replaced
+// by fixMismatchedRouting.
+func (s *Service) reconcileAdditionalRoutes(routes []*ec2.Route, rt *ec2.RouteTable) error {
+	current := make(map[string]*ec2.Route)
+	for _, route := range rt.Routes {
+		if destination := routeDestination(route); destination != "" {
+			current[destination] = route
+		}
+	}
+
+	wanted := make(map[string]struct{})
+	for i := range routes {
+		route := routes[i]
+		destination := routeDestination(route)
+		wanted[destination] = struct{}{}
+		if _, ok := current[destination]; ok {
+			continue
+		}
+		if err := wait.WaitForWithRetryable(wait.NewBackoff(), func() (bool, error) {
+			if _, err := s.EC2Client.CreateRoute(&ec2.CreateRouteInput{
+				RouteTableId:             rt.RouteTableId,
+				DestinationCidrBlock:     route.DestinationCidrBlock,
+				DestinationIpv6CidrBlock: route.DestinationIpv6CidrBlock,
+				DestinationPrefixListId:  route.DestinationPrefixListId,
+				GatewayId:                route.GatewayId,
+				TransitGatewayId:         route.TransitGatewayId,
+				VpcPeeringConnectionId:   route.VpcPeeringConnectionId,
+			}); err != nil {
+				return false, err
+			}
+			return true, nil
+		}, awserrors.RouteTableNotFound); err != nil {
+			record.Warnf(s.scope.InfraCluster(), "FailedCreateRoute", "Failed to create route %s for RouteTable %q: %v", route.GoString(), *rt.RouteTableId, err)
+			return errors.Wrapf(err, "failed to create route in route table %q: %s", *rt.RouteTableId, route.GoString())
+		}
+		record.Eventf(s.scope.InfraCluster(), "SuccessfulCreateRoute", "Created route %s for RouteTable %q", route.GoString(), *rt.RouteTableId)
+		s.recordAdditionalRoute(*rt.RouteTableId, destination)
+	}
+
+	for _, destination := range s.additionalRouteDestinations(*rt.RouteTableId) {
+		if _, ok := wanted[destination]; ok {
+			continue
+		}
+		if route, ok := current[destination]; ok {
+			if _, err := s.EC2Client.DeleteRoute(&ec2.DeleteRouteInput{
+				RouteTableId:             rt.RouteTableId,
+				DestinationCidrBlock:     route.DestinationCidrBlock,
+				DestinationIpv6CidrBlock: route.DestinationIpv6CidrBlock,
+				DestinationPrefixListId:  route.DestinationPrefixListId,
+			}); err != nil {
+				record.Warnf(s.scope.InfraCluster(), "FailedDeleteRoute", "Failed to delete route %s from RouteTable %q: %v", route.GoString(), *rt.RouteTableId, err)
+				return errors.Wrapf(err, "failed to delete route from route table %q: %s", *rt.RouteTableId, route.GoString())
+			}
+			record.Eventf(s.scope.InfraCluster(), "SuccessfulDeleteRoute", "Deleted route %s from RouteTable %q", route.GoString(), *rt.RouteTableId)
+		}
+		s.forgetAdditionalRoute(*rt.RouteTableId, destination)
+	}
+
+	return nil
+}
+
+// additionalRouteDestinations returns the destinations of the additional routes created by the provider
+// in the given route table.
+func (s *Service) additionalRouteDestinations(routeTableID string) []string {
+	for _, rtRoutes := range s.scope.Network().AdditionalRoutes {
+		if rtRoutes.RouteTableID == routeTableID {
+			return slices.Clone(rtRoutes.Destinations)
+		}
+	}
+	return nil
+}
+
+// recordAdditionalRoute records an additional route created by the provider in the network status.
+func (s *Service) recordAdditionalRoute(routeTableID, destination string) {
+	network := s.scope.Network()
+	for i := range network.AdditionalRoutes {
+		if network.AdditionalRoutes[i].RouteTableID == routeTableID {
+			if !slices.Contains(network.AdditionalRoutes[i].Destinations, destination) {
+				network.AdditionalRoutes[i].Destinations = append(network.AdditionalRoutes[i].Destinations, destination)
+			}
+			return
+		}
+	}
+	network.AdditionalRoutes = append(network.AdditionalRoutes, infrav1.RouteTableRoutes{
+		RouteTableID: routeTableID,
+		Destinations: []string{destination},
+	})
+}
+
+// forgetAdditionalRoute removes an additional route from the network status, and the route table
+// once it has no recorded route left.
+func (s *Service) forgetAdditionalRoute(routeTableID, destination string) {
+	network := s.scope.Network()
+	for i := range network.AdditionalRoutes {
+		if network.AdditionalRoutes[i].RouteTableID != routeTableID {
+			continue
+		}
+		network.AdditionalRoutes[i].Destinations = slices.Filter(nil, network.AdditionalRoutes[i].Destinations, func(d string) bool {
+			return d != destination
+		})
+		if len(network.AdditionalRoutes[i].Destinations) == 0 {
+			network.AdditionalRoutes = append(network.AdditionalRoutes[:i], network.AdditionalRoutes[i+1:]...)
+		}
+		return
+	}
+}
+
+// forgetRouteTable removes all the additional routes of a deleted route table from the network status.
+func (s *Service) forgetRouteTable(routeTableID string) {
+	network := s.scope.Network()
+	for i := range network.AdditionalRoutes {
+		if network.AdditionalRoutes[i].RouteTableID == routeTableID {
+			network.AdditionalRoutes = append(network.AdditionalRoutes[:i], network.AdditionalRoutes[i+1:]...)
+			return
+		}
+	}
+}
+
+// getAdditionalRoutes returns the additional routes of a subnet: its own routes, then the routes of its
+// subnet class and, for private subnets, the routes to the transit gateway. Only the first route for a
+// given destination is kept.
+func (s *Service) getAdditionalRoutes(sn *infrav1.SubnetSpec) []*ec2.Route {
+	specRoutes := append([]infrav1.Route{}, sn.AdditionalRoutes...)
+	if classRoutes := s.scope.AdditionalRoutes(); classRoutes != nil {
+		if sn.IsPublic {
+			specRoutes = append(specRoutes, classRoutes.Public...)
+		} else {
+			specRoutes = append(specRoutes, classRoutes.Private...)
+		}
+	}
+
+	var routes []*ec2.Route
+	for i := range specRoutes {
+		route := &ec2.Route{}
+		specRoute := specRoutes[i]
+		if specRoute.DestinationCIDRBlock != "" {
+			route.DestinationCidrBlock = aws.String(specRoute.DestinationCIDRBlock)
+		}
+		if specRoute.DestinationIPv6CIDRBlock != "" {
+			route.DestinationIpv6CidrBlock = aws.String(specRoute.DestinationIPv6CIDRBlock)
+		}
+		if specRoute.DestinationPrefixListID != "" {
+			route.DestinationPrefixListId = aws.String(specRoute.DestinationPrefixListID)
+		}
+		if specRoute.VPCPeeringConnectionID != "" {
+			route.VpcPeeringConnectionId = aws.String(specRoute.VPCPeeringConnectionID)
+		}
+		if specRoute.VirtualPrivateGatewayID != "" {
+			route.GatewayId = aws.String(specRoute.VirtualPrivateGatewayID)
+		}
+		if specRoute.TransitGatewayID != "" {
+			route.TransitGatewayId = aws.String(specRoute.TransitGatewayID)
+		}
+		routes = append(routes, route)
+	}
+	if !sn.IsPublic {
+		routes = append(routes, s.getTransitGatewayRoutes()...)
+	}
+
+	res := make([]*ec2.Route, 0, len(routes))
+	destinations := make(map[string]struct{})
+	for _, route := range routes {
+		destination := routeDestination(route)
+		if _, ok := destinations[destination]; ok {
+			continue
+		}
+		destinations[destination] = struct{}{}
+		res = append(res, route)
+	}
+	return res
+}
+
+// routeDestination returns the destination of a route, whatever its kind.
+func routeDestination(route *ec2.Route) string {
+	switch {
+	case route.DestinationCidrBlock != nil:
+		return *route.DestinationCidrBlock
+	case route.DestinationIpv6CidrBlock != nil:
+		return *route.DestinationIpv6CidrBlock
+	default:
+		return aws.StringValue(route.DestinationPrefixListId)
+	}
+}
+
+// routeTargetMismatch returns true if the current route points to a gateway, NAT gateway, carrier gateway,
+// transit gateway or VPC peering connection other than the target of the spec route.
+func routeTargetMismatch(specRoute *ec2.Route, currentRoute *ec2.Route) bool {
+	return (currentRoute.GatewayId != nil && *currentRoute.GatewayId != aws.StringValue(specRoute.GatewayId)) ||
+		(currentRoute.CarrierGatewayId != nil && *currentRoute.CarrierGatewayId != aws.StringValue(specRoute.CarrierGatewayId)) ||
+		(currentRoute.NatGatewayId != nil && *currentRoute.NatGatewayId != aws.StringValue(specRoute.NatGatewayId)) ||
+		(currentRoute.TransitGatewayId != nil && *currentRoute.TransitGatewayId != aws.StringValue(specRoute.TransitGatewayId)) ||
+		(currentRoute.VpcPeeringConnectionId != nil && *currentRoute.VpcPeeringConnectionId != aws.StringValue(specRoute.VpcPeeringConnectionId))
+}
+
 func (s *Service) describeVpcRouteTablesBySubnet() (map[string]*ec2.RouteTable, error) {
 	rts, err := s.describeVpcRouteTables()
 	if err != nil {
@@ -241,6 +474,7 @@ func (s *Service) deleteRouteTables() error {
 
 		record.Eventf(s.scope.InfraCluster(), "SuccessfulDeleteRouteTable", "Deleted managed RouteTable %q", *rt.RouteTableId)
 		s.scope.Info("Deleted route table", "route-table-id", *rt.RouteTableId)
+		s.forgetRouteTable(*rt.RouteTableId)
 	}
 	return nil
 }
@@ -290,6 +524,9 @@ func (s *Service) createRouteTableWithRoutes(routes []*ec2.Route, isPublic bool,
 				InstanceId:                  route.InstanceId,
 				NatGatewayId:                route.NatGatewayId,
 				NetworkInterfaceId:          route.NetworkInterfaceId,
+				DestinationPrefixListId:     route.DestinationPrefixListId,
+				CarrierGatewayId:            route.CarrierGatewayId,
+				TransitGatewayId:            route.TransitGatewayId,
 				VpcPeeringConnectionId:      route.VpcPeeringConnectionId,
 			}); err != nil {
 				return false, err
@@ -330,6 +567,13 @@ func (s *Service) getNatGatewayPrivateRoute(natGatewayID string) *ec2.Route {
 	}
 }
 
+func (s *Service) getCarrierGatewayPublicRoute() *ec2.Route {
+	return &ec2.Route{
+		DestinationCidrBlock: aws.String(services.AnyIPv4CidrBlock),
+		CarrierGatewayId:     s.scope.VPC().CarrierGatewayID,
+	}
+}
+
 func (s *Service) getEgressOnlyInternetGateway() *ec2.Route {
 	return &ec2.Route{
 		DestinationIpv6CidrBlock:    aws.String(services.AnyIPv6CidrBlock),
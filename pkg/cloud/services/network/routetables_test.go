@@ -418,6 +418,155 @@ func TestReconcileRouteTables(t *testing.T) {
 					Return(nil, nil)
 			},
 		},
+		{
+			name: "additional routes are created when missing and replaced when their target drifted",
+			input: &infrav1.NetworkSpec{
+				VPC: infrav1.VPCSpec{
+					InternetGatewayID: aws.String("igw-01"),
+					ID:                "vpc-routetables",
+					Tags: infrav1.Tags{
+						infrav1.ClusterTagKey("test-cluster"): "owned",
+					},
+				},
+				AdditionalRoutes: &infrav1.AdditionalRoutes{
+					Public: []infrav1.Route{
+						{
+							DestinationCIDRBlock:    "10.10.0.0/16",
+							VirtualPrivateGatewayID: "vgw-01",
+						},
+					},
+					Private: []infrav1.Route{
+						{
+							DestinationCIDRBlock:   "10.20.0.0/16",
+							VPCPeeringConnectionID: "pcx-class",
+						},
+					},
+				},
+				Subnets: infrav1.Subnets{
+					infrav1.SubnetSpec{
+						ID:               "subnet-routetables-private",
+						IsPublic:         false,
+						AvailabilityZone: "us-east-1a",
+						AdditionalRoutes: []infrav1.Route{
+							{
+								DestinationCIDRBlock:   "10.20.0.0/16",
+								VPCPeeringConnectionID: "pcx-subnet",
+							},
+							{
+								DestinationPrefixListID: "pl-01",
+								TransitGatewayID:        "tgw-01",
+							},
+						},
+					},
+					infrav1.SubnetSpec{
+						ID:               "subnet-routetables-public",
+						IsPublic:         true,
+						NatGatewayID:     aws.String("nat-01"),
+						AvailabilityZone: "us-east-1a",
+					},
+				},
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeRouteTables(gomock.AssignableToTypeOf(&ec2.DescribeRouteTablesInput{})).
+					Return(&ec2.DescribeRouteTablesOutput{
+						RouteTables: []*ec2.RouteTable{
+							{
+								RouteTableId: aws.String("route-table-private"),
+								Associations: []*ec2.RouteTableAssociation{
+									{
+										SubnetId: aws.String("subnet-routetables-private"),
+									},
+								},
+								Routes: []*ec2.Route{
+									{
+										DestinationCidrBlock: aws.String("0.0.0.0/0"),
+										NatGatewayId:         aws.String("nat-01"),
+									},
+									{
+										DestinationCidrBlock:   aws.String("10.20.0.0/16"),
+										VpcPeeringConnectionId: aws.String("pcx-old"),
+									},
+								},
+								Tags: []*ec2.Tag{
+									{
+										Key:   aws.String("kubernetes.io/cluster/test-cluster"),
+										Value: aws.String("owned"),
+									},
+									{
+										Key:   aws.String("sigs.k8s.io/cluster-api-provider-aws/role"),
+										Value: aws.String("common"),
+									},
+									{
+										Key:   aws.String("Name"),
+										Value: aws.String("test-cluster-rt-private-us-east-1a"),
+									},
+									{
+										Key:   aws.String("sigs.k8s.io/cluster-api-provider-aws/cluster/test-cluster"),
+										Value: aws.String("owned"),
+									},
+								},
+							},
+							{
+								RouteTableId: aws.String("route-table-public"),
+								Associations: []*ec2.RouteTableAssociation{
+									{
+										SubnetId: aws.String("subnet-routetables-public"),
+									},
+								},
+								Routes: []*ec2.Route{
+									{
+										DestinationCidrBlock: aws.String("0.0.0.0/0"),
+										GatewayId:            aws.String("igw-01"),
+									},
+								},
+								Tags: []*ec2.Tag{
+									{
+										Key:   aws.String("kubernetes.io/cluster/test-cluster"),
+										Value: aws.String("owned"),
+									},
+									{
+										Key:   aws.String("sigs.k8s.io/cluster-api-provider-aws/role"),
+										Value: aws.String("common"),
+									},
+									{
+										Key:   aws.String("Name"),
+										Value: aws.String("test-cluster-rt-public-us-east-1a"),
+									},
+									{
+										Key:   aws.String("sigs.k8s.io/cluster-api-provider-aws/cluster/test-cluster"),
+										Value: aws.String("owned"),
+									},
+								},
+							},
+						},
+					}, nil)
+
+				m.ReplaceRoute(gomock.Eq(
+					&ec2.ReplaceRouteInput{
+						DestinationCidrBlock:   aws.String("10.20.0.0/16"),
+						RouteTableId:           aws.String("route-table-private"),
+						VpcPeeringConnectionId: aws.String("pcx-subnet"),
+					},
+				)).
+					Return(nil, nil)
+				m.CreateRoute(gomock.Eq(
+					&ec2.CreateRouteInput{
+						DestinationPrefixListId: aws.String("pl-01"),
+						RouteTableId:            aws.String("route-table-private"),
+						TransitGatewayId:        aws.String("tgw-01"),
+					},
+				)).
+					Return(&ec2.CreateRouteOutput{}, nil)
+				m.CreateRoute(gomock.Eq(
+					&ec2.CreateRouteInput{
+						DestinationCidrBlock: aws.String("10.10.0.0/16"),
+						RouteTableId:         aws.String("route-table-public"),
+						GatewayId:            aws.String("vgw-01"),
+					},
+				)).
+					Return(&ec2.CreateRouteOutput{}, nil)
+			},
+		},
 		{
 			name: "extra routes exist, do nothing",
 			input: &infrav1.NetworkSpec{
@@ -753,3 +902,88 @@ func (r routeTableInputMatcher) String() string {
 func matchRouteTableInput(input *ec2.CreateRouteTableInput) gomock.Matcher {
 	return routeTableInputMatcher{routeTableInput: input}
 }
+
+func TestReconcileAdditionalRoutes(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	g := NewWithT(t)
+	ec2Mock := mocks.NewMockEC2API(mockCtrl)
+
+	scheme := runtime.NewScheme()
+	_ = infrav1.AddToScheme(scheme)
+	client := fake.NewClientBuilder().WithScheme(scheme).Build()
+	scope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+		Client: client,
+		Cluster: &clusterv1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-cluster"},
+		},
+		AWSCluster: &infrav1.AWSCluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "test"},
+			Status: infrav1.AWSClusterStatus{
+				Network: infrav1.NetworkStatus{
+					AdditionalRoutes: []infrav1.RouteTableRoutes{
+						{
+							RouteTableID: "rtb-01",
+							Destinations: []string{"10.10.0.0/16", "2001:db8::/32", "pl-old", "10.30.0.0/16"},
+						},
+					},
+				},
+			},
+		},
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	s := NewService(scope)
+	s.EC2Client = ec2Mock
+
+	rt := &ec2.RouteTable{
+		RouteTableId: aws.String("rtb-01"),
+		Routes: []*ec2.Route{
+			{
+				DestinationCidrBlock: aws.String("0.0.0.0/0"),
+				NatGatewayId:         aws.String("nat-01"),
+			},
+			{
+				DestinationCidrBlock:   aws.String("10.10.0.0/16"),
+				VpcPeeringConnectionId: aws.String("pcx-01"),
+			},
+			{
+				DestinationIpv6CidrBlock: aws.String("2001:db8::/32"),
+				TransitGatewayId:         aws.String("tgw-01"),
+			},
+			{
+				DestinationPrefixListId: aws.String("pl-old"),
+				GatewayId:               aws.String("vgw-01"),
+			},
+			{
+				// Not created by the provider, kept.
+				DestinationIpv6CidrBlock: aws.String("2001:db8:1::/48"),
+				TransitGatewayId:         aws.String("tgw-01"),
+			},
+		},
+	}
+
+	// 10.30.0.0/16 is recorded but already gone from the route table: it is only forgotten.
+	ec2Mock.EXPECT().DeleteRoute(gomock.Eq(&ec2.DeleteRouteInput{
+		RouteTableId:             aws.String("rtb-01"),
+		DestinationIpv6CidrBlock: aws.String("2001:db8::/32"),
+	})).Return(&ec2.DeleteRouteOutput{}, nil)
+	ec2Mock.EXPECT().DeleteRoute(gomock.Eq(&ec2.DeleteRouteInput{
+		RouteTableId:            aws.String("rtb-01"),
+		DestinationPrefixListId: aws.String("pl-old"),
+	})).Return(&ec2.DeleteRouteOutput{}, nil)
+
+	g.Expect(s.reconcileAdditionalRoutes([]*ec2.Route{
+		{
+			DestinationCidrBlock:   aws.String("10.10.0.0/16"),
+			VpcPeeringConnectionId: aws.String("pcx-01"),
+		},
+	}, rt)).To(Succeed())
+	g.Expect(scope.Network().AdditionalRoutes).To(Equal([]infrav1.RouteTableRoutes{
+		{
+			RouteTableID: "rtb-01",
+			Destinations: []string{"10.10.0.0/16"},
+		},
+	}))
+}
@@ -26,6 +26,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/awserrors"
@@ -155,7 +156,13 @@ func (s *Service) reconcileSubnets() error {
 
 			// Update subnet spec with the existing subnet details
 			// TODO(vincepri): check if subnet needs to be updated.
+			additionalRoutes, zoneType, parentZoneName := sub.AdditionalRoutes, sub.ZoneType, sub.ParentZoneName
 			existingSubnet.DeepCopyInto(sub)
+			// Additional routes and zone information are not part of the described subnet.
+			sub.AdditionalRoutes = additionalRoutes
+			if sub.ZoneType == nil {
+				sub.ZoneType, sub.ParentZoneName = zoneType, parentZoneName
+			}
 		} else if unmanagedVPC {
 			// If there is no existing subnet and we have an umanaged vpc report an error
 			record.Warnf(s.scope.InfraCluster(), "FailedMatchSubnet", "Using unmanaged VPC and failed to find existing subnet for specified subnet id %d, cidr %q", sub.ID, sub.CidrBlock)
@@ -163,6 +170,11 @@ func (s *Service) reconcileSubnets() error {
 		}
 	}
 
+	// Edge subnets are told apart from the subnets in standard availability zones by their zone type.
+	if err := s.reconcileZoneInfo(subnets); err != nil {
+		return err
+	}
+
 	if !unmanagedVPC {
 		// Check that we need at least 1 private and 1 public subnet after we have updated the metadata
 		if len(subnets.FilterPrivate()) < 1 {
@@ -449,7 +461,8 @@ func (s *Service) createSubnet(sn *infrav1.SubnetSpec) (*infrav1.SubnetSpec, err
 		record.Eventf(s.scope.InfraCluster(), "SuccessfulModifySubnetAttributes", "Modified managed Subnet %q attributes", *out.Subnet.SubnetId)
 	}
 
-	if sn.IsPublic {
+	// Instances in Wavelength Zones get carrier IP addresses instead of public IP addresses.
+	if sn.IsPublic && !sn.IsEdgeWavelength() {
 		if err := wait.WaitForWithRetryable(wait.NewBackoff(), func() (bool, error) {
 			if _, err := s.EC2Client.ModifySubnetAttribute(&ec2.ModifySubnetAttributeInput{
 				SubnetId: out.Subnet.SubnetId,
@@ -472,6 +485,9 @@ func (s *Service) createSubnet(sn *infrav1.SubnetSpec) (*infrav1.SubnetSpec, err
 		AvailabilityZone: *out.Subnet.AvailabilityZone,
 		CidrBlock:        *out.Subnet.CidrBlock, // TODO: this will panic in case of IPv6 only subnets...
 		IsPublic:         sn.IsPublic,
+		ZoneType:         sn.ZoneType,
+		ParentZoneName:   sn.ParentZoneName,
+		AdditionalRoutes: sn.AdditionalRoutes,
 	}
 	for _, set := range out.Subnet.Ipv6CidrBlockAssociationSet {
 		if *set.Ipv6CidrBlockState.State == ec2.SubnetCidrBlockStateCodeAssociated {
@@ -555,3 +571,19 @@ func (s *Service) getSubnetTagParams(unmanagedVPC bool, id string, public bool,
 		}
 	}
 }
+
+// getPrivateSubnetIDsPerZone returns the first private subnet of each availability zone. It is used by
+// resources that accept a single subnet per availability zone, like interface VPC endpoints or
+// transit gateway attachments.
+func (s *Service) getPrivateSubnetIDsPerZone() []string {
+	ids := []string{}
+	zones := sets.NewString()
+	for _, sn := range s.scope.Subnets().FilterPrivate() {
+		if sn.ID == "" || zones.Has(sn.AvailabilityZone) {
+			continue
+		}
+		zones.Insert(sn.AvailabilityZone)
+		ids = append(ids, sn.ID)
+	}
+	return ids
+}
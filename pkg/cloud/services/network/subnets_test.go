@@ -2345,6 +2345,7 @@ func TestReconcileSubnets(t *testing.T) {
 }
 
 func TestDiscoverSubnets(t *testing.T) {
+	availabilityZone := infrav1.ZoneTypeAvailabilityZone
 	testCases := []struct {
 		name   string
 		input  *infrav1.NetworkSpec
@@ -2480,6 +2481,7 @@ func TestDiscoverSubnets(t *testing.T) {
 					Tags: infrav1.Tags{
 						"Name": "provided-subnet-public",
 					},
+					ZoneType: &availabilityZone,
 				},
 				{
 					ID:               "subnet-2",
@@ -2490,6 +2492,7 @@ func TestDiscoverSubnets(t *testing.T) {
 					Tags: infrav1.Tags{
 						"Name": "provided-subnet-private",
 					},
+					ZoneType: &availabilityZone,
 				},
 			},
 		},
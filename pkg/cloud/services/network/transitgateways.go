@@ -0,0 +1,306 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/awserrors"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/converters"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/filter"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/wait"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/tags"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/record"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+// transitGatewayAttachmentStates are the states of an attachment that has not been deleted yet.
+var transitGatewayAttachmentStates = []string{
+	ec2.TransitGatewayAttachmentStateInitiating,
+	ec2.TransitGatewayAttachmentStateInitiatingRequest,
+	ec2.TransitGatewayAttachmentStatePendingAcceptance,
+	ec2.TransitGatewayAttachmentStatePending,
+	ec2.TransitGatewayAttachmentStateAvailable,
+	ec2.TransitGatewayAttachmentStateModifying,
+	ec2.TransitGatewayAttachmentStateRollingBack,
+	ec2.TransitGatewayAttachmentStateDeleting,
+}
+
+func (s *Service) reconcileTransitGatewayAttachment() error {
+	if s.scope.VPC().IsUnmanaged(s.scope.Name()) {
+		s.scope.Trace("Skipping transit gateway attachment reconcile in unmanaged mode")
+		return nil
+	}
+
+	tgw := s.scope.TransitGateway()
+	if tgw == nil && s.scope.Network().TransitGatewayAttachmentID == "" {
+		s.scope.Trace("Skipping transit gateway attachment reconcile, no transit gateway configured")
+		return nil
+	}
+
+	s.scope.Debug("Reconciling transit gateway attachment")
+
+	attachments, err := s.describeTransitGatewayAttachments()
+	if err != nil && !awserrors.IsNotFound(err) {
+		return err
+	}
+
+	var attachment *ec2.TransitGatewayVpcAttachment
+	for _, a := range attachments {
+		if aws.StringValue(a.State) == ec2.TransitGatewayAttachmentStateDeleting {
+			continue
+		}
+		if tgw != nil && attachment == nil && aws.StringValue(a.TransitGatewayId) == tgw.ID {
+			attachment = a
+			continue
+		}
+		// The attachment belongs to a transit gateway that is no longer configured.
+		if err := s.deleteTransitGatewayAttachment(a); err != nil {
+			return err
+		}
+	}
+
+	if tgw == nil {
+		s.scope.Network().TransitGatewayAttachmentID = ""
+		return nil
+	}
+
+	if attachment == nil {
+		attachment, err = s.createTransitGatewayAttachment(tgw)
+		if err != nil {
+			return err
+		}
+	} else if err := s.updateTransitGatewayAttachment(tgw, attachment); err != nil {
+		return err
+	}
+
+	attachmentID := aws.StringValue(attachment.TransitGatewayAttachmentId)
+	s.scope.Network().TransitGatewayAttachmentID = attachmentID
+
+	// Routes to the transit gateway can only be created once the attachment is available, they are skipped
+	// until then and the cluster is requeued.
+	switch state := aws.StringValue(attachment.State); state {
+	case ec2.TransitGatewayAttachmentStateAvailable, ec2.TransitGatewayAttachmentStateModifying:
+		conditions.MarkTrue(s.scope.InfraCluster(), infrav1.TransitGatewayAttachmentReadyCondition)
+	case ec2.TransitGatewayAttachmentStatePendingAcceptance:
+		conditions.MarkFalse(s.scope.InfraCluster(), infrav1.TransitGatewayAttachmentReadyCondition, infrav1.WaitForTransitGatewayAttachmentReason, clusterv1.ConditionSeverityWarning,
+			"transit gateway attachment %q must be accepted by the owner of the transit gateway", attachmentID)
+	case ec2.TransitGatewayAttachmentStateFailed, ec2.TransitGatewayAttachmentStateFailing,
+		ec2.TransitGatewayAttachmentStateRejected, ec2.TransitGatewayAttachmentStateRejecting:
+		return errors.Errorf("transit gateway attachment %q is in state %q", attachmentID, state)
+	default:
+		conditions.MarkFalse(s.scope.InfraCluster(), infrav1.TransitGatewayAttachmentReadyCondition, infrav1.WaitForTransitGatewayAttachmentReason, clusterv1.ConditionSeverityInfo,
+			"transit gateway attachment %q is in state %q", attachmentID, state)
+	}
+	return nil
+}
+
+func (s *Service) deleteTransitGatewayAttachments() error {
+	if s.scope.VPC().IsUnmanaged(s.scope.Name()) {
+		s.scope.Trace("Skipping transit gateway attachment deletion in unmanaged mode")
+		return nil
+	}
+
+	if s.scope.TransitGateway() == nil && s.scope.Network().TransitGatewayAttachmentID == "" {
+		s.scope.Trace("Skipping transit gateway attachment deletion, no transit gateway configured")
+		return nil
+	}
+
+	attachments, err := s.describeTransitGatewayAttachments()
+	if awserrors.IsNotFound(err) {
+		s.scope.Network().TransitGatewayAttachmentID = ""
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	for _, a := range attachments {
+		if aws.StringValue(a.State) == ec2.TransitGatewayAttachmentStateDeleting {
+			continue
+		}
+		if err := s.deleteTransitGatewayAttachment(a); err != nil {
+			return err
+		}
+	}
+
+	// The attachment keeps network interfaces in the cluster subnets until it is fully deleted,
+	// wait for it to be gone so that the subnets can be deleted afterwards.
+	if err := wait.WaitForWithRetryable(wait.NewBackoff(), func() (bool, error) {
+		if _, err := s.describeTransitGatewayAttachments(); awserrors.IsNotFound(err) {
+			return true, nil
+		} else if err != nil {
+			return false, err
+		}
+		return false, nil
+	}); err != nil {
+		return errors.Wrapf(err, "failed to wait for transit gateway attachments to be deleted in vpc %q", s.scope.VPC().ID)
+	}
+
+	s.scope.Network().TransitGatewayAttachmentID = ""
+	return nil
+}
+
+func (s *Service) createTransitGatewayAttachment(tgw *infrav1.TransitGatewaySpec) (*ec2.TransitGatewayVpcAttachment, error) {
+	out, err := s.EC2Client.CreateTransitGatewayVpcAttachment(&ec2.CreateTransitGatewayVpcAttachmentInput{
+		TransitGatewayId: aws.String(tgw.ID),
+		VpcId:            aws.String(s.scope.VPC().ID),
+		SubnetIds:        aws.StringSlice(s.getTransitGatewayAttachmentSubnetIDs(tgw)),
+		TagSpecifications: []*ec2.TagSpecification{
+			tags.BuildParamsToTagSpecification(ec2.ResourceTypeTransitGatewayAttachment, s.getTransitGatewayAttachmentTagParams(services.TemporaryResourceID)),
+		},
+	})
+	if err != nil {
+		record.Warnf(s.scope.InfraCluster(), "FailedCreateTransitGatewayAttachment", "Failed to attach VPC %q to Transit Gateway %q: %v", s.scope.VPC().ID, tgw.ID, err)
+		return nil, errors.Wrapf(err, "failed to attach vpc %q to transit gateway %q", s.scope.VPC().ID, tgw.ID)
+	}
+
+	record.Eventf(s.scope.InfraCluster(), "SuccessfulCreateTransitGatewayAttachment", "Created new managed Transit Gateway Attachment %q to Transit Gateway %q", *out.TransitGatewayVpcAttachment.TransitGatewayAttachmentId, tgw.ID)
+	s.scope.Info("Created transit gateway attachment", "transit-gateway-attachment-id", *out.TransitGatewayVpcAttachment.TransitGatewayAttachmentId, "transit-gateway-id", tgw.ID, "vpc-id", s.scope.VPC().ID)
+
+	return out.TransitGatewayVpcAttachment, nil
+}
+
+func (s *Service) updateTransitGatewayAttachment(tgw *infrav1.TransitGatewaySpec, attachment *ec2.TransitGatewayVpcAttachment) error {
+	// Subnets can only be changed on available attachments.
+	if aws.StringValue(attachment.State) == ec2.TransitGatewayAttachmentStateAvailable {
+		current := sets.NewString(aws.StringValueSlice(attachment.SubnetIds)...)
+		wanted := sets.NewString(s.getTransitGatewayAttachmentSubnetIDs(tgw)...)
+		if add, remove := wanted.Difference(current), current.Difference(wanted); add.Len() > 0 || remove.Len() > 0 {
+			input := &ec2.ModifyTransitGatewayVpcAttachmentInput{
+				TransitGatewayAttachmentId: attachment.TransitGatewayAttachmentId,
+			}
+			if add.Len() > 0 {
+				input.AddSubnetIds = aws.StringSlice(add.List())
+			}
+			if remove.Len() > 0 {
+				input.RemoveSubnetIds = aws.StringSlice(remove.List())
+			}
+			out, err := s.EC2Client.ModifyTransitGatewayVpcAttachment(input)
+			if err != nil {
+				record.Warnf(s.scope.InfraCluster(), "FailedModifyTransitGatewayAttachment", "Failed to modify managed Transit Gateway Attachment %q: %v", *attachment.TransitGatewayAttachmentId, err)
+				return errors.Wrapf(err, "failed to modify transit gateway attachment %q", *attachment.TransitGatewayAttachmentId)
+			}
+			record.Eventf(s.scope.InfraCluster(), "SuccessfulModifyTransitGatewayAttachment", "Modified managed Transit Gateway Attachment %q", *attachment.TransitGatewayAttachmentId)
+			if out.TransitGatewayVpcAttachment != nil {
+				attachment.State = out.TransitGatewayVpcAttachment.State
+			}
+		}
+	}
+
+	// Make sure tags are up to date.
+	if err := wait.WaitForWithRetryable(wait.NewBackoff(), func() (bool, error) {
+		buildParams := s.getTransitGatewayAttachmentTagParams(*attachment.TransitGatewayAttachmentId)
+		tagsBuilder := tags.New(&buildParams, tags.WithEC2(s.EC2Client))
+		if err := tagsBuilder.Ensure(converters.TagsToMap(attachment.Tags)); err != nil {
+			return false, err
+		}
+		return true, nil
+	}, awserrors.TransitGatewayAttachmentNotFound); err != nil {
+		record.Warnf(s.scope.InfraCluster(), "FailedTagTransitGatewayAttachment", "Failed to tag managed Transit Gateway Attachment %q: %v", *attachment.TransitGatewayAttachmentId, err)
+		return errors.Wrapf(err, "failed to tag transit gateway attachment %q", *attachment.TransitGatewayAttachmentId)
+	}
+
+	return nil
+}
+
+func (s *Service) deleteTransitGatewayAttachment(attachment *ec2.TransitGatewayVpcAttachment) error {
+	id := aws.StringValue(attachment.TransitGatewayAttachmentId)
+	if _, err := s.EC2Client.DeleteTransitGatewayVpcAttachment(&ec2.DeleteTransitGatewayVpcAttachmentInput{
+		TransitGatewayAttachmentId: aws.String(id),
+	}); err != nil {
+		if code, _ := awserrors.Code(err); code != awserrors.TransitGatewayAttachmentNotFound {
+			record.Warnf(s.scope.InfraCluster(), "FailedDeleteTransitGatewayAttachment", "Failed to delete managed Transit Gateway Attachment %q: %v", id, err)
+			return errors.Wrapf(err, "failed to delete transit gateway attachment %q", id)
+		}
+	}
+
+	record.Eventf(s.scope.InfraCluster(), "SuccessfulDeleteTransitGatewayAttachment", "Deleted managed Transit Gateway Attachment %q", id)
+	s.scope.Info("Deleted transit gateway attachment", "transit-gateway-attachment-id", id, "vpc-id", s.scope.VPC().ID)
+	return nil
+}
+
+// describeTransitGatewayAttachments returns the transit gateway attachments of the VPC owned by the cluster
+// that are not yet deleted.
+func (s *Service) describeTransitGatewayAttachments() ([]*ec2.TransitGatewayVpcAttachment, error) {
+	var attachments []*ec2.TransitGatewayVpcAttachment
+	if err := s.EC2Client.DescribeTransitGatewayVpcAttachmentsPages(&ec2.DescribeTransitGatewayVpcAttachmentsInput{
+		Filters: []*ec2.Filter{
+			filter.EC2.VPC(s.scope.VPC().ID),
+			filter.EC2.ClusterOwned(s.scope.Name()),
+			filter.EC2.TransitGatewayAttachmentStates(transitGatewayAttachmentStates...),
+		},
+	}, func(page *ec2.DescribeTransitGatewayVpcAttachmentsOutput, lastPage bool) bool {
+		attachments = append(attachments, page.TransitGatewayVpcAttachments...)
+		return !lastPage
+	}); err != nil {
+		record.Eventf(s.scope.InfraCluster(), "FailedDescribeTransitGatewayAttachments", "Failed to describe transit gateway attachments in vpc %q: %v", s.scope.VPC().ID, err)
+		return nil, errors.Wrapf(err, "failed to describe transit gateway attachments in vpc %q", s.scope.VPC().ID)
+	}
+
+	if len(attachments) == 0 {
+		return nil, awserrors.NewNotFound(fmt.Sprintf("no transit gateway attachments found in vpc %q", s.scope.VPC().ID))
+	}
+
+	return attachments, nil
+}
+
+func (s *Service) getTransitGatewayAttachmentSubnetIDs(tgw *infrav1.TransitGatewaySpec) []string {
+	if len(tgw.SubnetIDs) > 0 {
+		return tgw.SubnetIDs
+	}
+	return s.getPrivateSubnetIDsPerZone()
+}
+
+// getTransitGatewayRoutes returns the routes to the transit gateway to add to the private route tables.
+// They are only returned once the attachment is available.
+func (s *Service) getTransitGatewayRoutes() []*ec2.Route {
+	tgw := s.scope.TransitGateway()
+	if tgw == nil || s.scope.Network().TransitGatewayAttachmentID == "" ||
+		!conditions.IsTrue(s.scope.InfraCluster(), infrav1.TransitGatewayAttachmentReadyCondition) {
+		return nil
+	}
+
+	routes := make([]*ec2.Route, 0, len(tgw.RouteCIDRs))
+	for _, cidr := range tgw.RouteCIDRs {
+		routes = append(routes, &ec2.Route{
+			DestinationCidrBlock: aws.String(cidr),
+			TransitGatewayId:     aws.String(tgw.ID),
+		})
+	}
+	return routes
+}
+
+func (s *Service) getTransitGatewayAttachmentTagParams(id string) infrav1.BuildParams {
+	name := fmt.Sprintf("%s-tgw-attachment", s.scope.Name())
+
+	return infrav1.BuildParams{
+		ClusterName: s.scope.Name(),
+		ResourceID:  id,
+		Lifecycle:   infrav1.ResourceLifecycleOwned,
+		Name:        aws.String(name),
+		Role:        aws.String(infrav1.CommonRoleTagValue),
+		Additional:  s.scope.AdditionalTags(),
+	}
+}
@@ -0,0 +1,455 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/test/mocks"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+func describeTransitGatewayVpcAttachmentsPages(attachments ...*ec2.TransitGatewayVpcAttachment) func(_ *ec2.DescribeTransitGatewayVpcAttachmentsInput, fn func(*ec2.DescribeTransitGatewayVpcAttachmentsOutput, bool) bool) error {
+	return func(_ *ec2.DescribeTransitGatewayVpcAttachmentsInput, fn func(*ec2.DescribeTransitGatewayVpcAttachmentsOutput, bool) bool) error {
+		fn(&ec2.DescribeTransitGatewayVpcAttachmentsOutput{TransitGatewayVpcAttachments: attachments}, true)
+		return nil
+	}
+}
+
+func TestReconcileTransitGatewayAttachment(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	subnets := infrav1.Subnets{
+		{
+			ID:               "subnet-private-1a",
+			AvailabilityZone: "us-east-1a",
+		},
+		{
+			ID:               "subnet-private-1b",
+			AvailabilityZone: "us-east-1b",
+		},
+		{
+			ID:               "subnet-public-1a",
+			AvailabilityZone: "us-east-1a",
+			IsPublic:         true,
+		},
+	}
+
+	testCases := []struct {
+		name                 string
+		input                *infrav1.NetworkSpec
+		expect               func(m *mocks.MockEC2APIMockRecorder)
+		expectedAttachmentID string
+		expectedReady        bool
+		expectedReason       string
+		wantErr              bool
+	}{
+		{
+			name: "unmanaged vpc, skips reconciliation",
+			input: &infrav1.NetworkSpec{
+				VPC: infrav1.VPCSpec{
+					ID: "vpc-tgw",
+				},
+				Subnets: subnets,
+				TransitGateway: &infrav1.TransitGatewaySpec{
+					ID: "tgw-1",
+				},
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {},
+		},
+		{
+			name: "no transit gateway configured, skips reconciliation",
+			input: &infrav1.NetworkSpec{
+				VPC: infrav1.VPCSpec{
+					ID: "vpc-tgw",
+					Tags: infrav1.Tags{
+						infrav1.ClusterTagKey("test-cluster"): "owned",
+					},
+				},
+				Subnets: subnets,
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {},
+		},
+		{
+			name: "no attachment, creates one in the private subnets and waits for it to be available",
+			input: &infrav1.NetworkSpec{
+				VPC: infrav1.VPCSpec{
+					ID: "vpc-tgw",
+					Tags: infrav1.Tags{
+						infrav1.ClusterTagKey("test-cluster"): "owned",
+					},
+				},
+				Subnets: subnets,
+				TransitGateway: &infrav1.TransitGatewaySpec{
+					ID: "tgw-1",
+				},
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeTransitGatewayVpcAttachmentsPages(gomock.AssignableToTypeOf(&ec2.DescribeTransitGatewayVpcAttachmentsInput{}), gomock.Any()).
+					DoAndReturn(describeTransitGatewayVpcAttachmentsPages())
+				m.CreateTransitGatewayVpcAttachment(gomock.AssignableToTypeOf(&ec2.CreateTransitGatewayVpcAttachmentInput{})).
+					DoAndReturn(func(input *ec2.CreateTransitGatewayVpcAttachmentInput) (*ec2.CreateTransitGatewayVpcAttachmentOutput, error) {
+						g := NewWithT(t)
+						g.Expect(aws.StringValue(input.TransitGatewayId)).To(Equal("tgw-1"))
+						g.Expect(aws.StringValue(input.VpcId)).To(Equal("vpc-tgw"))
+						g.Expect(aws.StringValueSlice(input.SubnetIds)).To(Equal([]string{"subnet-private-1a", "subnet-private-1b"}))
+						g.Expect(input.TagSpecifications).To(HaveLen(1))
+						return &ec2.CreateTransitGatewayVpcAttachmentOutput{
+							TransitGatewayVpcAttachment: &ec2.TransitGatewayVpcAttachment{
+								TransitGatewayAttachmentId: aws.String("tgw-attach-1"),
+								TransitGatewayId:           aws.String("tgw-1"),
+								State:                      aws.String(ec2.TransitGatewayAttachmentStatePending),
+							},
+						}, nil
+					})
+			},
+			expectedAttachmentID: "tgw-attach-1",
+			expectedReason:       infrav1.WaitForTransitGatewayAttachmentReason,
+		},
+		{
+			name: "attachment to another transit gateway exists, replaces it",
+			input: &infrav1.NetworkSpec{
+				VPC: infrav1.VPCSpec{
+					ID: "vpc-tgw",
+					Tags: infrav1.Tags{
+						infrav1.ClusterTagKey("test-cluster"): "owned",
+					},
+				},
+				Subnets: subnets,
+				TransitGateway: &infrav1.TransitGatewaySpec{
+					ID:        "tgw-2",
+					SubnetIDs: []string{"subnet-private-1a"},
+				},
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeTransitGatewayVpcAttachmentsPages(gomock.AssignableToTypeOf(&ec2.DescribeTransitGatewayVpcAttachmentsInput{}), gomock.Any()).
+					DoAndReturn(describeTransitGatewayVpcAttachmentsPages(&ec2.TransitGatewayVpcAttachment{
+						TransitGatewayAttachmentId: aws.String("tgw-attach-1"),
+						TransitGatewayId:           aws.String("tgw-1"),
+						State:                      aws.String(ec2.TransitGatewayAttachmentStateAvailable),
+					}))
+				m.DeleteTransitGatewayVpcAttachment(gomock.Eq(&ec2.DeleteTransitGatewayVpcAttachmentInput{
+					TransitGatewayAttachmentId: aws.String("tgw-attach-1"),
+				})).Return(&ec2.DeleteTransitGatewayVpcAttachmentOutput{}, nil)
+				m.CreateTransitGatewayVpcAttachment(gomock.AssignableToTypeOf(&ec2.CreateTransitGatewayVpcAttachmentInput{})).
+					DoAndReturn(func(input *ec2.CreateTransitGatewayVpcAttachmentInput) (*ec2.CreateTransitGatewayVpcAttachmentOutput, error) {
+						g := NewWithT(t)
+						g.Expect(aws.StringValue(input.TransitGatewayId)).To(Equal("tgw-2"))
+						g.Expect(aws.StringValueSlice(input.SubnetIds)).To(Equal([]string{"subnet-private-1a"}))
+						return &ec2.CreateTransitGatewayVpcAttachmentOutput{
+							TransitGatewayVpcAttachment: &ec2.TransitGatewayVpcAttachment{
+								TransitGatewayAttachmentId: aws.String("tgw-attach-2"),
+								TransitGatewayId:           aws.String("tgw-2"),
+								State:                      aws.String(ec2.TransitGatewayAttachmentStateAvailable),
+							},
+						}, nil
+					})
+			},
+			expectedAttachmentID: "tgw-attach-2",
+			expectedReady:        true,
+		},
+		{
+			name: "attachment exists with outdated subnets, modifies it",
+			input: &infrav1.NetworkSpec{
+				VPC: infrav1.VPCSpec{
+					ID: "vpc-tgw",
+					Tags: infrav1.Tags{
+						infrav1.ClusterTagKey("test-cluster"): "owned",
+					},
+				},
+				Subnets: subnets,
+				TransitGateway: &infrav1.TransitGatewaySpec{
+					ID: "tgw-1",
+				},
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeTransitGatewayVpcAttachmentsPages(gomock.AssignableToTypeOf(&ec2.DescribeTransitGatewayVpcAttachmentsInput{}), gomock.Any()).
+					DoAndReturn(describeTransitGatewayVpcAttachmentsPages(&ec2.TransitGatewayVpcAttachment{
+						TransitGatewayAttachmentId: aws.String("tgw-attach-1"),
+						TransitGatewayId:           aws.String("tgw-1"),
+						SubnetIds:                  aws.StringSlice([]string{"subnet-private-1a"}),
+						State:                      aws.String(ec2.TransitGatewayAttachmentStateAvailable),
+					}))
+				m.ModifyTransitGatewayVpcAttachment(gomock.Eq(&ec2.ModifyTransitGatewayVpcAttachmentInput{
+					TransitGatewayAttachmentId: aws.String("tgw-attach-1"),
+					AddSubnetIds:               aws.StringSlice([]string{"subnet-private-1b"}),
+				})).Return(&ec2.ModifyTransitGatewayVpcAttachmentOutput{
+					TransitGatewayVpcAttachment: &ec2.TransitGatewayVpcAttachment{
+						TransitGatewayAttachmentId: aws.String("tgw-attach-1"),
+						State:                      aws.String(ec2.TransitGatewayAttachmentStateAvailable),
+					},
+				}, nil)
+				m.CreateTags(gomock.AssignableToTypeOf(&ec2.CreateTagsInput{})).
+					Return(nil, nil)
+			},
+			expectedAttachmentID: "tgw-attach-1",
+			expectedReady:        true,
+		},
+		{
+			name: "attachment pending acceptance, waits for it to be accepted",
+			input: &infrav1.NetworkSpec{
+				VPC: infrav1.VPCSpec{
+					ID: "vpc-tgw",
+					Tags: infrav1.Tags{
+						infrav1.ClusterTagKey("test-cluster"): "owned",
+					},
+				},
+				Subnets: subnets,
+				TransitGateway: &infrav1.TransitGatewaySpec{
+					ID: "tgw-1",
+				},
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeTransitGatewayVpcAttachmentsPages(gomock.AssignableToTypeOf(&ec2.DescribeTransitGatewayVpcAttachmentsInput{}), gomock.Any()).
+					DoAndReturn(describeTransitGatewayVpcAttachmentsPages(&ec2.TransitGatewayVpcAttachment{
+						TransitGatewayAttachmentId: aws.String("tgw-attach-1"),
+						TransitGatewayId:           aws.String("tgw-1"),
+						State:                      aws.String(ec2.TransitGatewayAttachmentStatePendingAcceptance),
+					}))
+				m.CreateTags(gomock.AssignableToTypeOf(&ec2.CreateTagsInput{})).
+					Return(nil, nil)
+			},
+			expectedAttachmentID: "tgw-attach-1",
+			expectedReason:       infrav1.WaitForTransitGatewayAttachmentReason,
+		},
+		{
+			name: "attachment rejected, returns error",
+			input: &infrav1.NetworkSpec{
+				VPC: infrav1.VPCSpec{
+					ID: "vpc-tgw",
+					Tags: infrav1.Tags{
+						infrav1.ClusterTagKey("test-cluster"): "owned",
+					},
+				},
+				Subnets: subnets,
+				TransitGateway: &infrav1.TransitGatewaySpec{
+					ID: "tgw-1",
+				},
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeTransitGatewayVpcAttachmentsPages(gomock.AssignableToTypeOf(&ec2.DescribeTransitGatewayVpcAttachmentsInput{}), gomock.Any()).
+					DoAndReturn(describeTransitGatewayVpcAttachmentsPages())
+				m.CreateTransitGatewayVpcAttachment(gomock.AssignableToTypeOf(&ec2.CreateTransitGatewayVpcAttachmentInput{})).
+					Return(&ec2.CreateTransitGatewayVpcAttachmentOutput{
+						TransitGatewayVpcAttachment: &ec2.TransitGatewayVpcAttachment{
+							TransitGatewayAttachmentId: aws.String("tgw-attach-1"),
+							TransitGatewayId:           aws.String("tgw-1"),
+							State:                      aws.String(ec2.TransitGatewayAttachmentStateRejected),
+						},
+					}, nil)
+			},
+			expectedAttachmentID: "tgw-attach-1",
+			wantErr:              true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			ec2Mock := mocks.NewMockEC2API(mockCtrl)
+
+			scope, err := getVPCEndpointsClusterScope(tc.input)
+			g.Expect(err).NotTo(HaveOccurred())
+
+			tc.expect(ec2Mock.EXPECT())
+
+			s := NewService(scope)
+			s.EC2Client = ec2Mock
+
+			err = s.reconcileTransitGatewayAttachment()
+			if tc.wantErr {
+				g.Expect(err).To(HaveOccurred())
+			} else {
+				g.Expect(err).NotTo(HaveOccurred())
+			}
+			g.Expect(scope.Network().TransitGatewayAttachmentID).To(Equal(tc.expectedAttachmentID))
+			g.Expect(conditions.IsTrue(scope.InfraCluster(), infrav1.TransitGatewayAttachmentReadyCondition)).To(Equal(tc.expectedReady))
+			if tc.expectedReason != "" {
+				g.Expect(conditions.GetReason(scope.InfraCluster(), infrav1.TransitGatewayAttachmentReadyCondition)).To(Equal(tc.expectedReason))
+			}
+		})
+	}
+}
+
+func TestDeleteTransitGatewayAttachments(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	testCases := []struct {
+		name   string
+		input  *infrav1.NetworkSpec
+		expect func(m *mocks.MockEC2APIMockRecorder)
+	}{
+		{
+			name: "Should ignore deletion if vpc is unmanaged",
+			input: &infrav1.NetworkSpec{
+				VPC: infrav1.VPCSpec{
+					ID: "vpc-tgw",
+				},
+				TransitGateway: &infrav1.TransitGatewaySpec{
+					ID: "tgw-1",
+				},
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {},
+		},
+		{
+			name: "Should delete the attachment and wait for it to be gone",
+			input: &infrav1.NetworkSpec{
+				VPC: infrav1.VPCSpec{
+					ID: "vpc-tgw",
+					Tags: infrav1.Tags{
+						infrav1.ClusterTagKey("test-cluster"): "owned",
+					},
+				},
+				TransitGateway: &infrav1.TransitGatewaySpec{
+					ID: "tgw-1",
+				},
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeTransitGatewayVpcAttachmentsPages(gomock.Eq(&ec2.DescribeTransitGatewayVpcAttachmentsInput{
+					Filters: []*ec2.Filter{
+						{
+							Name:   aws.String("vpc-id"),
+							Values: aws.StringSlice([]string{"vpc-tgw"}),
+						},
+						{
+							Name:   aws.String("tag:sigs.k8s.io/cluster-api-provider-aws/cluster/test-cluster"),
+							Values: aws.StringSlice([]string{"owned"}),
+						},
+						{
+							Name:   aws.String("state"),
+							Values: aws.StringSlice(transitGatewayAttachmentStates),
+						},
+					},
+				}), gomock.Any()).DoAndReturn(describeTransitGatewayVpcAttachmentsPages(&ec2.TransitGatewayVpcAttachment{
+					TransitGatewayAttachmentId: aws.String("tgw-attach-1"),
+					TransitGatewayId:           aws.String("tgw-1"),
+					State:                      aws.String(ec2.TransitGatewayAttachmentStateAvailable),
+				}))
+				m.DeleteTransitGatewayVpcAttachment(gomock.Eq(&ec2.DeleteTransitGatewayVpcAttachmentInput{
+					TransitGatewayAttachmentId: aws.String("tgw-attach-1"),
+				})).Return(&ec2.DeleteTransitGatewayVpcAttachmentOutput{}, nil)
+				m.DescribeTransitGatewayVpcAttachmentsPages(gomock.AssignableToTypeOf(&ec2.DescribeTransitGatewayVpcAttachmentsInput{}), gomock.Any()).
+					DoAndReturn(describeTransitGatewayVpcAttachmentsPages())
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			ec2Mock := mocks.NewMockEC2API(mockCtrl)
+
+			scope, err := getVPCEndpointsClusterScope(tc.input)
+			g.Expect(err).NotTo(HaveOccurred())
+
+			tc.expect(ec2Mock.EXPECT())
+
+			s := NewService(scope)
+			s.EC2Client = ec2Mock
+
+			g.Expect(s.deleteTransitGatewayAttachments()).To(Succeed())
+			g.Expect(scope.Network().TransitGatewayAttachmentID).To(BeEmpty())
+		})
+	}
+}
+
+func TestReconcileTransitGatewayRoutes(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	g := NewWithT(t)
+	ec2Mock := mocks.NewMockEC2API(mockCtrl)
+
+	scope, err := getVPCEndpointsClusterScope(&infrav1.NetworkSpec{
+		VPC: infrav1.VPCSpec{
+			ID: "vpc-tgw",
+			Tags: infrav1.Tags{
+				infrav1.ClusterTagKey("test-cluster"): "owned",
+			},
+		},
+		TransitGateway: &infrav1.TransitGatewaySpec{
+			ID:         "tgw-1",
+			RouteCIDRs: []string{"10.100.0.0/16", "10.200.0.0/16"},
+		},
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+	scope.Network().TransitGatewayAttachmentID = "tgw-attach-1"
+	scope.Network().AdditionalRoutes = []infrav1.RouteTableRoutes{
+		{
+			RouteTableID: "rtb-private",
+			Destinations: []string{"10.100.0.0/16", "172.16.0.0/12"},
+		},
+	}
+
+	s := NewService(scope)
+	s.EC2Client = ec2Mock
+
+	// Routes are skipped until the attachment is available.
+	conditions.MarkFalse(scope.InfraCluster(), infrav1.TransitGatewayAttachmentReadyCondition, infrav1.WaitForTransitGatewayAttachmentReason, clusterv1.ConditionSeverityInfo, "")
+	g.Expect(s.getTransitGatewayRoutes()).To(BeEmpty())
+	conditions.MarkTrue(scope.InfraCluster(), infrav1.TransitGatewayAttachmentReadyCondition)
+
+	rt := &ec2.RouteTable{
+		RouteTableId: aws.String("rtb-private"),
+		Routes: []*ec2.Route{
+			{
+				DestinationCidrBlock: aws.String("0.0.0.0/0"),
+				NatGatewayId:         aws.String("nat-01"),
+			},
+			{
+				DestinationCidrBlock: aws.String("10.100.0.0/16"),
+				TransitGatewayId:     aws.String("tgw-1"),
+			},
+			{
+				DestinationCidrBlock: aws.String("172.16.0.0/12"),
+				TransitGatewayId:     aws.String("tgw-1"),
+			},
+			{
+				// Not created by the provider, kept.
+				DestinationCidrBlock: aws.String("192.168.0.0/16"),
+				TransitGatewayId:     aws.String("tgw-1"),
+			},
+		},
+	}
+
+	ec2Mock.EXPECT().CreateRoute(gomock.Eq(&ec2.CreateRouteInput{
+		RouteTableId:         aws.String("rtb-private"),
+		DestinationCidrBlock: aws.String("10.200.0.0/16"),
+		TransitGatewayId:     aws.String("tgw-1"),
+	})).Return(&ec2.CreateRouteOutput{}, nil)
+	ec2Mock.EXPECT().DeleteRoute(gomock.Eq(&ec2.DeleteRouteInput{
+		RouteTableId:         aws.String("rtb-private"),
+		DestinationCidrBlock: aws.String("172.16.0.0/12"),
+	})).Return(&ec2.DeleteRouteOutput{}, nil)
+
+	g.Expect(s.reconcileAdditionalRoutes(s.getTransitGatewayRoutes(), rt)).To(Succeed())
+	g.Expect(scope.Network().AdditionalRoutes).To(Equal([]infrav1.RouteTableRoutes{
+		{
+			RouteTableID: "rtb-private",
+			Destinations: []string{"10.100.0.0/16", "10.200.0.0/16"},
+		},
+	}))
+}
@@ -211,10 +211,22 @@ func (s *Service) createVPC() (*infrav1.VPCSpec, error) {
 		input.AmazonProvidedIpv6CidrBlock = aws.Bool(s.scope.VPC().IsIPv6Enabled())
 	}
 
-	if s.scope.VPC().CidrBlock == "" {
-		s.scope.VPC().CidrBlock = defaultVPCCidr
+	// Allocate the IPv4 CIDR block from an IPAM pool if one is configured, the allocated
+	// CIDR block is stored in the spec once the VPC is created.
+	if pool := s.scope.VPC().IPAMPool; pool != nil {
+		poolID, err := s.getIPAMPoolID(pool)
+		if err != nil {
+			record.Warnf(s.scope.InfraCluster(), "FailedCreateVPC", "Failed to find IPAM pool for new managed VPC: %v", err)
+			return nil, errors.Wrap(err, "failed to get ipam pool")
+		}
+		input.Ipv4IpamPoolId = aws.String(poolID)
+		input.Ipv4NetmaskLength = aws.Int64(pool.GetNetmaskLength())
+	} else {
+		if s.scope.VPC().CidrBlock == "" {
+			s.scope.VPC().CidrBlock = defaultVPCCidr
+		}
+		input.CidrBlock = &s.scope.VPC().CidrBlock
 	}
-	input.CidrBlock = &s.scope.VPC().CidrBlock
 
 	out, err := s.EC2Client.CreateVpc(input)
 	if err != nil {
@@ -275,6 +287,31 @@ func (s *Service) createVPC() (*infrav1.VPCSpec, error) {
 	return nil, fmt.Errorf("no IPv6 associated CIDR block sets found for IPv6 enabled cluster with vpc id %s", *out.Vpc.VpcId)
 }
 
+// getIPAMPoolID returns the ID of the IPAM pool, looking it up by name if the ID is not set.
+func (s *Service) getIPAMPoolID(pool *infrav1.IPAMPool) (string, error) {
+	if pool.ID != "" {
+		return pool.ID, nil
+	}
+
+	out, err := s.EC2Client.DescribeIpamPools(&ec2.DescribeIpamPoolsInput{
+		Filters: []*ec2.Filter{
+			filter.EC2.Name(pool.Name),
+		},
+	})
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to describe ipam pool %q", pool.Name)
+	}
+
+	switch len(out.IpamPools) {
+	case 0:
+		return "", awserrors.NewNotFound(fmt.Sprintf("ipam pool %q not found", pool.Name))
+	case 1:
+		return aws.StringValue(out.IpamPools[0].IpamPoolId), nil
+	default:
+		return "", awserrors.NewConflict(fmt.Sprintf("found %d ipam pools named %q", len(out.IpamPools), pool.Name))
+	}
+}
+
 func (s *Service) deleteVPC() error {
 	vpc := s.scope.VPC()
 
@@ -229,6 +229,106 @@ func TestReconcileVPC(t *testing.T) {
 				m.ModifyVpcAttribute(gomock.AssignableToTypeOf(&ec2.ModifyVpcAttributeInput{})).Return(&ec2.ModifyVpcAttributeOutput{}, nil).Times(2)
 			},
 		},
+		{
+			name: "Should create a new VPC from an IPAM pool if managed vpc does not exist",
+			input: &infrav1.VPCSpec{
+				AvailabilityZoneUsageLimit: &usageLimit,
+				AvailabilityZoneSelection:  &selection,
+				IPAMPool:                   &infrav1.IPAMPool{ID: "ipam-pool-1"},
+			},
+			wantErr: false,
+			want: &infrav1.VPCSpec{
+				ID:        "vpc-new",
+				CidrBlock: "10.5.0.0/16",
+				IPAMPool:  &infrav1.IPAMPool{ID: "ipam-pool-1"},
+				Tags: map[string]string{
+					"sigs.k8s.io/cluster-api-provider-aws/role": "common",
+					"Name": "test-cluster-vpc",
+					"sigs.k8s.io/cluster-api-provider-aws/cluster/test-cluster": "owned",
+				},
+				AvailabilityZoneUsageLimit: &usageLimit,
+				AvailabilityZoneSelection:  &selection,
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.CreateVpc(gomock.AssignableToTypeOf(&ec2.CreateVpcInput{})).DoAndReturn(func(input *ec2.CreateVpcInput) (*ec2.CreateVpcOutput, error) {
+					if input.CidrBlock != nil || aws.StringValue(input.Ipv4IpamPoolId) != "ipam-pool-1" || aws.Int64Value(input.Ipv4NetmaskLength) != 16 {
+						t.Fatalf("unexpected CreateVpc input: %v", input)
+					}
+					return &ec2.CreateVpcOutput{
+						Vpc: &ec2.Vpc{
+							State:     aws.String("available"),
+							VpcId:     aws.String("vpc-new"),
+							CidrBlock: aws.String("10.5.0.0/16"),
+							Tags:      tags,
+						},
+					}, nil
+				})
+
+				m.DescribeVpcAttribute(gomock.AssignableToTypeOf(&ec2.DescribeVpcAttributeInput{})).
+					DoAndReturn(describeVpcAttributeTrue).AnyTimes()
+			},
+		},
+		{
+			name: "Should look up the IPAM pool by name when creating a new VPC",
+			input: &infrav1.VPCSpec{
+				AvailabilityZoneUsageLimit: &usageLimit,
+				AvailabilityZoneSelection:  &selection,
+				IPAMPool:                   &infrav1.IPAMPool{Name: "cluster-pool", NetmaskLength: 20},
+			},
+			wantErr: false,
+			want: &infrav1.VPCSpec{
+				ID:        "vpc-new",
+				CidrBlock: "10.5.16.0/20",
+				IPAMPool:  &infrav1.IPAMPool{Name: "cluster-pool", NetmaskLength: 20},
+				Tags: map[string]string{
+					"sigs.k8s.io/cluster-api-provider-aws/role": "common",
+					"Name": "test-cluster-vpc",
+					"sigs.k8s.io/cluster-api-provider-aws/cluster/test-cluster": "owned",
+				},
+				AvailabilityZoneUsageLimit: &usageLimit,
+				AvailabilityZoneSelection:  &selection,
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeIpamPools(gomock.Eq(&ec2.DescribeIpamPoolsInput{
+					Filters: []*ec2.Filter{
+						{
+							Name:   aws.String("tag:Name"),
+							Values: aws.StringSlice([]string{"cluster-pool"}),
+						},
+					},
+				})).Return(&ec2.DescribeIpamPoolsOutput{
+					IpamPools: []*ec2.IpamPool{
+						{
+							IpamPoolId: aws.String("ipam-pool-2"),
+						},
+					},
+				}, nil)
+				m.CreateVpc(gomock.AssignableToTypeOf(&ec2.CreateVpcInput{})).DoAndReturn(func(input *ec2.CreateVpcInput) (*ec2.CreateVpcOutput, error) {
+					if input.CidrBlock != nil || aws.StringValue(input.Ipv4IpamPoolId) != "ipam-pool-2" || aws.Int64Value(input.Ipv4NetmaskLength) != 20 {
+						t.Fatalf("unexpected CreateVpc input: %v", input)
+					}
+					return &ec2.CreateVpcOutput{
+						Vpc: &ec2.Vpc{
+							State:     aws.String("available"),
+							VpcId:     aws.String("vpc-new"),
+							CidrBlock: aws.String("10.5.16.0/20"),
+							Tags:      tags,
+						},
+					}, nil
+				})
+
+				m.DescribeVpcAttribute(gomock.AssignableToTypeOf(&ec2.DescribeVpcAttributeInput{})).
+					DoAndReturn(describeVpcAttributeTrue).AnyTimes()
+			},
+		},
+		{
+			name:    "Should return error if the IPAM pool cannot be found",
+			input:   &infrav1.VPCSpec{IPAMPool: &infrav1.IPAMPool{Name: "missing-pool"}},
+			wantErr: true,
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeIpamPools(gomock.AssignableToTypeOf(&ec2.DescribeIpamPoolsInput{})).Return(&ec2.DescribeIpamPoolsOutput{}, nil)
+			},
+		},
 		{
 			name: "Should create a new IPv6 VPC if managed IPv6 vpc does not exist",
 			input: &infrav1.VPCSpec{
@@ -0,0 +1,368 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/awserrors"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/converters"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/filter"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/wait"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/tags"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/record"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+func (s *Service) reconcileVPCEndpoints() error {
+	if s.scope.VPC().IsUnmanaged(s.scope.Name()) {
+		s.scope.Trace("Skipping VPC endpoints reconcile in unmanaged mode")
+		return nil
+	}
+
+	specs := s.scope.VPC().Endpoints
+	if len(specs) == 0 && len(s.scope.Network().VPCEndpoints) == 0 {
+		s.scope.Trace("Skipping VPC endpoints reconcile, no endpoints configured")
+		return nil
+	}
+
+	s.scope.Debug("Reconciling VPC endpoints")
+
+	existing, err := s.describeVPCEndpoints()
+	if err != nil && !awserrors.IsNotFound(err) {
+		return err
+	}
+
+	byServiceName := make(map[string]*ec2.VpcEndpoint, len(existing))
+	for _, ep := range existing {
+		byServiceName[aws.StringValue(ep.ServiceName)] = ep
+	}
+
+	statuses := make([]infrav1.VPCEndpoint, 0, len(specs))
+	desired := sets.NewString()
+	for i := range specs {
+		spec := &specs[i]
+		serviceName := s.getVPCEndpointServiceName(spec.ServiceName)
+		desired.Insert(serviceName)
+
+		ep, ok := byServiceName[serviceName]
+		if !ok {
+			ep, err = s.createVPCEndpoint(spec, serviceName)
+			if err != nil {
+				return err
+			}
+		} else if err := s.updateVPCEndpoint(spec, ep); err != nil {
+			return err
+		}
+
+		statuses = append(statuses, infrav1.VPCEndpoint{
+			ID:          aws.StringValue(ep.VpcEndpointId),
+			ServiceName: serviceName,
+			Type:        vpcEndpointType(spec),
+			State:       aws.StringValue(ep.State),
+		})
+	}
+
+	// Remove endpoints that have been removed from the spec.
+	var stale []*string
+	for serviceName, ep := range byServiceName {
+		if !desired.Has(serviceName) {
+			stale = append(stale, ep.VpcEndpointId)
+		}
+	}
+	if len(stale) > 0 {
+		if err := s.deleteVPCEndpointsByID(stale); err != nil {
+			return err
+		}
+	}
+
+	s.scope.Network().VPCEndpoints = statuses
+	conditions.MarkTrue(s.scope.InfraCluster(), infrav1.VpcEndpointsReadyCondition)
+	return nil
+}
+
+func (s *Service) deleteVPCEndpoints() error {
+	if s.scope.VPC().IsUnmanaged(s.scope.Name()) {
+		s.scope.Trace("Skipping VPC endpoints deletion in unmanaged mode")
+		return nil
+	}
+
+	if len(s.scope.VPC().Endpoints) == 0 && len(s.scope.Network().VPCEndpoints) == 0 {
+		s.scope.Trace("Skipping VPC endpoints deletion, no endpoints configured")
+		return nil
+	}
+
+	existing, err := s.describeVPCEndpoints()
+	if awserrors.IsNotFound(err) {
+		s.scope.Network().VPCEndpoints = nil
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	ids := make([]*string, 0, len(existing))
+	for _, ep := range existing {
+		ids = append(ids, ep.VpcEndpointId)
+	}
+
+	if err := s.deleteVPCEndpointsByID(ids); err != nil {
+		return err
+	}
+
+	// Interface endpoints keep network interfaces in the cluster subnets until they are fully deleted,
+	// wait for them to be gone so that the subnets can be deleted afterwards.
+	if err := wait.WaitForWithRetryable(wait.NewBackoff(), func() (bool, error) {
+		if _, err := s.describeVPCEndpoints(); awserrors.IsNotFound(err) {
+			return true, nil
+		} else if err != nil {
+			return false, err
+		}
+		return false, nil
+	}); err != nil {
+		return errors.Wrapf(err, "failed to wait for VPC endpoints to be deleted in vpc %q", s.scope.VPC().ID)
+	}
+
+	s.scope.Network().VPCEndpoints = nil
+	return nil
+}
+
+func (s *Service) createVPCEndpoint(spec *infrav1.VPCEndpointSpec, serviceName string) (*ec2.VpcEndpoint, error) {
+	input := &ec2.CreateVpcEndpointInput{
+		VpcId:           aws.String(s.scope.VPC().ID),
+		ServiceName:     aws.String(serviceName),
+		VpcEndpointType: aws.String(string(vpcEndpointType(spec))),
+		TagSpecifications: []*ec2.TagSpecification{
+			tags.BuildParamsToTagSpecification(ec2.ResourceTypeVpcEndpoint, s.getVPCEndpointTagParams(services.TemporaryResourceID, spec.ServiceName)),
+		},
+	}
+
+	if spec.IsGateway() {
+		input.RouteTableIds = aws.StringSlice(s.getVPCEndpointRouteTableIDs())
+	} else {
+		input.SubnetIds = aws.StringSlice(s.getVPCEndpointSubnetIDs(spec))
+		if len(spec.SecurityGroupIDs) > 0 {
+			input.SecurityGroupIds = aws.StringSlice(spec.SecurityGroupIDs)
+		}
+		input.PrivateDnsEnabled = aws.Bool(vpcEndpointPrivateDNSEnabled(spec))
+	}
+
+	out, err := s.EC2Client.CreateVpcEndpoint(input)
+	if err != nil {
+		record.Warnf(s.scope.InfraCluster(), "FailedCreateVPCEndpoint", "Failed to create new managed VPC endpoint for service %q: %v", serviceName, err)
+		return nil, errors.Wrapf(err, "failed to create VPC endpoint for service %q", serviceName)
+	}
+
+	record.Eventf(s.scope.InfraCluster(), "SuccessfulCreateVPCEndpoint", "Created new managed VPC endpoint %q for service %q", *out.VpcEndpoint.VpcEndpointId, serviceName)
+	s.scope.Info("Created VPC endpoint", "vpc-endpoint-id", *out.VpcEndpoint.VpcEndpointId, "service-name", serviceName, "vpc-id", s.scope.VPC().ID)
+
+	return out.VpcEndpoint, nil
+}
+
+func (s *Service) updateVPCEndpoint(spec *infrav1.VPCEndpointSpec, ep *ec2.VpcEndpoint) error {
+	input := &ec2.ModifyVpcEndpointInput{
+		VpcEndpointId: ep.VpcEndpointId,
+	}
+	changed := false
+
+	if spec.IsGateway() {
+		current := sets.NewString(aws.StringValueSlice(ep.RouteTableIds)...)
+		wanted := sets.NewString(s.getVPCEndpointRouteTableIDs()...)
+		if add := wanted.Difference(current); add.Len() > 0 {
+			input.AddRouteTableIds = aws.StringSlice(add.List())
+			changed = true
+		}
+		if remove := current.Difference(wanted); remove.Len() > 0 {
+			input.RemoveRouteTableIds = aws.StringSlice(remove.List())
+			changed = true
+		}
+	} else {
+		current := sets.NewString(aws.StringValueSlice(ep.SubnetIds)...)
+		wanted := sets.NewString(s.getVPCEndpointSubnetIDs(spec)...)
+		if add := wanted.Difference(current); add.Len() > 0 {
+			input.AddSubnetIds = aws.StringSlice(add.List())
+			changed = true
+		}
+		if remove := current.Difference(wanted); remove.Len() > 0 {
+			input.RemoveSubnetIds = aws.StringSlice(remove.List())
+			changed = true
+		}
+
+		// Only converge security groups when they have been set explicitly, otherwise the
+		// VPC default security group is attached by AWS.
+		if len(spec.SecurityGroupIDs) > 0 {
+			currentGroups := sets.NewString()
+			for _, group := range ep.Groups {
+				currentGroups.Insert(aws.StringValue(group.GroupId))
+			}
+			wantedGroups := sets.NewString(spec.SecurityGroupIDs...)
+			if add := wantedGroups.Difference(currentGroups); add.Len() > 0 {
+				input.AddSecurityGroupIds = aws.StringSlice(add.List())
+				changed = true
+			}
+			if remove := currentGroups.Difference(wantedGroups); remove.Len() > 0 {
+				input.RemoveSecurityGroupIds = aws.StringSlice(remove.List())
+				changed = true
+			}
+		}
+
+		if privateDNSEnabled := vpcEndpointPrivateDNSEnabled(spec); aws.BoolValue(ep.PrivateDnsEnabled) != privateDNSEnabled {
+			input.PrivateDnsEnabled = aws.Bool(privateDNSEnabled)
+			changed = true
+		}
+	}
+
+	if changed {
+		if _, err := s.EC2Client.ModifyVpcEndpoint(input); err != nil {
+			record.Warnf(s.scope.InfraCluster(), "FailedModifyVPCEndpoint", "Failed to modify managed VPC endpoint %q: %v", *ep.VpcEndpointId, err)
+			return errors.Wrapf(err, "failed to modify VPC endpoint %q", *ep.VpcEndpointId)
+		}
+		record.Eventf(s.scope.InfraCluster(), "SuccessfulModifyVPCEndpoint", "Modified managed VPC endpoint %q", *ep.VpcEndpointId)
+	}
+
+	// Make sure tags are up to date.
+	if err := wait.WaitForWithRetryable(wait.NewBackoff(), func() (bool, error) {
+		buildParams := s.getVPCEndpointTagParams(*ep.VpcEndpointId, spec.ServiceName)
+		tagsBuilder := tags.New(&buildParams, tags.WithEC2(s.EC2Client))
+		if err := tagsBuilder.Ensure(converters.TagsToMap(ep.Tags)); err != nil {
+			return false, err
+		}
+		return true, nil
+	}, awserrors.VPCEndpointNotFound); err != nil {
+		record.Warnf(s.scope.InfraCluster(), "FailedTagVPCEndpoint", "Failed to tag managed VPC endpoint %q: %v", *ep.VpcEndpointId, err)
+		return errors.Wrapf(err, "failed to tag VPC endpoint %q", *ep.VpcEndpointId)
+	}
+
+	return nil
+}
+
+func (s *Service) deleteVPCEndpointsByID(ids []*string) error {
+	out, err := s.EC2Client.DeleteVpcEndpoints(&ec2.DeleteVpcEndpointsInput{
+		VpcEndpointIds: ids,
+	})
+	if err != nil {
+		record.Warnf(s.scope.InfraCluster(), "FailedDeleteVPCEndpoints", "Failed to delete managed VPC endpoints in VPC %q: %v", s.scope.VPC().ID, err)
+		return errors.Wrapf(err, "failed to delete VPC endpoints in vpc %q", s.scope.VPC().ID)
+	}
+
+	for _, item := range out.Unsuccessful {
+		if item.Error != nil && aws.StringValue(item.Error.Code) == awserrors.VPCEndpointNotFound {
+			continue
+		}
+		record.Warnf(s.scope.InfraCluster(), "FailedDeleteVPCEndpoint", "Failed to delete managed VPC endpoint %q: %v", aws.StringValue(item.ResourceId), item.Error)
+		return errors.Errorf("failed to delete VPC endpoint %q: %v", aws.StringValue(item.ResourceId), item.Error)
+	}
+
+	for _, id := range ids {
+		record.Eventf(s.scope.InfraCluster(), "SuccessfulDeleteVPCEndpoint", "Deleted managed VPC endpoint %q", *id)
+		s.scope.Info("Deleted VPC endpoint", "vpc-endpoint-id", *id, "vpc-id", s.scope.VPC().ID)
+	}
+
+	return nil
+}
+
+// describeVPCEndpoints returns the VPC endpoints owned by the cluster that are not yet deleted.
+func (s *Service) describeVPCEndpoints() ([]*ec2.VpcEndpoint, error) {
+	var endpoints []*ec2.VpcEndpoint
+	if err := s.EC2Client.DescribeVpcEndpointsPages(&ec2.DescribeVpcEndpointsInput{
+		Filters: []*ec2.Filter{
+			filter.EC2.VPC(s.scope.VPC().ID),
+			filter.EC2.ClusterOwned(s.scope.Name()),
+		},
+	}, func(page *ec2.DescribeVpcEndpointsOutput, lastPage bool) bool {
+		for _, ep := range page.VpcEndpoints {
+			// DescribeVpcEndpoints reports states in lowercase.
+			if strings.EqualFold(aws.StringValue(ep.State), ec2.StateDeleted) {
+				continue
+			}
+			endpoints = append(endpoints, ep)
+		}
+		return !lastPage
+	}); err != nil {
+		record.Eventf(s.scope.InfraCluster(), "FailedDescribeVPCEndpoints", "Failed to describe VPC endpoints in vpc %q: %v", s.scope.VPC().ID, err)
+		return nil, errors.Wrapf(err, "failed to describe VPC endpoints in vpc %q", s.scope.VPC().ID)
+	}
+
+	if len(endpoints) == 0 {
+		return nil, awserrors.NewNotFound(fmt.Sprintf("no VPC endpoints found in vpc %q", s.scope.VPC().ID))
+	}
+
+	return endpoints, nil
+}
+
+// getVPCEndpointServiceName expands a short service name like "s3" to the regional service name.
+func (s *Service) getVPCEndpointServiceName(name string) string {
+	if strings.HasPrefix(name, "com.amazonaws.") || strings.HasPrefix(name, "cn.com.amazonaws.") {
+		return name
+	}
+	return fmt.Sprintf("com.amazonaws.%s.%s", s.scope.Region(), name)
+}
+
+// getVPCEndpointSubnetIDs returns the subnets of an interface endpoint. Interface endpoints only
+// accept a single subnet per availability zone, so by default the first private subnet of each zone is used.
+func (s *Service) getVPCEndpointSubnetIDs(spec *infrav1.VPCEndpointSpec) []string {
+	if len(spec.SubnetIDs) > 0 {
+		return spec.SubnetIDs
+	}
+
+	return s.getPrivateSubnetIDsPerZone()
+}
+
+// getVPCEndpointRouteTableIDs returns the route tables of the cluster subnets, used by gateway endpoints.
+func (s *Service) getVPCEndpointRouteTableIDs() []string {
+	ids := sets.NewString()
+	for _, sn := range s.scope.Subnets() {
+		if sn.RouteTableID != nil {
+			ids.Insert(*sn.RouteTableID)
+		}
+	}
+	return ids.List()
+}
+
+func (s *Service) getVPCEndpointTagParams(id, serviceName string) infrav1.BuildParams {
+	name := fmt.Sprintf("%s-vpce-%s", s.scope.Name(), serviceName)
+
+	return infrav1.BuildParams{
+		ClusterName: s.scope.Name(),
+		ResourceID:  id,
+		Lifecycle:   infrav1.ResourceLifecycleOwned,
+		Name:        aws.String(name),
+		Role:        aws.String(infrav1.CommonRoleTagValue),
+		Additional:  s.scope.AdditionalTags(),
+	}
+}
+
+func vpcEndpointType(spec *infrav1.VPCEndpointSpec) infrav1.VPCEndpointType {
+	if spec.Type == "" {
+		return infrav1.VPCEndpointTypeInterface
+	}
+	return spec.Type
+}
+
+func vpcEndpointPrivateDNSEnabled(spec *infrav1.VPCEndpointSpec) bool {
+	if spec.PrivateDNSEnabled == nil {
+		return true
+	}
+	return *spec.PrivateDNSEnabled
+}
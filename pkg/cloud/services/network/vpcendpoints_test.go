@@ -0,0 +1,410 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/test/mocks"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+)
+
+func describeVPCEndpointsPages(endpoints ...*ec2.VpcEndpoint) func(_ *ec2.DescribeVpcEndpointsInput, fn func(*ec2.DescribeVpcEndpointsOutput, bool) bool) error {
+	return func(_ *ec2.DescribeVpcEndpointsInput, fn func(*ec2.DescribeVpcEndpointsOutput, bool) bool) error {
+		fn(&ec2.DescribeVpcEndpointsOutput{VpcEndpoints: endpoints}, true)
+		return nil
+	}
+}
+
+func TestReconcileVPCEndpoints(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	subnets := infrav1.Subnets{
+		{
+			ID:               "subnet-private-1a",
+			AvailabilityZone: "us-east-1a",
+			RouteTableID:     aws.String("rtb-private-1a"),
+		},
+		{
+			ID:               "subnet-private-1a-extra",
+			AvailabilityZone: "us-east-1a",
+			RouteTableID:     aws.String("rtb-private-1a"),
+		},
+		{
+			ID:               "subnet-private-1b",
+			AvailabilityZone: "us-east-1b",
+			RouteTableID:     aws.String("rtb-private-1b"),
+		},
+		{
+			ID:               "subnet-public-1a",
+			AvailabilityZone: "us-east-1a",
+			IsPublic:         true,
+			RouteTableID:     aws.String("rtb-public"),
+		},
+	}
+
+	testCases := []struct {
+		name           string
+		input          *infrav1.NetworkSpec
+		expect         func(m *mocks.MockEC2APIMockRecorder)
+		expectedStatus []infrav1.VPCEndpoint
+	}{
+		{
+			name: "unmanaged vpc, skips reconciliation",
+			input: &infrav1.NetworkSpec{
+				VPC: infrav1.VPCSpec{
+					ID: "vpc-endpoints",
+					Endpoints: []infrav1.VPCEndpointSpec{
+						{ServiceName: "sts"},
+					},
+				},
+				Subnets: subnets,
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {},
+		},
+		{
+			name: "no endpoints configured, skips reconciliation",
+			input: &infrav1.NetworkSpec{
+				VPC: infrav1.VPCSpec{
+					ID: "vpc-endpoints",
+					Tags: infrav1.Tags{
+						infrav1.ClusterTagKey("test-cluster"): "owned",
+					},
+				},
+				Subnets: subnets,
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {},
+		},
+		{
+			name: "creates interface and gateway endpoints",
+			input: &infrav1.NetworkSpec{
+				VPC: infrav1.VPCSpec{
+					ID: "vpc-endpoints",
+					Tags: infrav1.Tags{
+						infrav1.ClusterTagKey("test-cluster"): "owned",
+					},
+					Endpoints: []infrav1.VPCEndpointSpec{
+						{
+							ServiceName:      "ecr.api",
+							Type:             infrav1.VPCEndpointTypeInterface,
+							SecurityGroupIDs: []string{"sg-endpoints"},
+						},
+						{
+							ServiceName: "s3",
+							Type:        infrav1.VPCEndpointTypeGateway,
+						},
+					},
+				},
+				Subnets: subnets,
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeVpcEndpointsPages(gomock.Any(), gomock.Any()).
+					DoAndReturn(describeVPCEndpointsPages())
+				m.CreateVpcEndpoint(gomock.AssignableToTypeOf(&ec2.CreateVpcEndpointInput{})).
+					DoAndReturn(func(input *ec2.CreateVpcEndpointInput) (*ec2.CreateVpcEndpointOutput, error) {
+						g := NewWithT(t)
+						g.Expect(aws.StringValue(input.ServiceName)).To(Equal("com.amazonaws.us-east-1.ecr.api"))
+						g.Expect(aws.StringValue(input.VpcEndpointType)).To(Equal("Interface"))
+						g.Expect(aws.StringValueSlice(input.SubnetIds)).To(Equal([]string{"subnet-private-1a", "subnet-private-1b"}))
+						g.Expect(aws.StringValueSlice(input.SecurityGroupIds)).To(Equal([]string{"sg-endpoints"}))
+						g.Expect(aws.BoolValue(input.PrivateDnsEnabled)).To(BeTrue())
+						g.Expect(input.TagSpecifications).To(HaveLen(1))
+						g.Expect(aws.StringValue(input.TagSpecifications[0].ResourceType)).To(Equal(ec2.ResourceTypeVpcEndpoint))
+						return &ec2.CreateVpcEndpointOutput{
+							VpcEndpoint: &ec2.VpcEndpoint{
+								VpcEndpointId: aws.String("vpce-ecr-api"),
+								ServiceName:   input.ServiceName,
+								State:         aws.String("pending"),
+							},
+						}, nil
+					})
+				m.CreateVpcEndpoint(gomock.AssignableToTypeOf(&ec2.CreateVpcEndpointInput{})).
+					DoAndReturn(func(input *ec2.CreateVpcEndpointInput) (*ec2.CreateVpcEndpointOutput, error) {
+						g := NewWithT(t)
+						g.Expect(aws.StringValue(input.ServiceName)).To(Equal("com.amazonaws.us-east-1.s3"))
+						g.Expect(aws.StringValue(input.VpcEndpointType)).To(Equal("Gateway"))
+						g.Expect(aws.StringValueSlice(input.RouteTableIds)).To(Equal([]string{"rtb-private-1a", "rtb-private-1b", "rtb-public"}))
+						g.Expect(input.SubnetIds).To(BeEmpty())
+						return &ec2.CreateVpcEndpointOutput{
+							VpcEndpoint: &ec2.VpcEndpoint{
+								VpcEndpointId: aws.String("vpce-s3"),
+								ServiceName:   input.ServiceName,
+								State:         aws.String("available"),
+							},
+						}, nil
+					})
+			},
+			expectedStatus: []infrav1.VPCEndpoint{
+				{
+					ID:          "vpce-ecr-api",
+					ServiceName: "com.amazonaws.us-east-1.ecr.api",
+					Type:        infrav1.VPCEndpointTypeInterface,
+					State:       "pending",
+				},
+				{
+					ID:          "vpce-s3",
+					ServiceName: "com.amazonaws.us-east-1.s3",
+					Type:        infrav1.VPCEndpointTypeGateway,
+					State:       "available",
+				},
+			},
+		},
+		{
+			name: "updates drifted endpoint and deletes endpoints removed from the spec",
+			input: &infrav1.NetworkSpec{
+				VPC: infrav1.VPCSpec{
+					ID: "vpc-endpoints",
+					Tags: infrav1.Tags{
+						infrav1.ClusterTagKey("test-cluster"): "owned",
+					},
+					Endpoints: []infrav1.VPCEndpointSpec{
+						{
+							ServiceName:       "com.amazonaws.us-east-1.sts",
+							PrivateDNSEnabled: aws.Bool(false),
+						},
+					},
+				},
+				Subnets: subnets,
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeVpcEndpointsPages(gomock.Any(), gomock.Any()).
+					DoAndReturn(describeVPCEndpointsPages(
+						&ec2.VpcEndpoint{
+							VpcEndpointId:     aws.String("vpce-sts"),
+							ServiceName:       aws.String("com.amazonaws.us-east-1.sts"),
+							State:             aws.String("available"),
+							SubnetIds:         aws.StringSlice([]string{"subnet-private-1a", "subnet-public-1a"}),
+							PrivateDnsEnabled: aws.Bool(true),
+						},
+						&ec2.VpcEndpoint{
+							VpcEndpointId: aws.String("vpce-ssm"),
+							ServiceName:   aws.String("com.amazonaws.us-east-1.ssm"),
+							State:         aws.String("available"),
+						},
+						&ec2.VpcEndpoint{
+							VpcEndpointId: aws.String("vpce-old"),
+							ServiceName:   aws.String("com.amazonaws.us-east-1.ec2"),
+							State:         aws.String("deleted"),
+						},
+					))
+				m.ModifyVpcEndpoint(gomock.Eq(&ec2.ModifyVpcEndpointInput{
+					VpcEndpointId:     aws.String("vpce-sts"),
+					AddSubnetIds:      aws.StringSlice([]string{"subnet-private-1b"}),
+					RemoveSubnetIds:   aws.StringSlice([]string{"subnet-public-1a"}),
+					PrivateDnsEnabled: aws.Bool(false),
+				})).Return(&ec2.ModifyVpcEndpointOutput{Return: aws.Bool(true)}, nil)
+				m.CreateTags(gomock.AssignableToTypeOf(&ec2.CreateTagsInput{})).
+					Return(nil, nil)
+				m.DeleteVpcEndpoints(gomock.Eq(&ec2.DeleteVpcEndpointsInput{
+					VpcEndpointIds: aws.StringSlice([]string{"vpce-ssm"}),
+				})).Return(&ec2.DeleteVpcEndpointsOutput{}, nil)
+			},
+			expectedStatus: []infrav1.VPCEndpoint{
+				{
+					ID:          "vpce-sts",
+					ServiceName: "com.amazonaws.us-east-1.sts",
+					Type:        infrav1.VPCEndpointTypeInterface,
+					State:       "available",
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			ec2Mock := mocks.NewMockEC2API(mockCtrl)
+
+			scope, err := getVPCEndpointsClusterScope(tc.input)
+			g.Expect(err).NotTo(HaveOccurred())
+
+			tc.expect(ec2Mock.EXPECT())
+
+			s := NewService(scope)
+			s.EC2Client = ec2Mock
+
+			g.Expect(s.reconcileVPCEndpoints()).To(Succeed())
+			g.Expect(scope.Network().VPCEndpoints).To(Equal(tc.expectedStatus))
+		})
+	}
+}
+
+func TestDeleteVPCEndpoints(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	testCases := []struct {
+		name    string
+		input   *infrav1.NetworkSpec
+		expect  func(m *mocks.MockEC2APIMockRecorder)
+		wantErr bool
+	}{
+		{
+			name: "Should ignore deletion if vpc is unmanaged",
+			input: &infrav1.NetworkSpec{
+				VPC: infrav1.VPCSpec{
+					ID: "vpc-endpoints",
+					Endpoints: []infrav1.VPCEndpointSpec{
+						{ServiceName: "sts"},
+					},
+				},
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {},
+		},
+		{
+			name: "Should ignore deletion if no endpoints are configured",
+			input: &infrav1.NetworkSpec{
+				VPC: infrav1.VPCSpec{
+					ID: "vpc-endpoints",
+					Tags: infrav1.Tags{
+						infrav1.ClusterTagKey("test-cluster"): "owned",
+					},
+				},
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {},
+		},
+		{
+			name: "Should delete endpoints and wait for them to be gone",
+			input: &infrav1.NetworkSpec{
+				VPC: infrav1.VPCSpec{
+					ID: "vpc-endpoints",
+					Tags: infrav1.Tags{
+						infrav1.ClusterTagKey("test-cluster"): "owned",
+					},
+					Endpoints: []infrav1.VPCEndpointSpec{
+						{ServiceName: "sts"},
+					},
+				},
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeVpcEndpointsPages(gomock.Eq(&ec2.DescribeVpcEndpointsInput{
+					Filters: []*ec2.Filter{
+						{
+							Name:   aws.String("vpc-id"),
+							Values: aws.StringSlice([]string{"vpc-endpoints"}),
+						},
+						{
+							Name:   aws.String("tag:sigs.k8s.io/cluster-api-provider-aws/cluster/test-cluster"),
+							Values: aws.StringSlice([]string{"owned"}),
+						},
+					},
+				}), gomock.Any()).DoAndReturn(describeVPCEndpointsPages(&ec2.VpcEndpoint{
+					VpcEndpointId: aws.String("vpce-sts"),
+					ServiceName:   aws.String("com.amazonaws.us-east-1.sts"),
+					State:         aws.String("available"),
+				}))
+				m.DeleteVpcEndpoints(gomock.Eq(&ec2.DeleteVpcEndpointsInput{
+					VpcEndpointIds: aws.StringSlice([]string{"vpce-sts"}),
+				})).Return(&ec2.DeleteVpcEndpointsOutput{}, nil)
+				m.DescribeVpcEndpointsPages(gomock.AssignableToTypeOf(&ec2.DescribeVpcEndpointsInput{}), gomock.Any()).
+					DoAndReturn(describeVPCEndpointsPages(&ec2.VpcEndpoint{
+						VpcEndpointId: aws.String("vpce-sts"),
+						ServiceName:   aws.String("com.amazonaws.us-east-1.sts"),
+						State:         aws.String("deleted"),
+					}))
+			},
+		},
+		{
+			name: "Should return error if endpoint deletion is unsuccessful",
+			input: &infrav1.NetworkSpec{
+				VPC: infrav1.VPCSpec{
+					ID: "vpc-endpoints",
+					Tags: infrav1.Tags{
+						infrav1.ClusterTagKey("test-cluster"): "owned",
+					},
+					Endpoints: []infrav1.VPCEndpointSpec{
+						{ServiceName: "sts"},
+					},
+				},
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeVpcEndpointsPages(gomock.AssignableToTypeOf(&ec2.DescribeVpcEndpointsInput{}), gomock.Any()).
+					DoAndReturn(describeVPCEndpointsPages(&ec2.VpcEndpoint{
+						VpcEndpointId: aws.String("vpce-sts"),
+						ServiceName:   aws.String("com.amazonaws.us-east-1.sts"),
+						State:         aws.String("available"),
+					}))
+				m.DeleteVpcEndpoints(gomock.AssignableToTypeOf(&ec2.DeleteVpcEndpointsInput{})).
+					Return(&ec2.DeleteVpcEndpointsOutput{
+						Unsuccessful: []*ec2.UnsuccessfulItem{
+							{
+								ResourceId: aws.String("vpce-sts"),
+								Error: &ec2.UnsuccessfulItemError{
+									Code:    aws.String("DependencyViolation"),
+									Message: aws.String("dependency violation"),
+								},
+							},
+						},
+					}, nil)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			ec2Mock := mocks.NewMockEC2API(mockCtrl)
+
+			scope, err := getVPCEndpointsClusterScope(tc.input)
+			g.Expect(err).NotTo(HaveOccurred())
+
+			tc.expect(ec2Mock.EXPECT())
+
+			s := NewService(scope)
+			s.EC2Client = ec2Mock
+
+			err = s.deleteVPCEndpoints()
+			if tc.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(scope.Network().VPCEndpoints).To(BeEmpty())
+		})
+	}
+}
+
+func getVPCEndpointsClusterScope(networkSpec *infrav1.NetworkSpec) (*scope.ClusterScope, error) {
+	scheme := runtime.NewScheme()
+	_ = infrav1.AddToScheme(scheme)
+	client := fake.NewClientBuilder().WithScheme(scheme).Build()
+	return scope.NewClusterScope(scope.ClusterScopeParams{
+		Client: client,
+		Cluster: &clusterv1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-cluster"},
+		},
+		AWSCluster: &infrav1.AWSCluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "test"},
+			Spec: infrav1.AWSClusterSpec{
+				Region:      "us-east-1",
+				NetworkSpec: *networkSpec,
+			},
+		},
+	})
+}
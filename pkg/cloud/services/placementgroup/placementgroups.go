@@ -0,0 +1,204 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package placementgroup
+
+import (
+	"sort"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/pkg/errors"
+	kerrors "k8s.io/apimachinery/pkg/util/errors"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/awserrors"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/filter"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/tags"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/record"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+// ReconcilePlacementGroups creates the placement groups declared in the cluster spec, and deletes the
+// placement groups created for the cluster that are no longer declared.
+func (s *Service) ReconcilePlacementGroups() error {
+	if len(s.scope.PlacementGroups()) == 0 && len(s.scope.ManagedPlacementGroups()) == 0 {
+		s.scope.Trace("No placement groups declared, skipping reconciliation")
+		return nil
+	}
+
+	s.scope.Debug("Reconciling placement groups")
+
+	existing, err := s.describeClusterOwnedPlacementGroups()
+	if err != nil {
+		return err
+	}
+
+	managed := []string{}
+	desired := map[string]struct{}{}
+	for _, group := range s.scope.PlacementGroups() {
+		desired[group.Name] = struct{}{}
+		if _, ok := existing[group.Name]; !ok {
+			if err := s.createPlacementGroup(group); err != nil {
+				return err
+			}
+		}
+		managed = append(managed, group.Name)
+	}
+
+	// Placement groups removed from the spec can only be deleted once no instances are running in them.
+	// Keep tracking them until they are gone, so deletion is retried on the next reconciliation.
+	for _, name := range s.scope.ManagedPlacementGroups() {
+		if _, ok := desired[name]; ok {
+			continue
+		}
+		if _, ok := existing[name]; !ok {
+			continue
+		}
+		if err := s.deletePlacementGroup(name); err != nil {
+			if code, _ := awserrors.Code(errors.Cause(err)); code == awserrors.PlacementGroupInUse {
+				s.scope.Info("Placement group is still in use, deletion will be retried", "placement-group", name)
+				managed = append(managed, name)
+				continue
+			}
+			return err
+		}
+	}
+
+	s.scope.SetManagedPlacementGroups(managed)
+	conditions.MarkTrue(s.scope.InfraCluster(), infrav1.PlacementGroupsReadyCondition)
+
+	return nil
+}
+
+// DeletePlacementGroups deletes all the placement groups owned by the cluster, including the ones
+// missing from the status, for instance when it was lost or not persisted after their creation.
+func (s *Service) DeletePlacementGroups() error {
+	existing, err := s.describeClusterOwnedPlacementGroups()
+	if err != nil {
+		return err
+	}
+
+	if len(existing) == 0 {
+		s.scope.Trace("No placement groups owned by the cluster, skipping deletion")
+		s.scope.SetManagedPlacementGroups(nil)
+		return nil
+	}
+
+	conditions.MarkFalse(s.scope.InfraCluster(), infrav1.PlacementGroupsReadyCondition, clusterv1.DeletingReason, clusterv1.ConditionSeverityInfo, "")
+
+	var errs []error
+	remaining := []string{}
+	names := make([]string, 0, len(existing))
+	for name := range existing {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if err := s.deletePlacementGroup(name); err != nil {
+			errs = append(errs, err)
+			remaining = append(remaining, name)
+		}
+	}
+	s.scope.SetManagedPlacementGroups(remaining)
+
+	if len(errs) > 0 {
+		err := kerrors.NewAggregate(errs)
+		conditions.MarkFalse(s.scope.InfraCluster(), infrav1.PlacementGroupsReadyCondition, "DeletingFailed", clusterv1.ConditionSeverityWarning, err.Error())
+		return err
+	}
+	conditions.MarkFalse(s.scope.InfraCluster(), infrav1.PlacementGroupsReadyCondition, clusterv1.DeletedReason, clusterv1.ConditionSeverityInfo, "")
+
+	return nil
+}
+
+// describeClusterOwnedPlacementGroups returns the placement groups owned by the cluster, keyed by name.
+// Placement groups being deleted are left out.
+func (s *Service) describeClusterOwnedPlacementGroups() (map[string]*ec2.PlacementGroup, error) {
+	input := &ec2.DescribePlacementGroupsInput{
+		Filters: []*ec2.Filter{
+			filter.EC2.ClusterOwned(s.scope.Name()),
+		},
+	}
+
+	out, err := s.EC2Client.DescribePlacementGroups(input)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to describe placement groups for cluster %q", s.scope.Name())
+	}
+
+	groups := make(map[string]*ec2.PlacementGroup, len(out.PlacementGroups))
+	for _, group := range out.PlacementGroups {
+		switch aws.StringValue(group.State) {
+		case ec2.PlacementGroupStateDeleting, ec2.PlacementGroupStateDeleted:
+			continue
+		}
+		groups[aws.StringValue(group.GroupName)] = group
+	}
+
+	return groups, nil
+}
+
+func (s *Service) createPlacementGroup(group infrav1.PlacementGroup) error {
+	input := &ec2.CreatePlacementGroupInput{
+		GroupName:      aws.String(group.Name),
+		Strategy:       aws.String(string(group.Strategy)),
+		PartitionCount: group.PartitionCount,
+		TagSpecifications: []*ec2.TagSpecification{
+			tags.BuildParamsToTagSpecification(ec2.ResourceTypePlacementGroup, s.getPlacementGroupTagParams(group.Name)),
+		},
+	}
+
+	out, err := s.EC2Client.CreatePlacementGroup(input)
+	if err != nil {
+		record.Warnf(s.scope.InfraCluster(), "FailedCreatePlacementGroup", "Failed to create placement group %q: %v", group.Name, err)
+		return errors.Wrapf(err, "failed to create placement group %q", group.Name)
+	}
+
+	record.Eventf(s.scope.InfraCluster(), "SuccessfulCreatePlacementGroup", "Created placement group %q with strategy %q", group.Name, group.Strategy)
+	s.scope.Info("Created placement group", "placement-group", group.Name, "placement-group-id", aws.StringValue(out.PlacementGroup.GroupId))
+
+	return nil
+}
+
+func (s *Service) deletePlacementGroup(name string) error {
+	input := &ec2.DeletePlacementGroupInput{
+		GroupName: aws.String(name),
+	}
+
+	if _, err := s.EC2Client.DeletePlacementGroup(input); err != nil {
+		if code, _ := awserrors.Code(err); code == awserrors.PlacementGroupNotFound {
+			return nil
+		}
+		record.Warnf(s.scope.InfraCluster(), "FailedDeletePlacementGroup", "Failed to delete placement group %q: %v", name, err)
+		return errors.Wrapf(err, "failed to delete placement group %q", name)
+	}
+
+	record.Eventf(s.scope.InfraCluster(), "SuccessfulDeletePlacementGroup", "Deleted placement group %q", name)
+	s.scope.Info("Deleted placement group", "placement-group", name)
+
+	return nil
+}
+
+func (s *Service) getPlacementGroupTagParams(name string) infrav1.BuildParams {
+	return infrav1.BuildParams{
+		ClusterName: s.scope.Name(),
+		Lifecycle:   infrav1.ResourceLifecycleOwned,
+		Name:        aws.String(name),
+		Role:        aws.String(infrav1.CommonRoleTagValue),
+		Additional:  s.scope.AdditionalTags(),
+	}
+}
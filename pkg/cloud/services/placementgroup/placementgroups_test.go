@@ -0,0 +1,288 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package placementgroup
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/awserrors"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/filter"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/test/mocks"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+func TestReconcilePlacementGroups(t *testing.T) {
+	describeInput := &ec2.DescribePlacementGroupsInput{
+		Filters: []*ec2.Filter{filter.EC2.ClusterOwned("test-cluster")},
+	}
+
+	testCases := []struct {
+		name            string
+		placementGroups []infrav1.PlacementGroup
+		managed         []string
+		expect          func(m *mocks.MockEC2APIMockRecorder)
+		expectErr       bool
+		expectManaged   []string
+	}{
+		{
+			name: "does nothing when no placement groups are declared or managed",
+		},
+		{
+			name: "creates missing placement groups",
+			placementGroups: []infrav1.PlacementGroup{
+				{Name: "pg-cluster", Strategy: infrav1.PlacementGroupStrategyCluster},
+				{Name: "pg-partition", Strategy: infrav1.PlacementGroupStrategyPartition, PartitionCount: aws.Int64(3)},
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribePlacementGroups(gomock.Eq(describeInput)).Return(&ec2.DescribePlacementGroupsOutput{
+					PlacementGroups: []*ec2.PlacementGroup{
+						{GroupName: aws.String("pg-cluster"), Strategy: aws.String("cluster"), State: aws.String(ec2.PlacementGroupStateAvailable)},
+					},
+				}, nil)
+				m.CreatePlacementGroup(gomock.Eq(&ec2.CreatePlacementGroupInput{
+					GroupName:      aws.String("pg-partition"),
+					Strategy:       aws.String("partition"),
+					PartitionCount: aws.Int64(3),
+					TagSpecifications: []*ec2.TagSpecification{
+						{
+							ResourceType: aws.String("placement-group"),
+							Tags: []*ec2.Tag{
+								{Key: aws.String("Name"), Value: aws.String("pg-partition")},
+								{Key: aws.String("sigs.k8s.io/cluster-api-provider-aws/cluster/test-cluster"), Value: aws.String("owned")},
+								{Key: aws.String("sigs.k8s.io/cluster-api-provider-aws/role"), Value: aws.String("common")},
+							},
+						},
+					},
+				})).Return(&ec2.CreatePlacementGroupOutput{
+					PlacementGroup: &ec2.PlacementGroup{GroupId: aws.String("pg-1234"), GroupName: aws.String("pg-partition")},
+				}, nil)
+			},
+			expectManaged: []string{"pg-cluster", "pg-partition"},
+		},
+		{
+			name: "recreates a placement group that is being deleted",
+			placementGroups: []infrav1.PlacementGroup{
+				{Name: "pg-spread", Strategy: infrav1.PlacementGroupStrategySpread},
+			},
+			managed: []string{"pg-spread"},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribePlacementGroups(gomock.Eq(describeInput)).Return(&ec2.DescribePlacementGroupsOutput{
+					PlacementGroups: []*ec2.PlacementGroup{
+						{GroupName: aws.String("pg-spread"), Strategy: aws.String("spread"), State: aws.String(ec2.PlacementGroupStateDeleting)},
+					},
+				}, nil)
+				m.CreatePlacementGroup(gomock.Any()).Return(&ec2.CreatePlacementGroupOutput{
+					PlacementGroup: &ec2.PlacementGroup{GroupId: aws.String("pg-1234"), GroupName: aws.String("pg-spread")},
+				}, nil)
+			},
+			expectManaged: []string{"pg-spread"},
+		},
+		{
+			name: "deletes placement groups removed from the spec",
+			placementGroups: []infrav1.PlacementGroup{
+				{Name: "pg-cluster", Strategy: infrav1.PlacementGroupStrategyCluster},
+			},
+			managed: []string{"pg-cluster", "pg-spread"},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribePlacementGroups(gomock.Eq(describeInput)).Return(&ec2.DescribePlacementGroupsOutput{
+					PlacementGroups: []*ec2.PlacementGroup{
+						{GroupName: aws.String("pg-cluster"), State: aws.String(ec2.PlacementGroupStateAvailable)},
+						{GroupName: aws.String("pg-spread"), State: aws.String(ec2.PlacementGroupStateAvailable)},
+					},
+				}, nil)
+				m.DeletePlacementGroup(gomock.Eq(&ec2.DeletePlacementGroupInput{GroupName: aws.String("pg-spread")})).
+					Return(&ec2.DeletePlacementGroupOutput{}, nil)
+			},
+			expectManaged: []string{"pg-cluster"},
+		},
+		{
+			name:    "keeps tracking removed placement groups that are still in use",
+			managed: []string{"pg-spread"},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribePlacementGroups(gomock.Eq(describeInput)).Return(&ec2.DescribePlacementGroupsOutput{
+					PlacementGroups: []*ec2.PlacementGroup{
+						{GroupName: aws.String("pg-spread"), State: aws.String(ec2.PlacementGroupStateAvailable)},
+					},
+				}, nil)
+				m.DeletePlacementGroup(gomock.Eq(&ec2.DeletePlacementGroupInput{GroupName: aws.String("pg-spread")})).
+					Return(nil, awserr.New(awserrors.PlacementGroupInUse, "placement group is in use", nil))
+			},
+			expectManaged: []string{"pg-spread"},
+		},
+		{
+			name: "returns an error when a placement group cannot be created",
+			placementGroups: []infrav1.PlacementGroup{
+				{Name: "pg-cluster", Strategy: infrav1.PlacementGroupStrategyCluster},
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribePlacementGroups(gomock.Eq(describeInput)).Return(&ec2.DescribePlacementGroupsOutput{}, nil)
+				m.CreatePlacementGroup(gomock.Any()).
+					Return(nil, awserr.New("InvalidPlacementGroup.Duplicate", "placement group already exists", nil))
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			ec2Mock := mocks.NewMockEC2API(mockCtrl)
+
+			cs := newTestClusterScope(t)
+			cs.AWSCluster.Spec.PlacementGroups = tc.placementGroups
+			cs.AWSCluster.Status.PlacementGroups = tc.managed
+			if tc.expect != nil {
+				tc.expect(ec2Mock.EXPECT())
+			}
+
+			s := NewService(cs)
+			s.EC2Client = ec2Mock
+
+			err := s.ReconcilePlacementGroups()
+			if tc.expectErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(cs.ManagedPlacementGroups()).To(Equal(tc.expectManaged))
+		})
+	}
+}
+
+func TestDeletePlacementGroups(t *testing.T) {
+	describeInput := &ec2.DescribePlacementGroupsInput{
+		Filters: []*ec2.Filter{filter.EC2.ClusterOwned("test-cluster")},
+	}
+
+	testCases := []struct {
+		name          string
+		managed       []string
+		expect        func(m *mocks.MockEC2APIMockRecorder)
+		expectErr     bool
+		expectManaged []string
+	}{
+		{
+			name: "does nothing when the cluster owns no placement groups",
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribePlacementGroups(gomock.Eq(describeInput)).Return(&ec2.DescribePlacementGroupsOutput{}, nil)
+			},
+		},
+		{
+			name:    "deletes the managed placement groups that still exist",
+			managed: []string{"pg-cluster", "pg-spread"},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribePlacementGroups(gomock.Eq(describeInput)).Return(&ec2.DescribePlacementGroupsOutput{
+					PlacementGroups: []*ec2.PlacementGroup{
+						{GroupName: aws.String("pg-cluster"), State: aws.String(ec2.PlacementGroupStateAvailable)},
+					},
+				}, nil)
+				m.DeletePlacementGroup(gomock.Eq(&ec2.DeletePlacementGroupInput{GroupName: aws.String("pg-cluster")})).
+					Return(&ec2.DeletePlacementGroupOutput{}, nil)
+			},
+			expectManaged: []string{},
+		},
+		{
+			name: "deletes the placement groups owned by the cluster that are missing from the status",
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribePlacementGroups(gomock.Eq(describeInput)).Return(&ec2.DescribePlacementGroupsOutput{
+					PlacementGroups: []*ec2.PlacementGroup{
+						{GroupName: aws.String("pg-untracked"), State: aws.String(ec2.PlacementGroupStateAvailable)},
+						{GroupName: aws.String("pg-deleting"), State: aws.String(ec2.PlacementGroupStateDeleting)},
+					},
+				}, nil)
+				m.DeletePlacementGroup(gomock.Eq(&ec2.DeletePlacementGroupInput{GroupName: aws.String("pg-untracked")})).
+					Return(&ec2.DeletePlacementGroupOutput{}, nil)
+			},
+			expectManaged: []string{},
+		},
+		{
+			name:    "returns an error when a placement group is still in use",
+			managed: []string{"pg-cluster"},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribePlacementGroups(gomock.Eq(describeInput)).Return(&ec2.DescribePlacementGroupsOutput{
+					PlacementGroups: []*ec2.PlacementGroup{
+						{GroupName: aws.String("pg-cluster"), State: aws.String(ec2.PlacementGroupStateAvailable)},
+					},
+				}, nil)
+				m.DeletePlacementGroup(gomock.Eq(&ec2.DeletePlacementGroupInput{GroupName: aws.String("pg-cluster")})).
+					Return(nil, awserr.New(awserrors.PlacementGroupInUse, "placement group is in use", nil))
+			},
+			expectErr:     true,
+			expectManaged: []string{"pg-cluster"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			ec2Mock := mocks.NewMockEC2API(mockCtrl)
+
+			cs := newTestClusterScope(t)
+			cs.AWSCluster.Status.PlacementGroups = tc.managed
+			if tc.expect != nil {
+				tc.expect(ec2Mock.EXPECT())
+			}
+
+			s := NewService(cs)
+			s.EC2Client = ec2Mock
+
+			err := s.DeletePlacementGroups()
+			if tc.expectErr {
+				g.Expect(err).To(HaveOccurred())
+				g.Expect(conditions.GetReason(cs.AWSCluster, infrav1.PlacementGroupsReadyCondition)).To(Equal("DeletingFailed"))
+			} else {
+				g.Expect(err).NotTo(HaveOccurred())
+			}
+			g.Expect(cs.ManagedPlacementGroups()).To(Equal(tc.expectManaged))
+		})
+	}
+}
+
+func newTestClusterScope(t *testing.T) *scope.ClusterScope {
+	t.Helper()
+
+	scheme := runtime.NewScheme()
+	_ = infrav1.AddToScheme(scheme)
+
+	cs, err := scope.NewClusterScope(scope.ClusterScopeParams{
+		Client: fake.NewClientBuilder().WithScheme(scheme).Build(),
+		Cluster: &clusterv1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-cluster"},
+		},
+		AWSCluster: &infrav1.AWSCluster{},
+	})
+	if err != nil {
+		t.Fatalf("Failed to create test context: %v", err)
+	}
+	return cs
+}
@@ -0,0 +1,39 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package placementgroup
+
+import (
+	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
+
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+)
+
+// Service holds a collection of interfaces.
+// The interfaces are broken down like this to group functions together.
+// One alternative is to have a large list of functions from the ec2 client.
+type Service struct {
+	scope     scope.PlacementGroupScope
+	EC2Client ec2iface.EC2API
+}
+
+// NewService returns a new service given the api clients.
+func NewService(placementGroupScope scope.PlacementGroupScope) *Service {
+	return &Service{
+		scope:     placementGroupScope,
+		EC2Client: scope.NewEC2Client(placementGroupScope, placementGroupScope, placementGroupScope, placementGroupScope.InfraCluster()),
+	}
+}
@@ -0,0 +1,374 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package securitygroup
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/pkg/errors"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/awserrors"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/filter"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/wait"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/record"
+)
+
+// securityGroupRules holds the rules of a security group, as reported by DescribeSecurityGroupRules.
+type securityGroupRules struct {
+	ingress []*ec2.SecurityGroupRule
+	egress  []*ec2.SecurityGroupRule
+}
+
+// securityGroupRuleChanges are the changes that make the rules of one direction of a security group
+// match the desired rules. Every rule has a single source or destination.
+type securityGroupRuleChanges struct {
+	toAuthorize infrav1.IngressRules
+	toModify    []*ec2.SecurityGroupRuleUpdate
+	toRevoke    []string
+}
+
+func (c *securityGroupRuleChanges) empty() bool {
+	return len(c.toAuthorize) == 0 && len(c.toModify) == 0 && len(c.toRevoke) == 0
+}
+
+// describeSecurityGroupRules returns the rules of the security groups, keyed by security group id.
+func (s *Service) describeSecurityGroupRules(groupIDs []string) (map[string]*securityGroupRules, error) {
+	res := make(map[string]*securityGroupRules, len(groupIDs))
+	if len(groupIDs) == 0 {
+		return res, nil
+	}
+
+	input := &ec2.DescribeSecurityGroupRulesInput{
+		Filters: []*ec2.Filter{
+			filter.EC2.SecurityGroupIDs(groupIDs...),
+		},
+	}
+
+	err := s.EC2Client.DescribeSecurityGroupRulesPages(input, func(out *ec2.DescribeSecurityGroupRulesOutput, last bool) bool {
+		for _, rule := range out.SecurityGroupRules {
+			groupID := aws.StringValue(rule.GroupId)
+			if res[groupID] == nil {
+				res[groupID] = &securityGroupRules{}
+			}
+			if aws.BoolValue(rule.IsEgress) {
+				res[groupID].egress = append(res[groupID].egress, rule)
+			} else {
+				res[groupID].ingress = append(res[groupID].ingress, rule)
+			}
+		}
+		return true
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to describe rules of security groups %v", groupIDs)
+	}
+
+	return res, nil
+}
+
+// reconcileSecurityGroupRules makes the rules of one direction of a security group match the desired rules.
+// Missing rules are authorized first, then the rules whose description changed are modified in place, and
+// finally the rules that are no longer desired are revoked, so that the allowed traffic is never interrupted.
+// Undesired rules are only revoked when revoke is true.
+func (s *Service) reconcileSecurityGroupRules(groupID string, egress bool, current []*ec2.SecurityGroupRule, want infrav1.IngressRules, revoke bool) error {
+	changes := diffSecurityGroupRules(current, want)
+	if !revoke {
+		changes.toRevoke = nil
+	}
+	if changes.empty() {
+		return nil
+	}
+
+	direction := "ingress"
+	if egress {
+		direction = "egress"
+	}
+
+	if len(changes.toAuthorize) > 0 {
+		if err := wait.WaitForWithRetryable(wait.NewBackoff(), func() (bool, error) {
+			var err error
+			if egress {
+				err = s.authorizeSecurityGroupEgressRules(groupID, ingressRulesToEgressRules(changes.toAuthorize))
+			} else {
+				err = s.authorizeSecurityGroupIngressRules(groupID, changes.toAuthorize)
+			}
+			if err != nil {
+				return false, err
+			}
+			return true, nil
+		}, awserrors.GroupNotFound); err != nil {
+			return errors.Wrapf(err, "failed to authorize security group %s rules for %q", direction, groupID)
+		}
+
+		s.scope.Debug("Authorized rules in security group", "direction", direction, "authorized-rules", changes.toAuthorize, "security-group-id", groupID)
+	}
+
+	if len(changes.toModify) > 0 {
+		if err := s.modifySecurityGroupRules(groupID, changes.toModify); err != nil {
+			return err
+		}
+
+		s.scope.Debug("Modified rules in security group", "direction", direction, "modified-rules", len(changes.toModify), "security-group-id", groupID)
+	}
+
+	if len(changes.toRevoke) > 0 {
+		if err := wait.WaitForWithRetryable(wait.NewBackoff(), func() (bool, error) {
+			if err := s.revokeSecurityGroupRules(groupID, egress, changes.toRevoke); err != nil {
+				return false, err
+			}
+			return true, nil
+		}, awserrors.GroupNotFound); err != nil {
+			return errors.Wrapf(err, "failed to revoke security group %s rules for %q", direction, groupID)
+		}
+
+		s.scope.Debug("Revoked rules from security group", "direction", direction, "revoked-rule-ids", changes.toRevoke, "security-group-id", groupID)
+	}
+
+	return nil
+}
+
+func (s *Service) modifySecurityGroupRules(id string, updates []*ec2.SecurityGroupRuleUpdate) error {
+	ruleIDs := make([]string, 0, len(updates))
+	for _, update := range updates {
+		ruleIDs = append(ruleIDs, aws.StringValue(update.SecurityGroupRuleId))
+	}
+
+	if _, err := s.EC2Client.ModifySecurityGroupRules(&ec2.ModifySecurityGroupRulesInput{
+		GroupId:            aws.String(id),
+		SecurityGroupRules: updates,
+	}); err != nil {
+		record.Warnf(s.scope.InfraCluster(), "FailedModifySecurityGroupRules", "Failed to modify security group rules %v for SecurityGroup %q: %v", ruleIDs, id, err)
+		return errors.Wrapf(err, "failed to modify security group %q rules %v", id, ruleIDs)
+	}
+
+	record.Eventf(s.scope.InfraCluster(), "SuccessfulModifySecurityGroupRules", "Modified security group rules %v for SecurityGroup %q", ruleIDs, id)
+	return nil
+}
+
+func (s *Service) revokeSecurityGroupRules(id string, egress bool, ruleIDs []string) error {
+	var err error
+	if egress {
+		_, err = s.EC2Client.RevokeSecurityGroupEgress(&ec2.RevokeSecurityGroupEgressInput{
+			GroupId:              aws.String(id),
+			SecurityGroupRuleIds: aws.StringSlice(ruleIDs),
+		})
+	} else {
+		_, err = s.EC2Client.RevokeSecurityGroupIngress(&ec2.RevokeSecurityGroupIngressInput{
+			GroupId:              aws.String(id),
+			SecurityGroupRuleIds: aws.StringSlice(ruleIDs),
+		})
+	}
+	if err != nil {
+		record.Warnf(s.scope.InfraCluster(), "FailedRevokeSecurityGroupRules", "Failed to revoke security group rules %v for SecurityGroup %q: %v", ruleIDs, id, err)
+		return errors.Wrapf(err, "failed to revoke security group %q rules %v", id, ruleIDs)
+	}
+
+	record.Eventf(s.scope.InfraCluster(), "SuccessfulRevokeSecurityGroupRules", "Revoked security group rules %v for SecurityGroup %q", ruleIDs, id)
+	return nil
+}
+
+// diffSecurityGroupRules matches the desired rules with the current rules of a security group. A desired rule
+// that allows the same traffic as a current rule is kept, and modified in place if only its description changed.
+func diffSecurityGroupRules(current []*ec2.SecurityGroupRule, want infrav1.IngressRules) *securityGroupRuleChanges {
+	changes := &securityGroupRuleChanges{}
+
+	matched := make([]bool, len(current))
+	for _, w := range splitIngressRules(want) {
+		found := false
+		for i := range current {
+			if matched[i] {
+				continue
+			}
+			c := ingressRuleFromSecurityGroupRule(current[i])
+			if !sameTraffic(c, w) {
+				continue
+			}
+
+			matched[i] = true
+			found = true
+			if c.Description != w.Description {
+				changes.toModify = append(changes.toModify, securityGroupRuleUpdate(current[i], w.Description))
+			}
+			break
+		}
+
+		if !found {
+			changes.toAuthorize = append(changes.toAuthorize, w)
+		}
+	}
+
+	for i := range current {
+		if !matched[i] {
+			changes.toRevoke = append(changes.toRevoke, aws.StringValue(current[i].SecurityGroupRuleId))
+		}
+	}
+
+	return changes
+}
+
+// splitIngressRules splits the rules into rules with a single source, the way EC2 stores them.
+// Rules allowing the same traffic as a previous rule are dropped, as EC2 only keeps one of them.
+func splitIngressRules(rules infrav1.IngressRules) infrav1.IngressRules {
+	var res infrav1.IngressRules
+
+	add := func(rule infrav1.IngressRule) {
+		for i := range res {
+			if sameTraffic(res[i], rule) {
+				return
+			}
+		}
+		res = append(res, rule)
+	}
+
+	for i := range rules {
+		base := infrav1.IngressRule{
+			Description: rules[i].Description,
+			Protocol:    rules[i].Protocol,
+			FromPort:    rules[i].FromPort,
+			ToPort:      rules[i].ToPort,
+		}
+
+		for _, cidr := range rules[i].CidrBlocks {
+			rule := base
+			rule.CidrBlocks = []string{cidr}
+			add(rule)
+		}
+		for _, cidr := range rules[i].IPv6CidrBlocks {
+			rule := base
+			rule.IPv6CidrBlocks = []string{cidr}
+			add(rule)
+		}
+		for _, groupID := range rules[i].SourceSecurityGroupIDs {
+			rule := base
+			rule.SourceSecurityGroupIDs = []string{groupID}
+			add(rule)
+		}
+		for _, prefixListID := range rules[i].SourcePrefixListIDs {
+			rule := base
+			rule.SourcePrefixListIDs = []string{prefixListID}
+			add(rule)
+		}
+	}
+
+	return res
+}
+
+// sameTraffic returns true if both rules allow the same traffic, regardless of their descriptions.
+func sameTraffic(a, b infrav1.IngressRule) bool {
+	a.Description, b.Description = "", ""
+	return a.Equals(&b)
+}
+
+// ingressRuleFromSecurityGroupRule converts a security group rule to a rule with a single source.
+// The source of an egress rule is its destination.
+func ingressRuleFromSecurityGroupRule(r *ec2.SecurityGroupRule) infrav1.IngressRule {
+	rule := infrav1.IngressRule{
+		Description: aws.StringValue(r.Description),
+		Protocol:    infrav1.SecurityGroupProtocol(aws.StringValue(r.IpProtocol)),
+	}
+
+	// See ingressRulesFromSDKType for the ports of the protocols.
+	switch aws.StringValue(r.IpProtocol) {
+	case IPProtocolTCP,
+		IPProtocolUDP,
+		IPProtocolICMP,
+		IPProtocolICMPv6:
+		rule.FromPort = aws.Int64Value(r.FromPort)
+		rule.ToPort = aws.Int64Value(r.ToPort)
+	}
+
+	switch {
+	case r.CidrIpv4 != nil:
+		rule.CidrBlocks = []string{aws.StringValue(r.CidrIpv4)}
+	case r.CidrIpv6 != nil:
+		rule.IPv6CidrBlocks = []string{aws.StringValue(r.CidrIpv6)}
+	case r.ReferencedGroupInfo != nil && r.ReferencedGroupInfo.GroupId != nil:
+		rule.SourceSecurityGroupIDs = []string{aws.StringValue(r.ReferencedGroupInfo.GroupId)}
+	case r.PrefixListId != nil:
+		rule.SourcePrefixListIDs = []string{aws.StringValue(r.PrefixListId)}
+	}
+
+	return rule
+}
+
+// securityGroupRuleUpdate returns the update that only changes the description of a security group rule.
+func securityGroupRuleUpdate(r *ec2.SecurityGroupRule, description string) *ec2.SecurityGroupRuleUpdate {
+	request := &ec2.SecurityGroupRuleRequest{
+		IpProtocol:   r.IpProtocol,
+		FromPort:     r.FromPort,
+		ToPort:       r.ToPort,
+		CidrIpv4:     r.CidrIpv4,
+		CidrIpv6:     r.CidrIpv6,
+		PrefixListId: r.PrefixListId,
+	}
+	if r.ReferencedGroupInfo != nil {
+		request.ReferencedGroupId = r.ReferencedGroupInfo.GroupId
+	}
+	if description != "" {
+		request.Description = aws.String(description)
+	}
+
+	return &ec2.SecurityGroupRuleUpdate{
+		SecurityGroupRuleId: r.SecurityGroupRuleId,
+		SecurityGroupRule:   request,
+	}
+}
+
+// egressRuleToIngressRule converts an egress rule to a rule whose sources are the destinations of the egress rule,
+// as EC2 describes inbound and outbound rules the same way.
+func egressRuleToIngressRule(e *infrav1.EgressRule) infrav1.IngressRule {
+	return infrav1.IngressRule{
+		Description:            e.Description,
+		Protocol:               e.Protocol,
+		FromPort:               e.FromPort,
+		ToPort:                 e.ToPort,
+		CidrBlocks:             e.CidrBlocks,
+		IPv6CidrBlocks:         e.IPv6CidrBlocks,
+		SourceSecurityGroupIDs: e.DestinationSecurityGroupIDs,
+		SourcePrefixListIDs:    e.DestinationPrefixListIDs,
+	}
+}
+
+// ingressRuleToEgressRule is the reverse of egressRuleToIngressRule.
+func ingressRuleToEgressRule(r *infrav1.IngressRule) infrav1.EgressRule {
+	return infrav1.EgressRule{
+		Description:                 r.Description,
+		Protocol:                    r.Protocol,
+		FromPort:                    r.FromPort,
+		ToPort:                      r.ToPort,
+		CidrBlocks:                  r.CidrBlocks,
+		IPv6CidrBlocks:              r.IPv6CidrBlocks,
+		DestinationSecurityGroupIDs: r.SourceSecurityGroupIDs,
+		DestinationPrefixListIDs:    r.SourcePrefixListIDs,
+	}
+}
+
+func egressRulesToIngressRules(rules infrav1.EgressRules) infrav1.IngressRules {
+	res := make(infrav1.IngressRules, 0, len(rules))
+	for i := range rules {
+		res = append(res, egressRuleToIngressRule(&rules[i]))
+	}
+	return res
+}
+
+func ingressRulesToEgressRules(rules infrav1.IngressRules) infrav1.EgressRules {
+	res := make(infrav1.EgressRules, 0, len(rules))
+	for i := range rules {
+		res = append(res, ingressRuleToEgressRule(&rules[i]))
+	}
+	return res
+}
@@ -0,0 +1,503 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package securitygroup
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/filter"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/test/mocks"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+)
+
+func TestDescribeSecurityGroupRules(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	g := NewWithT(t)
+	ec2Mock := mocks.NewMockEC2API(mockCtrl)
+
+	ec2Mock.EXPECT().DescribeSecurityGroupRulesPages(gomock.Eq(&ec2.DescribeSecurityGroupRulesInput{
+		Filters: []*ec2.Filter{filter.EC2.SecurityGroupIDs("sg-cp", "sg-node")},
+	}), gomock.Any()).
+		DoAndReturn(func(_ *ec2.DescribeSecurityGroupRulesInput, fn func(*ec2.DescribeSecurityGroupRulesOutput, bool) bool) error {
+			fn(&ec2.DescribeSecurityGroupRulesOutput{
+				SecurityGroupRules: []*ec2.SecurityGroupRule{
+					{SecurityGroupRuleId: aws.String("sgr-1"), GroupId: aws.String("sg-cp"), IsEgress: aws.Bool(false)},
+					{SecurityGroupRuleId: aws.String("sgr-2"), GroupId: aws.String("sg-cp"), IsEgress: aws.Bool(true)},
+				},
+			}, false)
+			fn(&ec2.DescribeSecurityGroupRulesOutput{
+				SecurityGroupRules: []*ec2.SecurityGroupRule{
+					{SecurityGroupRuleId: aws.String("sgr-3"), GroupId: aws.String("sg-node"), IsEgress: aws.Bool(false)},
+				},
+			}, true)
+			return nil
+		})
+
+	s := NewService(newRulesTestClusterScope(t), testSecurityGroupRoles)
+	s.EC2Client = ec2Mock
+
+	rules, err := s.describeSecurityGroupRules([]string{"sg-cp", "sg-node"})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(rules).To(HaveLen(2))
+	g.Expect(rules["sg-cp"].ingress).To(HaveLen(1))
+	g.Expect(aws.StringValue(rules["sg-cp"].ingress[0].SecurityGroupRuleId)).To(Equal("sgr-1"))
+	g.Expect(rules["sg-cp"].egress).To(HaveLen(1))
+	g.Expect(aws.StringValue(rules["sg-cp"].egress[0].SecurityGroupRuleId)).To(Equal("sgr-2"))
+	g.Expect(rules["sg-node"].ingress).To(HaveLen(1))
+	g.Expect(rules["sg-node"].egress).To(BeEmpty())
+
+	// No call is made without security groups.
+	rules, err = s.describeSecurityGroupRules(nil)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(rules).To(BeEmpty())
+}
+
+func TestDiffSecurityGroupRules(t *testing.T) {
+	sshRule := &ec2.SecurityGroupRule{
+		SecurityGroupRuleId: aws.String("sgr-ssh"),
+		IpProtocol:          aws.String("tcp"),
+		FromPort:            aws.Int64(22),
+		ToPort:              aws.Int64(22),
+		CidrIpv4:            aws.String("10.0.0.0/16"),
+		Description:         aws.String("SSH"),
+	}
+	nodeRule := &ec2.SecurityGroupRule{
+		SecurityGroupRuleId: aws.String("sgr-node"),
+		IpProtocol:          aws.String("-1"),
+		FromPort:            aws.Int64(-1),
+		ToPort:              aws.Int64(-1),
+		ReferencedGroupInfo: &ec2.ReferencedSecurityGroup{GroupId: aws.String("sg-node")},
+		Description:         aws.String("Node traffic"),
+	}
+	prefixListRule := &ec2.SecurityGroupRule{
+		SecurityGroupRuleId: aws.String("sgr-pl"),
+		IpProtocol:          aws.String("tcp"),
+		FromPort:            aws.Int64(443),
+		ToPort:              aws.Int64(443),
+		PrefixListId:        aws.String("pl-12345678"),
+	}
+
+	testCases := []struct {
+		name        string
+		current     []*ec2.SecurityGroupRule
+		want        infrav1.IngressRules
+		toAuthorize infrav1.IngressRules
+		toModify    []*ec2.SecurityGroupRuleUpdate
+		toRevoke    []string
+	}{
+		{
+			name:    "matching rules are kept",
+			current: []*ec2.SecurityGroupRule{sshRule, nodeRule, prefixListRule},
+			want: infrav1.IngressRules{
+				{
+					Description: "SSH",
+					Protocol:    infrav1.SecurityGroupProtocolTCP,
+					FromPort:    22,
+					ToPort:      22,
+					CidrBlocks:  []string{"10.0.0.0/16"},
+				},
+				{
+					Description:            "Node traffic",
+					Protocol:               infrav1.SecurityGroupProtocolAll,
+					SourceSecurityGroupIDs: []string{"sg-node"},
+				},
+				{
+					Protocol:            infrav1.SecurityGroupProtocolTCP,
+					FromPort:            443,
+					ToPort:              443,
+					SourcePrefixListIDs: []string{"pl-12345678"},
+				},
+			},
+		},
+		{
+			name:    "rules with multiple sources are split and missing ones authorized",
+			current: []*ec2.SecurityGroupRule{sshRule},
+			want: infrav1.IngressRules{
+				{
+					Description: "SSH",
+					Protocol:    infrav1.SecurityGroupProtocolTCP,
+					FromPort:    22,
+					ToPort:      22,
+					CidrBlocks:  []string{"10.0.0.0/16", "10.1.0.0/16"},
+				},
+			},
+			toAuthorize: infrav1.IngressRules{
+				{
+					Description: "SSH",
+					Protocol:    infrav1.SecurityGroupProtocolTCP,
+					FromPort:    22,
+					ToPort:      22,
+					CidrBlocks:  []string{"10.1.0.0/16"},
+				},
+			},
+		},
+		{
+			name:    "rules allowing the same traffic are only authorized once",
+			current: nil,
+			want: infrav1.IngressRules{
+				{
+					Description: "SSH",
+					Protocol:    infrav1.SecurityGroupProtocolTCP,
+					FromPort:    22,
+					ToPort:      22,
+					CidrBlocks:  []string{"10.0.0.0/16"},
+				},
+				{
+					Description: "SSH again",
+					Protocol:    infrav1.SecurityGroupProtocolTCP,
+					FromPort:    22,
+					ToPort:      22,
+					CidrBlocks:  []string{"10.0.0.0/16"},
+				},
+			},
+			toAuthorize: infrav1.IngressRules{
+				{
+					Description: "SSH",
+					Protocol:    infrav1.SecurityGroupProtocolTCP,
+					FromPort:    22,
+					ToPort:      22,
+					CidrBlocks:  []string{"10.0.0.0/16"},
+				},
+			},
+		},
+		{
+			name:    "rules whose description changed are modified in place",
+			current: []*ec2.SecurityGroupRule{sshRule, nodeRule},
+			want: infrav1.IngressRules{
+				{
+					Description: "SSH from the VPN",
+					Protocol:    infrav1.SecurityGroupProtocolTCP,
+					FromPort:    22,
+					ToPort:      22,
+					CidrBlocks:  []string{"10.0.0.0/16"},
+				},
+				{
+					Protocol:               infrav1.SecurityGroupProtocolAll,
+					SourceSecurityGroupIDs: []string{"sg-node"},
+				},
+			},
+			toModify: []*ec2.SecurityGroupRuleUpdate{
+				{
+					SecurityGroupRuleId: aws.String("sgr-ssh"),
+					SecurityGroupRule: &ec2.SecurityGroupRuleRequest{
+						IpProtocol:  aws.String("tcp"),
+						FromPort:    aws.Int64(22),
+						ToPort:      aws.Int64(22),
+						CidrIpv4:    aws.String("10.0.0.0/16"),
+						Description: aws.String("SSH from the VPN"),
+					},
+				},
+				{
+					SecurityGroupRuleId: aws.String("sgr-node"),
+					SecurityGroupRule: &ec2.SecurityGroupRuleRequest{
+						IpProtocol:        aws.String("-1"),
+						FromPort:          aws.Int64(-1),
+						ToPort:            aws.Int64(-1),
+						ReferencedGroupId: aws.String("sg-node"),
+					},
+				},
+			},
+		},
+		{
+			name:    "rules that are no longer desired are revoked by id",
+			current: []*ec2.SecurityGroupRule{sshRule, nodeRule, prefixListRule},
+			want: infrav1.IngressRules{
+				{
+					Description:            "Node traffic",
+					Protocol:               infrav1.SecurityGroupProtocolAll,
+					SourceSecurityGroupIDs: []string{"sg-node"},
+				},
+			},
+			toRevoke: []string{"sgr-ssh", "sgr-pl"},
+		},
+		{
+			name:    "rules whose ports changed are replaced",
+			current: []*ec2.SecurityGroupRule{sshRule},
+			want: infrav1.IngressRules{
+				{
+					Description: "SSH",
+					Protocol:    infrav1.SecurityGroupProtocolTCP,
+					FromPort:    2222,
+					ToPort:      2222,
+					CidrBlocks:  []string{"10.0.0.0/16"},
+				},
+			},
+			toAuthorize: infrav1.IngressRules{
+				{
+					Description: "SSH",
+					Protocol:    infrav1.SecurityGroupProtocolTCP,
+					FromPort:    2222,
+					ToPort:      2222,
+					CidrBlocks:  []string{"10.0.0.0/16"},
+				},
+			},
+			toRevoke: []string{"sgr-ssh"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			changes := diffSecurityGroupRules(tc.current, tc.want)
+			g.Expect(changes.toAuthorize).To(Equal(tc.toAuthorize))
+			g.Expect(changes.toModify).To(Equal(tc.toModify))
+			g.Expect(changes.toRevoke).To(Equal(tc.toRevoke))
+		})
+	}
+}
+
+func TestReconcileSecurityGroupRules(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	defaultEgressRule := &ec2.SecurityGroupRule{
+		SecurityGroupRuleId: aws.String("sgr-default"),
+		IsEgress:            aws.Bool(true),
+		IpProtocol:          aws.String("-1"),
+		FromPort:            aws.Int64(-1),
+		ToPort:              aws.Int64(-1),
+		CidrIpv4:            aws.String("0.0.0.0/0"),
+	}
+	httpsEgressRule := &ec2.SecurityGroupRule{
+		SecurityGroupRuleId: aws.String("sgr-https"),
+		IsEgress:            aws.Bool(true),
+		IpProtocol:          aws.String("tcp"),
+		FromPort:            aws.Int64(443),
+		ToPort:              aws.Int64(443),
+		CidrIpv4:            aws.String("10.0.0.0/8"),
+		Description:         aws.String("HTTPS"),
+	}
+	sshIngressRule := &ec2.SecurityGroupRule{
+		SecurityGroupRuleId: aws.String("sgr-ssh"),
+		IpProtocol:          aws.String("tcp"),
+		FromPort:            aws.Int64(22),
+		ToPort:              aws.Int64(22),
+		CidrIpv4:            aws.String("10.0.0.0/16"),
+		Description:         aws.String("SSH"),
+	}
+
+	testCases := []struct {
+		name    string
+		egress  bool
+		current []*ec2.SecurityGroupRule
+		want    infrav1.IngressRules
+		revoke  bool
+		expect  func(m *mocks.MockEC2APIMockRecorder)
+	}{
+		{
+			name:    "matching rules are left untouched",
+			current: []*ec2.SecurityGroupRule{sshIngressRule},
+			want: infrav1.IngressRules{
+				{
+					Description: "SSH",
+					Protocol:    infrav1.SecurityGroupProtocolTCP,
+					FromPort:    22,
+					ToPort:      22,
+					CidrBlocks:  []string{"10.0.0.0/16"},
+				},
+			},
+			revoke: true,
+			expect: func(m *mocks.MockEC2APIMockRecorder) {},
+		},
+		{
+			name:    "ingress rules are authorized before the replaced rules are revoked",
+			current: []*ec2.SecurityGroupRule{sshIngressRule},
+			want: infrav1.IngressRules{
+				{
+					Description: "SSH",
+					Protocol:    infrav1.SecurityGroupProtocolTCP,
+					FromPort:    22,
+					ToPort:      22,
+					CidrBlocks:  []string{"10.1.0.0/16"},
+				},
+			},
+			revoke: true,
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				gomock.InOrder(
+					m.AuthorizeSecurityGroupIngress(gomock.Eq(&ec2.AuthorizeSecurityGroupIngressInput{
+						GroupId: aws.String("sg-test"),
+						IpPermissions: []*ec2.IpPermission{
+							{
+								IpProtocol: aws.String("tcp"),
+								FromPort:   aws.Int64(22),
+								ToPort:     aws.Int64(22),
+								IpRanges: []*ec2.IpRange{
+									{CidrIp: aws.String("10.1.0.0/16"), Description: aws.String("SSH")},
+								},
+							},
+						},
+					})).Return(&ec2.AuthorizeSecurityGroupIngressOutput{}, nil),
+					m.RevokeSecurityGroupIngress(gomock.Eq(&ec2.RevokeSecurityGroupIngressInput{
+						GroupId:              aws.String("sg-test"),
+						SecurityGroupRuleIds: aws.StringSlice([]string{"sgr-ssh"}),
+					})).Return(&ec2.RevokeSecurityGroupIngressOutput{}, nil),
+				)
+			},
+		},
+		{
+			name:    "description changes are applied in place",
+			current: []*ec2.SecurityGroupRule{sshIngressRule},
+			want: infrav1.IngressRules{
+				{
+					Description: "SSH from the VPN",
+					Protocol:    infrav1.SecurityGroupProtocolTCP,
+					FromPort:    22,
+					ToPort:      22,
+					CidrBlocks:  []string{"10.0.0.0/16"},
+				},
+			},
+			revoke: true,
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.ModifySecurityGroupRules(gomock.Eq(&ec2.ModifySecurityGroupRulesInput{
+					GroupId: aws.String("sg-test"),
+					SecurityGroupRules: []*ec2.SecurityGroupRuleUpdate{
+						{
+							SecurityGroupRuleId: aws.String("sgr-ssh"),
+							SecurityGroupRule: &ec2.SecurityGroupRuleRequest{
+								IpProtocol:  aws.String("tcp"),
+								FromPort:    aws.Int64(22),
+								ToPort:      aws.Int64(22),
+								CidrIpv4:    aws.String("10.0.0.0/16"),
+								Description: aws.String("SSH from the VPN"),
+							},
+						},
+					},
+				})).Return(&ec2.ModifySecurityGroupRulesOutput{Return: aws.Bool(true)}, nil)
+			},
+		},
+		{
+			name:    "undesired rules are kept when revoking is disabled",
+			current: []*ec2.SecurityGroupRule{sshIngressRule},
+			want: infrav1.IngressRules{
+				{
+					Description: "HTTPS",
+					Protocol:    infrav1.SecurityGroupProtocolTCP,
+					FromPort:    443,
+					ToPort:      443,
+					CidrBlocks:  []string{"10.0.0.0/16"},
+				},
+			},
+			revoke: false,
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.AuthorizeSecurityGroupIngress(gomock.AssignableToTypeOf(&ec2.AuthorizeSecurityGroupIngressInput{})).
+					Return(&ec2.AuthorizeSecurityGroupIngressOutput{}, nil)
+			},
+		},
+		{
+			name:    "default egress rule is replaced by the configured egress rules",
+			egress:  true,
+			current: []*ec2.SecurityGroupRule{defaultEgressRule, httpsEgressRule},
+			want: infrav1.IngressRules{
+				{
+					Description: "HTTPS",
+					Protocol:    infrav1.SecurityGroupProtocolTCP,
+					FromPort:    443,
+					ToPort:      443,
+					CidrBlocks:  []string{"10.0.0.0/8"},
+				},
+				{
+					Description:            "DNS",
+					Protocol:               infrav1.SecurityGroupProtocolUDP,
+					FromPort:               53,
+					ToPort:                 53,
+					SourceSecurityGroupIDs: []string{"sg-dns"},
+				},
+			},
+			revoke: true,
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				gomock.InOrder(
+					m.AuthorizeSecurityGroupEgress(gomock.Eq(&ec2.AuthorizeSecurityGroupEgressInput{
+						GroupId: aws.String("sg-test"),
+						IpPermissions: []*ec2.IpPermission{
+							{
+								IpProtocol: aws.String("udp"),
+								FromPort:   aws.Int64(53),
+								ToPort:     aws.Int64(53),
+								UserIdGroupPairs: []*ec2.UserIdGroupPair{
+									{GroupId: aws.String("sg-dns"), Description: aws.String("DNS")},
+								},
+							},
+						},
+					})).Return(&ec2.AuthorizeSecurityGroupEgressOutput{}, nil),
+					m.RevokeSecurityGroupEgress(gomock.Eq(&ec2.RevokeSecurityGroupEgressInput{
+						GroupId:              aws.String("sg-test"),
+						SecurityGroupRuleIds: aws.StringSlice([]string{"sgr-default"}),
+					})).Return(&ec2.RevokeSecurityGroupEgressOutput{}, nil),
+				)
+			},
+		},
+		{
+			name:    "all egress rules are revoked when no egress is allowed",
+			egress:  true,
+			current: []*ec2.SecurityGroupRule{defaultEgressRule, httpsEgressRule},
+			want:    infrav1.IngressRules{},
+			revoke:  true,
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.RevokeSecurityGroupEgress(gomock.Eq(&ec2.RevokeSecurityGroupEgressInput{
+					GroupId:              aws.String("sg-test"),
+					SecurityGroupRuleIds: aws.StringSlice([]string{"sgr-default", "sgr-https"}),
+				})).Return(&ec2.RevokeSecurityGroupEgressOutput{}, nil)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			ec2Mock := mocks.NewMockEC2API(mockCtrl)
+
+			tc.expect(ec2Mock.EXPECT())
+
+			s := NewService(newRulesTestClusterScope(t), testSecurityGroupRoles)
+			s.EC2Client = ec2Mock
+
+			g.Expect(s.reconcileSecurityGroupRules("sg-test", tc.egress, tc.current, tc.want, tc.revoke)).To(Succeed())
+		})
+	}
+}
+
+func newRulesTestClusterScope(t *testing.T) *scope.ClusterScope {
+	t.Helper()
+
+	scheme := runtime.NewScheme()
+	_ = infrav1.AddToScheme(scheme)
+
+	cs, err := scope.NewClusterScope(scope.ClusterScopeParams{
+		Client: fake.NewClientBuilder().WithScheme(scheme).Build(),
+		Cluster: &clusterv1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-cluster"},
+		},
+		AWSCluster: &infrav1.AWSCluster{},
+	})
+	if err != nil {
+		t.Fatalf("Failed to create test context: %v", err)
+	}
+	return cs
+}
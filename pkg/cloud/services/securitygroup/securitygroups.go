@@ -18,6 +18,7 @@ package securitygroup
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -137,7 +138,20 @@ func (s *Service) ReconcileSecurityGroups() error {
 	}
 
 	// Second iteration creates or updates all permissions on the security group to match
-	// the specified ingress rules.
+	// the specified ingress and egress rules.
+	groupIDs := make([]string, 0, len(s.scope.SecurityGroups()))
+	for _, sg := range s.scope.SecurityGroups() {
+		if !s.securityGroupIsAnOverride(sg.ID) {
+			groupIDs = append(groupIDs, sg.ID)
+		}
+	}
+	sort.Strings(groupIDs)
+
+	groupRules, err := s.describeSecurityGroupRules(groupIDs)
+	if err != nil {
+		return err
+	}
+
 	for role := range s.scope.SecurityGroups() {
 		sg := s.scope.SecurityGroups()[role]
 		s.scope.Debug("second pass security group reconciliation", "group-id", sg.ID, "name", sg.Name, "role", role)
@@ -147,43 +161,36 @@ func (s *Service) ReconcileSecurityGroups() error {
 			continue
 		}
 
+		current := groupRules[sg.ID]
+		if current == nil {
+			current = &securityGroupRules{}
+		}
+
 		if sg.Tags.HasAWSCloudProviderOwned(s.scope.Name()) || s.isEKSOwned(sg) {
-			// skip rule reconciliation, as we expect the in-cluster cloud integration to manage them
+			// skip rule reconciliation, as we expect the in-cluster cloud integration to manage them.
+			// Additional ingress rules are still added, but the other rules of the group are left untouched.
+			if err := s.reconcileSecurityGroupRules(sg.ID, false, current.ingress, s.getAdditionalIngressRules(role), false); err != nil {
+				return err
+			}
 			continue
 		}
-		current := sg.IngressRules
 
 		want, err := s.getSecurityGroupIngressRules(role)
 		if err != nil {
 			return err
 		}
+		want = append(want, s.getAdditionalIngressRules(role)...)
 
-		toRevoke := current.Difference(want)
-		if len(toRevoke) > 0 {
-			if err := wait.WaitForWithRetryable(wait.NewBackoff(), func() (bool, error) {
-				if err := s.revokeSecurityGroupIngressRules(sg.ID, toRevoke); err != nil {
-					return false, err
-				}
-				return true, nil
-			}, awserrors.GroupNotFound); err != nil {
-				return errors.Wrapf(err, "failed to revoke security group ingress rules for %q", sg.ID)
-			}
-
-			s.scope.Debug("Revoked ingress rules from security group", "revoked-ingress-rules", toRevoke, "security-group-id", sg.ID)
+		if err := s.reconcileSecurityGroupRules(sg.ID, false, current.ingress, want, true); err != nil {
+			return err
 		}
 
-		toAuthorize := want.Difference(current)
-		if len(toAuthorize) > 0 {
-			if err := wait.WaitForWithRetryable(wait.NewBackoff(), func() (bool, error) {
-				if err := s.authorizeSecurityGroupIngressRules(sg.ID, toAuthorize); err != nil {
-					return false, err
-				}
-				return true, nil
-			}, awserrors.GroupNotFound); err != nil {
+		// Security groups without configured egress rules keep their outbound rules.
+		if specRules, ok := s.scope.SecurityGroupEgressRules()[role]; ok {
+			wantEgress := egressRulesToIngressRules(s.getSecurityGroupEgressRules(specRules))
+			if err := s.reconcileSecurityGroupRules(sg.ID, true, current.egress, wantEgress, true); err != nil {
 				return err
 			}
-
-			s.scope.Debug("Authorized ingress rules in security group", "authorized-ingress-rules", toAuthorize, "security-group-id", sg.ID)
 		}
 	}
 	conditions.MarkTrue(s.scope.InfraCluster(), infrav1.ClusterSecurityGroupsReadyCondition)
@@ -249,6 +256,9 @@ func (s *Service) ec2SecurityGroupToSecurityGroup(ec2SecurityGroup *ec2.Security
 	for _, ec2rule := range ec2SecurityGroup.IpPermissions {
 		sg.IngressRules = append(sg.IngressRules, ingressRulesFromSDKType(ec2rule)...)
 	}
+	for _, ec2rule := range ec2SecurityGroup.IpPermissionsEgress {
+		sg.EgressRules = append(sg.EgressRules, egressRulesFromSDKType(ec2rule)...)
+	}
 	return sg
 }
 
@@ -408,19 +418,18 @@ func (s *Service) authorizeSecurityGroupIngressRules(id string, rules infrav1.In
 	return nil
 }
 
-func (s *Service) revokeSecurityGroupIngressRules(id string, rules infrav1.IngressRules) error {
-	input := &ec2.RevokeSecurityGroupIngressInput{GroupId: aws.String(id)}
+func (s *Service) authorizeSecurityGroupEgressRules(id string, rules infrav1.EgressRules) error {
+	input := &ec2.AuthorizeSecurityGroupEgressInput{GroupId: aws.String(id)}
 	for i := range rules {
 		rule := rules[i]
-		input.IpPermissions = append(input.IpPermissions, ingressRuleToSDKType(s.scope, &rule))
+		input.IpPermissions = append(input.IpPermissions, egressRuleToSDKType(s.scope, &rule))
 	}
-
-	if _, err := s.EC2Client.RevokeSecurityGroupIngress(input); err != nil {
-		record.Warnf(s.scope.InfraCluster(), "FailedRevokeSecurityGroupIngressRules", "Failed to revoke security group ingress rules %v for SecurityGroup %q: %v", rules, id, err)
-		return errors.Wrapf(err, "failed to revoke security group %q ingress rules: %v", id, rules)
+	if _, err := s.EC2Client.AuthorizeSecurityGroupEgress(input); err != nil {
+		record.Warnf(s.scope.InfraCluster(), "FailedAuthorizeSecurityGroupEgressRules", "Failed to authorize security group egress rules %v for SecurityGroup %q: %v", rules, id, err)
+		return errors.Wrapf(err, "failed to authorize security group %q egress rules: %v", id, rules)
 	}
 
-	record.Eventf(s.scope.InfraCluster(), "SuccessfulRevokeSecurityGroupIngressRules", "Revoked security group ingress rules %v for SecurityGroup %q", rules, id)
+	record.Eventf(s.scope.InfraCluster(), "SuccessfulAuthorizeSecurityGroupEgressRules", "Authorized security group egress rules %v for SecurityGroup %q", rules, id)
 	return nil
 }
 
@@ -586,7 +595,17 @@ func (s *Service) getSecurityGroupIngressRules(role infrav1.SecurityGroupRole) (
 		// We hand this group off to the in-cluster cloud provider, so these rules aren't used
 		// Except if the load balancer type is NLB, and we have an AWS Cluster in which case we
 		// need to open port 6443 to the NLB traffic and health check inside the VPC.
-		if s.scope.ControlPlaneLoadBalancer() != nil && s.scope.ControlPlaneLoadBalancer().LoadBalancerType == infrav1.LoadBalancerTypeNLB {
+		var (
+			hasNLB           bool
+			preserveClientIP bool
+		)
+		for _, lb := range s.scope.ControlPlaneLoadBalancers() {
+			if lb != nil && lb.LoadBalancerType == infrav1.LoadBalancerTypeNLB {
+				hasNLB = true
+				preserveClientIP = preserveClientIP || lb.PreserveClientIP
+			}
+		}
+		if hasNLB {
 			var (
 				ipv4CidrBlocks []string
 				ipv6CidrBlocks []string
@@ -596,7 +615,7 @@ func (s *Service) getSecurityGroupIngressRules(role infrav1.SecurityGroupRole) (
 			if s.scope.VPC().IsIPv6Enabled() {
 				ipv6CidrBlocks = []string{s.scope.VPC().IPv6.CidrBlock}
 			}
-			if s.scope.ControlPlaneLoadBalancer().PreserveClientIP {
+			if preserveClientIP {
 				ipv4CidrBlocks = []string{services.AnyIPv4CidrBlock}
 				if s.scope.VPC().IsIPv6Enabled() {
 					ipv6CidrBlocks = []string{services.AnyIPv6CidrBlock}
@@ -621,6 +640,36 @@ func (s *Service) getSecurityGroupIngressRules(role infrav1.SecurityGroupRole) (
 	return nil, errors.Errorf("Cannot determine ingress rules for unknown security group role %q", role)
 }
 
+// getAdditionalIngressRules returns the additional ingress rules configured for the role, with their
+// source security group roles resolved into the ids of the cluster security groups.
+func (s *Service) getAdditionalIngressRules(role infrav1.SecurityGroupRole) infrav1.IngressRules {
+	specRules := s.scope.AdditionalIngressRules()[role]
+	rules := make(infrav1.IngressRules, 0, len(specRules))
+	for i := range specRules {
+		rule := *specRules[i].DeepCopy()
+		for _, sourceRole := range rule.SourceSecurityGroupRoles {
+			rule.SourceSecurityGroupIDs = append(rule.SourceSecurityGroupIDs, s.scope.SecurityGroups()[sourceRole].ID)
+		}
+		rules = append(rules, rule)
+	}
+	return rules
+}
+
+// getSecurityGroupEgressRules resolves the destination security group roles of the egress rules
+// into the ids of the cluster security groups.
+func (s *Service) getSecurityGroupEgressRules(specRules infrav1.EgressRules) infrav1.EgressRules {
+	rules := make(infrav1.EgressRules, 0, len(specRules))
+	for i := range specRules {
+		rule := *specRules[i].DeepCopy()
+		for _, role := range rule.DestinationSecurityGroupRoles {
+			rule.DestinationSecurityGroupIDs = append(rule.DestinationSecurityGroupIDs, s.scope.SecurityGroups()[role].ID)
+		}
+		rule.DestinationSecurityGroupRoles = nil
+		rules = append(rules, rule)
+	}
+	return rules
+}
+
 func (s *Service) getSecurityGroupName(clusterName string, role infrav1.SecurityGroupRole) string {
 	groupPrefix := clusterName
 	if strings.HasPrefix(clusterName, "sg-") {
@@ -718,6 +767,18 @@ func ingressRuleToSDKType(scope scope.SGScope, i *infrav1.IngressRule) (res *ec2
 		res.UserIdGroupPairs = append(res.UserIdGroupPairs, userIDGroupPair)
 	}
 
+	for _, prefixListID := range i.SourcePrefixListIDs {
+		prefixList := &ec2.PrefixListId{
+			PrefixListId: aws.String(prefixListID),
+		}
+
+		if i.Description != "" {
+			prefixList.Description = aws.String(i.Description)
+		}
+
+		res.PrefixListIds = append(res.PrefixListIds, prefixList)
+	}
+
 	return res
 }
 
@@ -783,9 +844,35 @@ func ingressRulesFromSDKType(v *ec2.IpPermission) (res infrav1.IngressRules) {
 		res = append(res, r2)
 	}
 
+	if len(v.PrefixListIds) > 0 {
+		r3 := ir
+		for _, prefixList := range v.PrefixListIds {
+			if prefixList.PrefixListId == nil {
+				continue
+			}
+
+			if prefixList.Description != nil && *prefixList.Description != "" {
+				r3.Description = *prefixList.Description
+			}
+
+			r3.SourcePrefixListIDs = append(r3.SourcePrefixListIDs, *prefixList.PrefixListId)
+		}
+		res = append(res, r3)
+	}
+
 	return res
 }
 
+// egressRuleToSDKType converts an egress rule to an EC2 IP permission, which is shared by inbound and outbound rules.
+func egressRuleToSDKType(scope scope.SGScope, e *infrav1.EgressRule) *ec2.IpPermission {
+	rule := egressRuleToIngressRule(e)
+	return ingressRuleToSDKType(scope, &rule)
+}
+
+func egressRulesFromSDKType(v *ec2.IpPermission) infrav1.EgressRules {
+	return ingressRulesToEgressRules(ingressRulesFromSDKType(v))
+}
+
 func (s *Service) getDefaultIngressRulesForControlPlaneLB() infrav1.IngressRules {
 	if s.scope.VPC().IsIPv6Enabled() {
 		return infrav1.IngressRules{
@@ -34,6 +34,7 @@ import (
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/awserrors"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/filter"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/test/mocks"
@@ -92,7 +93,7 @@ func TestReconcileSecurityGroups(t *testing.T) {
 				m.DescribeSecurityGroups(gomock.AssignableToTypeOf(&ec2.DescribeSecurityGroupsInput{})).
 					Return(&ec2.DescribeSecurityGroupsOutput{}, nil)
 
-				securityGroupBastion := m.CreateSecurityGroup(gomock.Eq(&ec2.CreateSecurityGroupInput{
+				m.CreateSecurityGroup(gomock.Eq(&ec2.CreateSecurityGroupInput{
 					VpcId:       aws.String("vpc-securitygroups"),
 					GroupName:   aws.String("test-cluster-bastion"),
 					Description: aws.String("Kubernetes cluster test-cluster: bastion"),
@@ -118,12 +119,6 @@ func TestReconcileSecurityGroups(t *testing.T) {
 				})).
 					Return(&ec2.CreateSecurityGroupOutput{GroupId: aws.String("sg-bastion")}, nil)
 
-				m.AuthorizeSecurityGroupIngress(gomock.AssignableToTypeOf(&ec2.AuthorizeSecurityGroupIngressInput{
-					GroupId: aws.String("sg-bastion"),
-				})).
-					Return(&ec2.AuthorizeSecurityGroupIngressOutput{}, nil).
-					After(securityGroupBastion)
-
 				securityGroupAPIServerLb := m.CreateSecurityGroup(gomock.Eq(&ec2.CreateSecurityGroupInput{
 					VpcId:       aws.String("vpc-securitygroups"),
 					GroupName:   aws.String("test-cluster-apiserver-lb"),
@@ -249,6 +244,14 @@ func TestReconcileSecurityGroups(t *testing.T) {
 				})).
 					Return(&ec2.AuthorizeSecurityGroupIngressOutput{}, nil).
 					After(securityGroupNode)
+
+				m.DescribeSecurityGroupRulesPages(gomock.Eq(&ec2.DescribeSecurityGroupRulesInput{
+					Filters: []*ec2.Filter{
+						filter.EC2.SecurityGroupIDs("sg-apiserver-lb", "sg-bastion", "sg-control", "sg-lb", "sg-node"),
+					},
+				}), gomock.Any()).
+					Return(nil).
+					After(securityGroupNode)
 			},
 		},
 		{
@@ -286,7 +289,7 @@ func TestReconcileSecurityGroups(t *testing.T) {
 				m.DescribeSecurityGroups(gomock.AssignableToTypeOf(&ec2.DescribeSecurityGroupsInput{})).
 					Return(&ec2.DescribeSecurityGroupsOutput{}, nil)
 
-				securityGroupBastion := m.CreateSecurityGroup(gomock.Eq(&ec2.CreateSecurityGroupInput{
+				m.CreateSecurityGroup(gomock.Eq(&ec2.CreateSecurityGroupInput{
 					VpcId:       aws.String("vpc-securitygroups"),
 					GroupName:   aws.String("test-cluster-bastion"),
 					Description: aws.String("Kubernetes cluster test-cluster: bastion"),
@@ -312,12 +315,6 @@ func TestReconcileSecurityGroups(t *testing.T) {
 				})).
 					Return(&ec2.CreateSecurityGroupOutput{GroupId: aws.String("sg-bastion")}, nil)
 
-				m.AuthorizeSecurityGroupIngress(gomock.AssignableToTypeOf(&ec2.AuthorizeSecurityGroupIngressInput{
-					GroupId: aws.String("sg-bastion"),
-				})).
-					Return(&ec2.AuthorizeSecurityGroupIngressOutput{}, nil).
-					After(securityGroupBastion)
-
 				securityGroupAPIServerLb := m.CreateSecurityGroup(gomock.Eq(&ec2.CreateSecurityGroupInput{
 					VpcId:       aws.String("vpc-securitygroups"),
 					GroupName:   aws.String("test-cluster-apiserver-lb"),
@@ -448,6 +445,14 @@ func TestReconcileSecurityGroups(t *testing.T) {
 				})).
 					Return(&ec2.AuthorizeSecurityGroupIngressOutput{}, nil).
 					After(securityGroupNode)
+
+				m.DescribeSecurityGroupRulesPages(gomock.Eq(&ec2.DescribeSecurityGroupRulesInput{
+					Filters: []*ec2.Filter{
+						filter.EC2.SecurityGroupIDs("sg-apiserver-lb", "sg-bastion", "sg-control", "sg-lb", "sg-node"),
+					},
+				}), gomock.Any()).
+					Return(nil).
+					After(securityGroupNode)
 			},
 		},
 		{
@@ -1135,6 +1140,33 @@ func TestIngressRulesFromSDKType(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "Prefix lists",
+			input: &ec2.IpPermission{
+				IpProtocol: aws.String("tcp"),
+				FromPort:   aws.Int64(443),
+				ToPort:     aws.Int64(443),
+				PrefixListIds: []*ec2.PrefixListId{
+					{
+						PrefixListId: aws.String("pl-vpn-1"),
+						Description:  aws.String("VPN"),
+					},
+					{
+						PrefixListId: aws.String("pl-vpn-2"),
+						Description:  aws.String("VPN"),
+					},
+				},
+			},
+			expected: infrav1.IngressRules{
+				{
+					Description:         "VPN",
+					Protocol:            "tcp",
+					FromPort:            443,
+					ToPort:              443,
+					SourcePrefixListIDs: []string{"pl-vpn-1", "pl-vpn-2"},
+				},
+			},
+		},
 	}
 
 	for _, tc := range tests {
@@ -1147,6 +1179,126 @@ func TestIngressRulesFromSDKType(t *testing.T) {
 	}
 }
 
+func TestIngressRuleToSDKTypePrefixLists(t *testing.T) {
+	g := NewWithT(t)
+
+	rule := infrav1.IngressRule{
+		Description:         "VPN",
+		Protocol:            infrav1.SecurityGroupProtocolTCP,
+		FromPort:            443,
+		ToPort:              443,
+		SourcePrefixListIDs: []string{"pl-vpn"},
+	}
+
+	permission := ingressRuleToSDKType(nil, &rule)
+	g.Expect(permission).To(Equal(&ec2.IpPermission{
+		IpProtocol: aws.String("tcp"),
+		FromPort:   aws.Int64(443),
+		ToPort:     aws.Int64(443),
+		PrefixListIds: []*ec2.PrefixListId{
+			{
+				PrefixListId: aws.String("pl-vpn"),
+				Description:  aws.String("VPN"),
+			},
+		},
+	}))
+
+	roundTrip := ingressRulesFromSDKType(permission)
+	g.Expect(roundTrip).To(HaveLen(1))
+	g.Expect(roundTrip[0].Equals(&rule)).To(BeTrue())
+}
+
+func TestAdditionalIngressRules(t *testing.T) {
+	g := NewWithT(t)
+
+	scheme := runtime.NewScheme()
+	_ = infrav1.AddToScheme(scheme)
+
+	vpnRule := infrav1.IngressRule{
+		Description: "VPN",
+		Protocol:    infrav1.SecurityGroupProtocolTCP,
+		FromPort:    8080,
+		ToPort:      8080,
+		CidrBlocks:  []string{"192.168.0.0/16"},
+	}
+	monitoringRule := infrav1.IngressRule{
+		Description:              "Monitoring",
+		Protocol:                 infrav1.SecurityGroupProtocolTCP,
+		FromPort:                 9100,
+		ToPort:                   9100,
+		SourceSecurityGroupRoles: []infrav1.SecurityGroupRole{infrav1.SecurityGroupControlPlane},
+	}
+
+	cs, err := scope.NewClusterScope(scope.ClusterScopeParams{
+		Client: fake.NewClientBuilder().WithScheme(scheme).Build(),
+		Cluster: &clusterv1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-cluster"},
+		},
+		AWSCluster: &infrav1.AWSCluster{
+			Spec: infrav1.AWSClusterSpec{
+				NetworkSpec: infrav1.NetworkSpec{
+					AdditionalIngressRules: map[infrav1.SecurityGroupRole]infrav1.IngressRules{
+						infrav1.SecurityGroupNode: {vpnRule, monitoringRule},
+					},
+				},
+			},
+			Status: infrav1.AWSClusterStatus{
+				Network: infrav1.NetworkStatus{
+					SecurityGroups: map[infrav1.SecurityGroupRole]infrav1.SecurityGroup{
+						infrav1.SecurityGroupControlPlane: {ID: "sg-cp"},
+						infrav1.SecurityGroupNode:         {ID: "sg-node"},
+					},
+				},
+			},
+		},
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	s := NewService(cs, testSecurityGroupRoles)
+	rules := s.getAdditionalIngressRules(infrav1.SecurityGroupNode)
+	g.Expect(rules).To(HaveLen(2))
+	g.Expect(rules[0]).To(Equal(vpnRule))
+	g.Expect(rules[1].SourceSecurityGroupIDs).To(Equal([]string{"sg-cp"}))
+
+	// The spec is left untouched.
+	g.Expect(cs.AdditionalIngressRules()[infrav1.SecurityGroupNode][1].SourceSecurityGroupIDs).To(BeEmpty())
+
+	g.Expect(s.getAdditionalIngressRules(infrav1.SecurityGroupControlPlane)).To(BeEmpty())
+}
+
+func TestEgressRulesFromSDKType(t *testing.T) {
+	g := NewWithT(t)
+
+	output := egressRulesFromSDKType(&ec2.IpPermission{
+		IpProtocol: aws.String("tcp"),
+		FromPort:   aws.Int64(443),
+		ToPort:     aws.Int64(443),
+		IpRanges: []*ec2.IpRange{
+			{CidrIp: aws.String("10.0.0.0/8"), Description: aws.String("HTTPS")},
+		},
+		UserIdGroupPairs: []*ec2.UserIdGroupPair{
+			{GroupId: aws.String("sg-destination"), Description: aws.String("HTTPS")},
+		},
+	})
+
+	g.Expect(output).To(Equal(infrav1.EgressRules{
+		{
+			Description: "HTTPS",
+			Protocol:    infrav1.SecurityGroupProtocolTCP,
+			FromPort:    443,
+			ToPort:      443,
+			CidrBlocks:  []string{"10.0.0.0/8"},
+		},
+		{
+			Description:                 "HTTPS",
+			Protocol:                    infrav1.SecurityGroupProtocolTCP,
+			FromPort:                    443,
+			ToPort:                      443,
+			DestinationSecurityGroupIDs: []string{"sg-destination"},
+		},
+	}))
+}
+
 var processSecurityGroupsPage = func(_, y interface{}) {
 	funcType := y.(func(out *ec2.DescribeSecurityGroupsOutput, last bool) bool)
 	funcType(&ec2.DescribeSecurityGroupsOutput{
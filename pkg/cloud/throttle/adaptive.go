@@ -0,0 +1,186 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package throttle
+
+import (
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/internal/rate"
+)
+
+const (
+	// DecreaseFactor is the factor applied to the refill rate of a limiter when AWS throttles one of its calls.
+	DecreaseFactor = 0.5
+	// DecreaseInterval is the minimum time between two decreases of the refill rate of a limiter, so that the
+	// calls throttled together only decrease it once.
+	DecreaseInterval = time.Second
+	// MinRateFraction is the lowest refill rate of a limiter, as a fraction of its configured refill rate.
+	MinRateFraction = 0.1
+	// IncreaseFraction is the fraction of the configured refill rate that is recovered by an increase.
+	IncreaseFraction = 0.1
+	// IncreaseInterval is the minimum time between two increases of the refill rate of a limiter.
+	IncreaseInterval = 5 * time.Second
+
+	// DefaultAccount is the account of the limiters used with the credentials of the controller.
+	DefaultAccount = "default"
+
+	metricAWSSubsystem     = "aws"
+	metricRateLimitKey     = "api_rate_limit"
+	metricRateDecreasesKey = "api_rate_limit_decreases_total"
+	metricAccountLabel     = "account"
+	metricRegionLabel      = "region"
+	metricServiceLabel     = "service"
+	metricOperationLabel   = "operation"
+)
+
+var (
+	rateLimit = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Subsystem: metricAWSSubsystem,
+		Name:      metricRateLimitKey,
+		Help:      "Current refill rate, in calls per second, of the AWS API rate limiters",
+	}, []string{metricAccountLabel, metricRegionLabel, metricServiceLabel, metricOperationLabel})
+	rateDecreases = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Subsystem: metricAWSSubsystem,
+		Name:      metricRateDecreasesKey,
+		Help:      "Total number of decreases of the refill rate of the AWS API rate limiters after throttled calls",
+	}, []string{metricAccountLabel, metricRegionLabel, metricServiceLabel, metricOperationLabel})
+
+	sharedLimitersLock sync.Mutex
+	sharedLimiters     = map[string]*sharedServiceLimiters{}
+)
+
+func init() {
+	metrics.Registry.MustRegister(rateLimit)
+	metrics.Registry.MustRegister(rateDecreases)
+}
+
+type sharedServiceLimiters struct {
+	generation int64
+	limiters   ServiceLimiters
+}
+
+// SharedServiceLimiters returns the service limiters of an account in a region. The limiters are shared by all
+// the sessions using the account in the region, so that they adapt to the quotas of the account together.
+// They are recreated when the rate limits configuration changes.
+func SharedServiceLimiters(account, region string) ServiceLimiters {
+	if account == "" {
+		account = DefaultAccount
+	}
+	key := account + "/" + region
+	generation := ConfigGeneration()
+
+	sharedLimitersLock.Lock()
+	defer sharedLimitersLock.Unlock()
+
+	if shared, ok := sharedLimiters[key]; ok {
+		if shared.generation == generation {
+			return shared.limiters
+		}
+		shared.limiters.deleteMetrics()
+	}
+
+	limiters := NewServiceLimiters(account, region)
+	sharedLimiters[key] = &sharedServiceLimiters{
+		generation: generation,
+		limiters:   limiters,
+	}
+	return limiters
+}
+
+func (s ServiceLimiters) deleteMetrics() {
+	for _, limiter := range s {
+		for _, ol := range *limiter {
+			if ol.labels != nil {
+				rateLimit.Delete(ol.labels)
+				rateDecreases.Delete(ol.labels)
+			}
+		}
+	}
+}
+
+// adaptiveState is the state of the refill rate of an operation limiter, which is lowered when AWS throttles
+// calls and recovers gradually while calls succeed, up to the configured refill rate.
+type adaptiveState struct {
+	lock        sync.Mutex
+	current     rate.Limit
+	lastUpdated time.Time
+}
+
+// decreaseRate lowers the refill rate of the limiter after a throttled call.
+func (o *OperationLimiter) decreaseRate(now time.Time) {
+	o.adaptive.lock.Lock()
+	defer o.adaptive.lock.Unlock()
+
+	current := o.currentRate()
+	if now.Sub(o.adaptive.lastUpdated) < DecreaseInterval {
+		return
+	}
+	updated := current * DecreaseFactor
+	if floor := o.RefillRate * MinRateFraction; updated < floor {
+		updated = floor
+	}
+	o.adaptive.lastUpdated = now
+	if o.labels != nil {
+		rateDecreases.With(o.labels).Inc()
+	}
+	o.setRate(now, updated)
+}
+
+// increaseRate raises the refill rate of the limiter after a successful call, until it reaches the
+// configured refill rate.
+func (o *OperationLimiter) increaseRate(now time.Time) {
+	o.adaptive.lock.Lock()
+	defer o.adaptive.lock.Unlock()
+
+	current := o.currentRate()
+	if current >= o.RefillRate || now.Sub(o.adaptive.lastUpdated) < IncreaseInterval {
+		return
+	}
+	updated := current + o.RefillRate*IncreaseFraction
+	if updated > o.RefillRate {
+		updated = o.RefillRate
+	}
+	o.adaptive.lastUpdated = now
+	o.setRate(now, updated)
+}
+
+// CurrentRate returns the refill rate the limiter currently applies.
+func (o *OperationLimiter) CurrentRate() rate.Limit {
+	o.adaptive.lock.Lock()
+	defer o.adaptive.lock.Unlock()
+
+	return o.currentRate()
+}
+
+func (o *OperationLimiter) currentRate() rate.Limit {
+	if o.adaptive.current == 0 {
+		return o.RefillRate
+	}
+	return o.adaptive.current
+}
+
+func (o *OperationLimiter) setRate(now time.Time, limit rate.Limit) {
+	o.adaptive.current = limit
+	o.getLimiter().SetLimitAt(now, limit)
+	if o.labels != nil {
+		rateLimit.With(o.labels).Set(float64(limit))
+	}
+}
@@ -0,0 +1,109 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package throttle
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/internal/rate"
+)
+
+func TestOperationLimiterAdaptsRate(t *testing.T) {
+	g := NewWithT(t)
+
+	limiter := OperationLimit{RefillRate: 20, Burst: 100}.operationLimiter()
+	now := time.Now()
+
+	limiter.decreaseRate(now)
+	g.Expect(limiter.CurrentRate()).To(Equal(rate.Limit(10)))
+	g.Expect(limiter.getLimiter().Limit()).To(Equal(rate.Limit(10)))
+
+	// Calls throttled together only decrease the rate once.
+	limiter.decreaseRate(now.Add(DecreaseInterval / 2))
+	g.Expect(limiter.CurrentRate()).To(Equal(rate.Limit(10)))
+
+	// The rate does not go below its floor.
+	for i := 1; i <= 5; i++ {
+		limiter.decreaseRate(now.Add(time.Duration(i) * DecreaseInterval))
+	}
+	g.Expect(limiter.CurrentRate()).To(Equal(rate.Limit(2)))
+	now = now.Add(5 * DecreaseInterval)
+
+	// The rate recovers gradually, up to the configured rate.
+	limiter.increaseRate(now.Add(IncreaseInterval / 2))
+	g.Expect(limiter.CurrentRate()).To(Equal(rate.Limit(2)))
+	limiter.increaseRate(now.Add(IncreaseInterval))
+	g.Expect(limiter.CurrentRate()).To(Equal(rate.Limit(4)))
+	for i := 2; i <= 20; i++ {
+		limiter.increaseRate(now.Add(time.Duration(i) * IncreaseInterval))
+	}
+	g.Expect(limiter.CurrentRate()).To(Equal(rate.Limit(20)))
+	g.Expect(limiter.getLimiter().Limit()).To(Equal(rate.Limit(20)))
+}
+
+func TestReviewResponseDecreasesRateOnThrottling(t *testing.T) {
+	g := NewWithT(t)
+
+	limiters := NewServiceLimiters("123456789012", "review-test-region")
+	t.Cleanup(limiters.deleteMetrics)
+	serviceLimiter := limiters[ec2.ServiceID]
+	ol, ok := serviceLimiter.matchRequest(&request.Request{Operation: &request.Operation{Name: "RunInstances"}})
+	g.Expect(ok).To(BeTrue())
+	g.Expect(testutil.ToFloat64(rateLimit.With(ol.labels))).To(Equal(2.0))
+
+	serviceLimiter.ReviewResponse(&request.Request{
+		Operation: &request.Operation{Name: "RunInstances"},
+		Error:     awserr.New("RequestLimitExceeded", "Request limit exceeded.", nil),
+	})
+	g.Expect(ol.CurrentRate()).To(Equal(rate.Limit(1)))
+	g.Expect(testutil.ToFloat64(rateLimit.With(ol.labels))).To(Equal(1.0))
+	g.Expect(testutil.ToFloat64(rateDecreases.With(ol.labels))).To(Equal(1.0))
+
+	// Other errors do not change the rate.
+	serviceLimiter.ReviewResponse(&request.Request{
+		Operation: &request.Operation{Name: "RunInstances"},
+		Error:     awserr.New("InvalidParameterValue", "Invalid value.", nil),
+	})
+	g.Expect(ol.CurrentRate()).To(Equal(rate.Limit(1)))
+}
+
+func TestSharedServiceLimiters(t *testing.T) {
+	g := NewWithT(t)
+	t.Cleanup(func() { SetConfig(nil) })
+
+	limiters := SharedServiceLimiters("111111111111", "shared-test-region")
+	g.Expect(SharedServiceLimiters("111111111111", "shared-test-region")[ec2.ServiceID]).To(BeIdenticalTo(limiters[ec2.ServiceID]))
+	g.Expect(SharedServiceLimiters("222222222222", "shared-test-region")[ec2.ServiceID]).NotTo(BeIdenticalTo(limiters[ec2.ServiceID]))
+	g.Expect(SharedServiceLimiters("111111111111", "other-test-region")[ec2.ServiceID]).NotTo(BeIdenticalTo(limiters[ec2.ServiceID]))
+
+	// The limiters are recreated from a new configuration.
+	SetConfig(&Config{
+		Services: map[string][]OperationLimit{
+			ec2.ServiceID: {{RefillRate: 1, Burst: 1}},
+		},
+	})
+	reloaded := SharedServiceLimiters("111111111111", "shared-test-region")
+	g.Expect(reloaded[ec2.ServiceID]).NotTo(BeIdenticalTo(limiters[ec2.ServiceID]))
+	g.Expect(*reloaded[ec2.ServiceID]).To(HaveLen(1))
+}
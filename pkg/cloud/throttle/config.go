@@ -0,0 +1,290 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package throttle
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"regexp"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/elb"
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/yaml"
+
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/internal/rate"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/logger"
+)
+
+// Config defines the rate limits applied by the controllers to their AWS API calls.
+//
+// Limits are keyed by the service ID of the AWS SDK, for example "EC2" or "Elastic Load Balancing v2".
+// The limits of a service replace its default limits as a whole, and the limits of a service in a region
+// replace the limits of that service in all regions.
+type Config struct {
+	// Services are the limits of each service in all regions.
+	Services map[string][]OperationLimit `json:"services,omitempty"`
+
+	// Regions are the limits of each service in a given region.
+	Regions map[string]map[string][]OperationLimit `json:"regions,omitempty"`
+}
+
+// OperationLimit defines the token bucket used to limit calls to some operations of a service.
+// The first limit matching an operation applies.
+type OperationLimit struct {
+	// Operations are regular expressions matched against the beginning of operation names, for example "Describe".
+	// All operations are matched when empty.
+	Operations []string `json:"operations,omitempty"`
+
+	// RefillRate is the number of calls per second allowed on average.
+	RefillRate float64 `json:"refillRate"`
+
+	// Burst is the number of calls allowed at once.
+	Burst int `json:"burst"`
+}
+
+var (
+	configLock       sync.RWMutex
+	currentConfig    = &Config{}
+	configGeneration int64
+)
+
+// SetConfig replaces the rate limits configuration, which must be valid. Service limiters created afterwards use it.
+func SetConfig(c *Config) {
+	configLock.Lock()
+	defer configLock.Unlock()
+
+	if c == nil {
+		c = &Config{}
+	}
+	currentConfig = c
+	configGeneration++
+}
+
+// ConfigGeneration returns a counter incremented every time the configuration is replaced, so that
+// service limiters created with a previous configuration can be recreated.
+func ConfigGeneration() int64 {
+	configLock.RLock()
+	defer configLock.RUnlock()
+
+	return configGeneration
+}
+
+// NewServiceLimiters returns new service limiters for an account in a region, from the current configuration
+// and the default limits of the services it does not configure. The account and region label their metrics.
+func NewServiceLimiters(account, region string) ServiceLimiters {
+	configLock.RLock()
+	defer configLock.RUnlock()
+
+	limits := map[string][]OperationLimit{}
+	for service, serviceLimits := range DefaultConfig().Services {
+		limits[service] = serviceLimits
+	}
+	for service, serviceLimits := range currentConfig.Services {
+		limits[service] = serviceLimits
+	}
+	for service, serviceLimits := range currentConfig.Regions[region] {
+		limits[service] = serviceLimits
+	}
+
+	limiters := ServiceLimiters{}
+	for service, serviceLimits := range limits {
+		limiter := make(ServiceLimiter, 0, len(serviceLimits))
+		for _, limit := range serviceLimits {
+			ol := limit.operationLimiter()
+			ol.labels = prometheus.Labels{
+				metricAccountLabel:   account,
+				metricRegionLabel:    region,
+				metricServiceLabel:   service,
+				metricOperationLabel: ol.Operation,
+			}
+			rateLimit.With(ol.labels).Set(float64(ol.RefillRate))
+			limiter = append(limiter, ol)
+		}
+		limiters[service] = &limiter
+	}
+	return limiters
+}
+
+func (l OperationLimit) operationLimiter() *OperationLimiter {
+	operation := ".*"
+	if len(l.Operations) > 0 {
+		operation = NewMultiOperationMatch(l.Operations...)
+	}
+	ol := &OperationLimiter{
+		Operation:  operation,
+		RefillRate: rate.Limit(l.RefillRate),
+		Burst:      l.Burst,
+	}
+	// The limiters are shared by concurrent reconciliations, initialize them before they are used.
+	ol.regexp = regexp.MustCompile("^" + operation)
+	ol.getLimiter()
+	return ol
+}
+
+// DefaultConfig returns the limits used for the services that are not configured.
+func DefaultConfig() *Config {
+	generic := []OperationLimit{
+		{Operations: []string{"Describe", "Get", "List"}, RefillRate: 20, Burst: 100},
+		{RefillRate: 5, Burst: 200},
+	}
+
+	return &Config{
+		Services: map[string][]OperationLimit{
+			ec2.ServiceID: {
+				{Operations: []string{"Describe", "Get"}, RefillRate: 20, Burst: 100},
+				{Operations: []string{"AuthorizeSecurityGroupIngress", "CancelSpotInstanceRequests", "CreateKeyPair", "RequestSpotInstances"}, RefillRate: 20, Burst: 100},
+				{Operations: []string{"RunInstances"}, RefillRate: 2, Burst: 5},
+				{Operations: []string{"StartInstances"}, RefillRate: 2, Burst: 5},
+				{RefillRate: 5, Burst: 200},
+			},
+			elb.ServiceID:                      generic,
+			elbv2.ServiceID:                    generic,
+			resourcegroupstaggingapi.ServiceID: generic,
+			// Route53 allows five requests per second, shared by all the clients of an account.
+			route53.ServiceID: {
+				{RefillRate: 4, Burst: 10},
+			},
+			secretsmanager.ServiceID: generic,
+		},
+	}
+}
+
+// Validate checks that every limit allows calls and only uses valid regular expressions.
+func (c *Config) Validate() error {
+	var allErrs field.ErrorList
+
+	for service, limits := range c.Services {
+		allErrs = append(allErrs, validateOperationLimits(field.NewPath("services").Key(service), limits)...)
+	}
+	for region, services := range c.Regions {
+		for service, limits := range services {
+			allErrs = append(allErrs, validateOperationLimits(field.NewPath("regions").Key(region).Key(service), limits)...)
+		}
+	}
+
+	return allErrs.ToAggregate()
+}
+
+func validateOperationLimits(fldPath *field.Path, limits []OperationLimit) field.ErrorList {
+	var allErrs field.ErrorList
+
+	for i, limit := range limits {
+		limitPath := fldPath.Index(i)
+		for j, operation := range limit.Operations {
+			if _, err := regexp.Compile(operation); err != nil {
+				allErrs = append(allErrs, field.Invalid(limitPath.Child("operations").Index(j), operation, err.Error()))
+			}
+		}
+		if limit.RefillRate <= 0 {
+			allErrs = append(allErrs, field.Invalid(limitPath.Child("refillRate"), limit.RefillRate, "must be greater than 0"))
+		}
+		if limit.Burst <= 0 {
+			allErrs = append(allErrs, field.Invalid(limitPath.Child("burst"), limit.Burst, "must be greater than 0"))
+		}
+	}
+
+	return allErrs
+}
+
+// LoadConfig reads and validates a rate limits configuration file.
+func LoadConfig(path string) (*Config, error) {
+	data, err := os.ReadFile(path) //nolint:gosec
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read rate limits configuration %q", path)
+	}
+	return parseConfig(data)
+}
+
+func parseConfig(data []byte) (*Config, error) {
+	c := &Config{}
+	if err := yaml.UnmarshalStrict(data, c); err != nil {
+		return nil, errors.Wrap(err, "failed to parse rate limits configuration")
+	}
+	if err := c.Validate(); err != nil {
+		return nil, errors.Wrap(err, "invalid rate limits configuration")
+	}
+	return c, nil
+}
+
+// ConfigReloader reloads the rate limits configuration whenever its file changes.
+// An invalid configuration is reported and ignored, the previous one is kept.
+type ConfigReloader struct {
+	// Path is the path of the configuration file.
+	Path string
+	// Interval is how often the file is checked for changes.
+	Interval time.Duration
+	// Log is used to report reloads.
+	Log logger.Wrapper
+
+	data []byte
+}
+
+// Load reads the configuration file and sets it as the current configuration.
+func (r *ConfigReloader) Load() error {
+	data, err := os.ReadFile(r.Path)
+	if err != nil {
+		return errors.Wrapf(err, "failed to read rate limits configuration %q", r.Path)
+	}
+	if r.data != nil && bytes.Equal(data, r.data) {
+		return nil
+	}
+
+	c, err := parseConfig(data)
+	if err != nil {
+		return err
+	}
+	SetConfig(c)
+	r.data = data
+	return nil
+}
+
+// Start checks the configuration file for changes until the context is done.
+func (r *ConfigReloader) Start(ctx context.Context) error {
+	ticker := time.NewTicker(r.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			generation := ConfigGeneration()
+			if err := r.Load(); err != nil {
+				r.Log.Error(err, "Failed to reload rate limits configuration, keeping the current one", "path", r.Path)
+				continue
+			}
+			if generation != ConfigGeneration() {
+				r.Log.Info("Reloaded rate limits configuration", "path", r.Path)
+			}
+		}
+	}
+}
+
+// NeedLeaderElection returns false, as every replica calls AWS APIs through its webhooks and caches.
+func (r *ConfigReloader) NeedLeaderElection() bool {
+	return false
+}
@@ -0,0 +1,188 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package throttle
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/route53"
+	. "github.com/onsi/gomega"
+
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/internal/rate"
+)
+
+func TestParseConfig(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  string
+		wantErr bool
+	}{
+		{
+			name: "valid configuration",
+			config: `
+services:
+  EC2:
+  - operations: ["Describe"]
+    refillRate: 50
+    burst: 200
+  - refillRate: 10
+    burst: 100
+regions:
+  us-east-1:
+    Route 53:
+    - refillRate: 2
+      burst: 5
+`,
+		},
+		{
+			name:   "empty configuration",
+			config: "",
+		},
+		{
+			name: "unknown field",
+			config: `
+services:
+  EC2:
+  - refillRate: 10
+    burst: 100
+    limit: 3
+`,
+			wantErr: true,
+		},
+		{
+			name: "invalid operation",
+			config: `
+services:
+  EC2:
+  - operations: ["Describe("]
+    refillRate: 10
+    burst: 100
+`,
+			wantErr: true,
+		},
+		{
+			name: "refill rate not set",
+			config: `
+regions:
+  eu-west-1:
+    EC2:
+    - burst: 100
+`,
+			wantErr: true,
+		},
+		{
+			name: "negative burst",
+			config: `
+services:
+  EC2:
+  - refillRate: 10
+    burst: -1
+`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			_, err := parseConfig([]byte(tt.config))
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+			} else {
+				g.Expect(err).NotTo(HaveOccurred())
+			}
+		})
+	}
+}
+
+func TestNewServiceLimiters(t *testing.T) {
+	g := NewWithT(t)
+	t.Cleanup(func() { SetConfig(nil) })
+
+	SetConfig(&Config{
+		Services: map[string][]OperationLimit{
+			ec2.ServiceID: {
+				{Operations: []string{"Describe", "Get"}, RefillRate: 50, Burst: 300},
+				{RefillRate: 10, Burst: 100},
+			},
+		},
+		Regions: map[string]map[string][]OperationLimit{
+			"us-east-1": {
+				ec2.ServiceID: {
+					{RefillRate: 1, Burst: 2},
+				},
+			},
+		},
+	})
+
+	limiter := operationLimiterFor(g, NewServiceLimiters(DefaultAccount, "eu-west-1"), ec2.ServiceID, "DescribeInstances")
+	g.Expect(limiter.RefillRate).To(Equal(rate.Limit(50)))
+	g.Expect(limiter.Burst).To(Equal(300))
+	limiter = operationLimiterFor(g, NewServiceLimiters(DefaultAccount, "eu-west-1"), ec2.ServiceID, "RunInstances")
+	g.Expect(limiter.RefillRate).To(Equal(rate.Limit(10)))
+	g.Expect(limiter.Burst).To(Equal(100))
+
+	// The region overrides the limits of the service.
+	limiter = operationLimiterFor(g, NewServiceLimiters(DefaultAccount, "us-east-1"), ec2.ServiceID, "DescribeInstances")
+	g.Expect(limiter.RefillRate).To(Equal(rate.Limit(1)))
+	g.Expect(limiter.Burst).To(Equal(2))
+
+	// Services that are not configured keep their default limits.
+	limiter = operationLimiterFor(g, NewServiceLimiters(DefaultAccount, "us-east-1"), route53.ServiceID, "ChangeResourceRecordSets")
+	g.Expect(limiter.RefillRate).To(Equal(rate.Limit(4)))
+	g.Expect(limiter.Burst).To(Equal(10))
+}
+
+func TestConfigReloader(t *testing.T) {
+	g := NewWithT(t)
+	t.Cleanup(func() { SetConfig(nil) })
+
+	path := filepath.Join(t.TempDir(), "limits.yaml")
+	g.Expect(os.WriteFile(path, []byte("services:\n  EC2:\n  - refillRate: 10\n    burst: 100\n"), 0o600)).To(Succeed())
+
+	reloader := &ConfigReloader{Path: path}
+	g.Expect(reloader.Load()).To(Succeed())
+	generation := ConfigGeneration()
+	limiter := operationLimiterFor(g, NewServiceLimiters(DefaultAccount, "us-east-1"), ec2.ServiceID, "RunInstances")
+	g.Expect(limiter.RefillRate).To(Equal(rate.Limit(10)))
+
+	// An unchanged file does not replace the configuration.
+	g.Expect(reloader.Load()).To(Succeed())
+	g.Expect(ConfigGeneration()).To(Equal(generation))
+
+	// An invalid file is rejected and the current configuration is kept.
+	g.Expect(os.WriteFile(path, []byte("services:\n  EC2:\n  - refillRate: 0\n    burst: 100\n"), 0o600)).To(Succeed())
+	g.Expect(reloader.Load()).NotTo(Succeed())
+	g.Expect(ConfigGeneration()).To(Equal(generation))
+
+	g.Expect(os.WriteFile(path, []byte("services:\n  EC2:\n  - refillRate: 20\n    burst: 100\n"), 0o600)).To(Succeed())
+	g.Expect(reloader.Load()).To(Succeed())
+	g.Expect(ConfigGeneration()).To(BeNumerically(">", generation))
+	limiter = operationLimiterFor(g, NewServiceLimiters(DefaultAccount, "us-east-1"), ec2.ServiceID, "RunInstances")
+	g.Expect(limiter.RefillRate).To(Equal(rate.Limit(20)))
+}
+
+func operationLimiterFor(g *WithT, limiters ServiceLimiters, service, operation string) *OperationLimiter {
+	g.Expect(limiters).To(HaveKey(service))
+	limiter, ok := limiters[service].matchRequest(&request.Request{Operation: &request.Operation{Name: operation}})
+	g.Expect(ok).To(BeTrue())
+	return limiter
+}
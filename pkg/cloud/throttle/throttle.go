@@ -19,8 +19,10 @@ package throttle
 import (
 	"regexp"
 	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/prometheus/client_golang/prometheus"
 
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/awserrors"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/internal/rate"
@@ -44,6 +46,9 @@ type OperationLimiter struct {
 	Burst      int
 	regexp     *regexp.Regexp
 	limiter    *rate.Limiter
+	adaptive   adaptiveState
+	// labels are the labels of the metrics of the limiter, if it reports any.
+	labels prometheus.Labels
 }
 
 // Wait will wait on a request.
@@ -77,15 +82,21 @@ func (o *OperationLimiter) getLimiter() *rate.Limiter {
 	return o.limiter
 }
 
-// ReviewResponse will review the limits of a Request's response.
+// ReviewResponse will review the limits of a Request's response. A throttled request empties the bucket of
+// its limiter and lowers its refill rate, which then recovers gradually while requests succeed.
 func (s ServiceLimiter) ReviewResponse(r *request.Request) {
-	if r.Error != nil {
-		if errorCode, ok := awserrors.Code(r.Error); ok {
-			switch errorCode {
-			case "Throttling", "RequestLimitExceeded":
-				if ol, ok := s.matchRequest(r); ok {
-					ol.limiter.ResetTokens()
-				}
+	if r.Error == nil {
+		if ol, ok := s.matchRequest(r); ok {
+			ol.increaseRate(time.Now())
+		}
+		return
+	}
+	if errorCode, ok := awserrors.Code(r.Error); ok {
+		switch errorCode {
+		case "Throttling", "RequestLimitExceeded":
+			if ol, ok := s.matchRequest(r); ok {
+				ol.getLimiter().ResetTokens()
+				ol.decreaseRate(time.Now())
 			}
 		}
 	}
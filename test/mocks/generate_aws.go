@@ -25,5 +25,7 @@ limitations under the License.
 
 //go:generate ../../hack/tools/bin/mockgen -destination aws_ec2api_mock.go -package mocks github.com/aws/aws-sdk-go/service/ec2/ec2iface EC2API
 //go:generate /usr/bin/env bash -c "cat ../../hack/boilerplate/boilerplate.generatego.txt aws_ec2api_mock.go > _aws_ec2api_mock.go && mv _aws_ec2api_mock.go aws_ec2api_mock.go"
+//go:generate ../../hack/tools/bin/mockgen -destination aws_route53_mock.go -package mocks github.com/aws/aws-sdk-go/service/route53/route53iface Route53API
+//go:generate /usr/bin/env bash -c "cat ../../hack/boilerplate/boilerplate.generatego.txt aws_route53_mock.go > _aws_route53_mock.go && mv _aws_route53_mock.go aws_route53_mock.go"
 
 package mocks